@@ -1,8 +1,11 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
@@ -14,6 +17,7 @@ import (
 
 	// Shared
 	"finanzas-backend/internal/shared/infrastructure/config"
+	"finanzas-backend/internal/shared/infrastructure/identity"
 	"finanzas-backend/internal/shared/infrastructure/persistence"
 	"finanzas-backend/internal/shared/infrastructure/security"
 
@@ -21,17 +25,29 @@ import (
 	iamACLImpl "finanzas-backend/internal/iam/application/acl"
 	iamCommandServices "finanzas-backend/internal/iam/application/commandservices"
 	iamQueryServices "finanzas-backend/internal/iam/application/queryservices"
-	iamExternal "finanzas-backend/internal/iam/infrastructure/external"
+	iamValueObjects "finanzas-backend/internal/iam/domain/model/valueobjects"
+	iamJobs "finanzas-backend/internal/iam/infrastructure/jobs"
 	iamRepos "finanzas-backend/internal/iam/infrastructure/persistence/repositories"
 	iamSecurity "finanzas-backend/internal/iam/infrastructure/security"
 	iamACL "finanzas-backend/internal/iam/interfaces/acl"
 	iamControllers "finanzas-backend/internal/iam/interfaces/rest/controllers"
 
+	// Exchange rate
+	exchangeRateACLImpl "finanzas-backend/internal/exchangerate/application/acl"
+	exchangeRateCommandServices "finanzas-backend/internal/exchangerate/application/commandservices"
+	exchangeRateQueryServices "finanzas-backend/internal/exchangerate/application/queryservices"
+	exchangeRateJobs "finanzas-backend/internal/exchangerate/infrastructure/jobs"
+	exchangeRateRepos "finanzas-backend/internal/exchangerate/infrastructure/persistence/repositories"
+	exchangeRateACL "finanzas-backend/internal/exchangerate/interfaces/acl"
+	exchangeRateControllers "finanzas-backend/internal/exchangerate/interfaces/rest/controllers"
+
 	// Mortgage
 	mortgageACL "finanzas-backend/internal/mortgage/application/acl"
 	mortgageCommandServices "finanzas-backend/internal/mortgage/application/commandservices"
 	mortgageQueryServices "finanzas-backend/internal/mortgage/application/queryservices"
+	mortgageJobs "finanzas-backend/internal/mortgage/infrastructure/jobs"
 	mortgageRepos "finanzas-backend/internal/mortgage/infrastructure/persistence/repositories"
+	mortgageFacadeACL "finanzas-backend/internal/mortgage/interfaces/acl"
 	mortgageControllers "finanzas-backend/internal/mortgage/interfaces/rest/controllers"
 	mortgageMiddleware "finanzas-backend/internal/mortgage/interfaces/rest/middleware"
 
@@ -39,7 +55,7 @@ import (
 	profileACLImpl "finanzas-backend/internal/profile/application/acl"
 	profileCommandServices "finanzas-backend/internal/profile/application/commandservices"
 	profileQueryServices "finanzas-backend/internal/profile/application/queryservices"
-	profileExternal "finanzas-backend/internal/profile/infrastructure/external"
+	profileJobs "finanzas-backend/internal/profile/infrastructure/jobs"
 	profileRepos "finanzas-backend/internal/profile/infrastructure/persistence/repositories"
 	profileACL "finanzas-backend/internal/profile/interfaces/acl"
 	profileControllers "finanzas-backend/internal/profile/interfaces/rest/controllers"
@@ -55,7 +71,7 @@ import (
 // @securityDefinitions.apikey BearerAuth
 // @in header
 // @name Authorization
-// @description Type "Bearer" followed by a space and JWT token.
+// @description Type "Bearer" followed by a space and JWT token, or "ApiKey" followed by a space and an API key.
 func main() {
 	// Load configuration
 	cfg, err := config.LoadConfig()
@@ -69,16 +85,105 @@ func main() {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 
+	// Initialize encryption service (shared by IAM and Profile)
+	keyProvider, err := newKeyProvider(cfg.Encryption)
+	if err != nil {
+		log.Fatalf("Failed to initialize encryption key provider: %v", err)
+	}
+	encryptionService, err := security.NewEncryptionService(keyProvider, cfg.Encryption.Key)
+	if err != nil {
+		log.Fatalf("Failed to initialize encryption service: %v", err)
+	}
+
+	// Initialize JWT signing keys (asymmetric, rotated periodically)
+	tokenTTL := time.Hour * time.Duration(cfg.JWT.ExpirationHrs)
+	keyManager, err := iamSecurity.NewKeyManager(
+		iamRepos.NewSigningKeyStore(db, encryptionService),
+		cfg.JWT.Algorithm,
+		time.Hour*time.Duration(cfg.JWT.KeyRotationHrs),
+		tokenTTL,
+	)
+	if err != nil {
+		log.Fatalf("Failed to initialize JWT key manager: %v", err)
+	}
+	if err := keyManager.Initialize(context.Background()); err != nil {
+		log.Fatalf("Failed to load JWT signing keys: %v", err)
+	}
+	go keyManager.StartRotation(context.Background())
+
+	// JWT Service (shared by all contexts)
+	jwtService := iamSecurity.NewJWTService(keyManager, cfg.JWT.Issuer, cfg.JWT.ExpirationHrs)
+
+	// Blind index for searchable encrypted fields (DNI)
+	blindIndexKey := cfg.Encryption.BlindIndexKey
+	if blindIndexKey == "" {
+		if cfg.Encryption.Key == "" {
+			log.Fatalf("BLIND_INDEX_KEY is required when ENCRYPTION_KEY is not set")
+		}
+		blindIndexKey = security.DeriveBlindIndexKey(cfg.Encryption.Key)
+	}
+	blindIndexService, err := security.NewBlindIndexService(blindIndexKey)
+	if err != nil {
+		log.Fatalf("Failed to initialize blind index service: %v", err)
+	}
+
+	// Backfill del blind index del DNI para perfiles existentes
+	backfilled, err := profileRepos.BackfillDNIHashes(context.Background(), db, encryptionService, blindIndexService)
+	if err != nil {
+		log.Fatalf("Failed to backfill DNI blind index: %v", err)
+	}
+	if backfilled > 0 {
+		log.Printf("DNI blind index backfilled for %d profile(s)", backfilled)
+	}
+
+	// Conversión única a decimal de las tasas guardadas antes de registrar su unidad
+	migratedRates, err := mortgageRepos.MigrateLegacyRateUnits(context.Background(), db)
+	if err != nil {
+		log.Fatalf("Failed to migrate legacy rate units: %v", err)
+	}
+	if migratedRates > 0 {
+		log.Printf("Legacy rates converted to decimal for %d row(s)", migratedRates)
+	}
+
+	// Campos de PII del perfil cifrados además del DNI
+	profileEncryptedFields, err := profileRepos.ParseEncryptedFields(cfg.Profile.EncryptedFields)
+	if err != nil {
+		log.Fatalf("Invalid PROFILE_ENCRYPTED_FIELDS: %v", err)
+	}
+
+	// Re-cifrado de perfiles con la llave y los campos vigentes (0 lo desactiva)
+	if cfg.Encryption.ReencryptMins > 0 {
+		reencryptInterval := time.Minute * time.Duration(cfg.Encryption.ReencryptMins)
+		go profileJobs.NewProfileReencryptionJob(db, encryptionService, profileEncryptedFields, reencryptInterval).Start(context.Background())
+	}
+
 	// Setup Gin
 	router := gin.Default()
 
 	// Setup CORS
 	router.Use(corsMiddleware())
 
+	// Proveedor de identidad (RENIEC) compartido por IAM y Profile
+	identityProvider, err := newIdentityProvider(cfg.Reniec)
+	if err != nil {
+		log.Fatalf("Failed to initialize identity provider: %v", err)
+	}
+
+	// Mortgage facade (IAM lo usa para exportar y eliminar datos del usuario)
+	mortgageFacade := mortgageACL.NewMortgageContextFacade(mortgageRepos.NewMortgageRepository(db))
+
+	// Exchange rate facade (Profile y Mortgage convierten montos entre PEN y USD)
+	exchangeRateRepo := exchangeRateRepos.NewExchangeRateRepository(db)
+	exchangeRateFacade := exchangeRateACLImpl.NewExchangeRateContextFacade(
+		exchangeRateQueryServices.NewExchangeRateQueryService(exchangeRateRepo),
+		cfg.Exchange.MaxAgeDays,
+	)
+
 	// Setup dependencies and routes (Profile first, then IAM can use its ACL)
-	profileFacade := setupProfileContext(router, db, cfg)
-	iamFacade := setupIAMContext(router, db, cfg, profileFacade)
-	setupMortgageContext(router, db, iamFacade)
+	profileFacade := setupProfileContext(router, db, encryptionService, blindIndexService, profileEncryptedFields, identityProvider, jwtService, exchangeRateFacade)
+	iamFacade := setupIAMContext(router, db, cfg, encryptionService, jwtService, keyManager, identityProvider, profileFacade, mortgageFacade)
+	setupMortgageContext(router, db, cfg, iamFacade, profileFacade, exchangeRateFacade)
+	setupExchangeRateContext(router, db, cfg.Exchange, iamFacade)
 
 	// Swagger UI route con URL dinámica
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler,
@@ -106,6 +211,48 @@ func main() {
 	}
 }
 
+// newKeyProvider selecciona el origen de las llaves de cifrado
+func newKeyProvider(cfg config.EncryptionConfig) (security.KeyProvider, error) {
+	switch cfg.Provider {
+	case "env":
+		return security.NewEnvKeyProvider(cfg.Keys, cfg.CurrentKeyID, cfg.Key)
+	case "file":
+		return security.NewFileKeyProvider(cfg.KeysFile)
+	case "localkms":
+		return security.NewLocalKMSKeyProvider(cfg.KMSDir)
+	default:
+		return nil, fmt.Errorf("unknown ENCRYPTION_KEY_PROVIDER %q, must be env, file or localkms", cfg.Provider)
+	}
+}
+
+// identityCacheMaxEntries acota la memoria de la caché de consultas a RENIEC
+const identityCacheMaxEntries = 10000
+
+// newIdentityProvider arma el proveedor de identidad con caché, reintentos y circuit breaker
+func newIdentityProvider(cfg config.ReniecConfig) (identity.Provider, error) {
+	var provider identity.Provider
+	switch cfg.Provider {
+	case "decolecta":
+		provider = identity.NewDecolectaProvider(cfg.BaseURL, cfg.APIKey, time.Second*time.Duration(cfg.TimeoutSecs))
+		provider = identity.NewResilientProvider(provider, identity.ResilienceOptions{
+			MaxAttempts:      cfg.MaxAttempts,
+			InitialBackoff:   200 * time.Millisecond,
+			FailureThreshold: cfg.BreakerThreshold,
+			OpenDuration:     time.Second * time.Duration(cfg.BreakerOpenSecs),
+		})
+	case "stub":
+		log.Println("Using stub identity provider: DNIs are not validated against RENIEC")
+		provider = identity.NewStubProvider(nil)
+	default:
+		return nil, fmt.Errorf("unknown IDENTITY_PROVIDER %q, must be decolecta or stub", cfg.Provider)
+	}
+
+	if cfg.CacheTTLMins > 0 {
+		provider = identity.NewCachedProvider(provider, time.Minute*time.Duration(cfg.CacheTTLMins), identityCacheMaxEntries)
+	}
+	return provider, nil
+}
+
 // corsMiddleware configura CORS para producción y desarrollo
 func corsMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -123,121 +270,271 @@ func corsMiddleware() gin.HandlerFunc {
 	}
 }
 
-func setupIAMContext(router *gin.Engine, db *gorm.DB, cfg *config.Config, profileFacade profileACL.ProfileContextFacade) iamACL.IAMContextFacade {
-	// JWT Service
-	jwtService := iamSecurity.NewJWTService(
-		cfg.JWT.SecretKey,
-		cfg.JWT.Issuer,
-		cfg.JWT.ExpirationHrs,
-	)
+func setupIAMContext(router *gin.Engine, db *gorm.DB, cfg *config.Config, encryptionService *security.EncryptionService, jwtService *iamSecurity.JWTService, keyManager *iamSecurity.KeyManager, identityProvider identity.Provider, profileFacade profileACL.ProfileContextFacade, mortgageFacade mortgageFacadeACL.MortgageContextFacade) iamACL.IAMContextFacade {
+	// TOTP Service (2FA)
+	totpService := iamSecurity.NewTOTPService(cfg.MFA.Issuer)
 
 	// External Services
-	reniecService := iamExternal.NewReniecService(cfg.Reniec.APIKey)
 	externalProfileService := iamOutboundACL.NewExternalProfileService(profileFacade)
+	externalMortgageService := iamOutboundACL.NewExternalMortgageService(mortgageFacade)
 
 	// Repositories
-	userRepo := iamRepos.NewUserRepository(db)
+	userRepo := iamRepos.NewUserRepository(db, encryptionService)
+	apiKeyRepo := iamRepos.NewAPIKeyRepository(db)
+
+	// Unit of work: transacciones que abarcan IAM y los contextos llamados vía ACL
+	unitOfWork := persistence.NewTransactionManager(db)
 
 	// Services
-	userCommandService := iamCommandServices.NewUserCommandService(userRepo, reniecService, externalProfileService)
+	userCommandService := iamCommandServices.NewUserCommandService(userRepo, unitOfWork, identityProvider, externalProfileService)
 	userQueryService := iamQueryServices.NewUserQueryService(userRepo)
-	authService := iamCommandServices.NewAuthenticationService(userRepo, jwtService)
+	authService := iamCommandServices.NewAuthenticationService(userRepo, unitOfWork, jwtService, totpService)
+	mfaCommandService := iamCommandServices.NewMFACommandService(userRepo, unitOfWork, totpService)
+	signingKeyQueryService := iamQueryServices.NewSigningKeyQueryService(keyManager)
+	apiKeyCommandService := iamCommandServices.NewAPIKeyCommandService(apiKeyRepo, userRepo)
+	apiKeyQueryService := iamQueryServices.NewAPIKeyQueryService(apiKeyRepo)
+	accountCommandService := iamCommandServices.NewAccountCommandService(
+		userRepo,
+		unitOfWork,
+		externalProfileService,
+		externalMortgageService,
+		time.Duration(cfg.Account.DeletionGraceDays)*24*time.Hour,
+	)
+	accountQueryService := iamQueryServices.NewAccountQueryService(userRepo, apiKeyRepo, externalProfileService, externalMortgageService)
+
+	// Background job: borrado definitivo de cuentas con plazo de gracia vencido
+	go iamJobs.NewAccountPurgeJob(accountCommandService, time.Hour).Start(context.Background())
 
 	// ACL Facade (expuesto a otros bounded contexts)
-	iamFacade := iamACLImpl.NewIAMContextFacade(jwtService, userRepo)
+	iamFacade := iamACLImpl.NewIAMContextFacade(jwtService, userRepo, apiKeyRepo)
 
 	// External Services (ACL for own middleware)
 	externalAuthService := mortgageACL.NewExternalAuthenticationService(iamFacade)
 
 	// Middleware
 	authMiddleware := mortgageMiddleware.JWTAuthMiddleware(externalAuthService)
+	userSession := mortgageMiddleware.RequireUserSession()
 
 	// Controllers
 	userController := iamControllers.NewUserController(userCommandService, userQueryService, authService)
+	mfaController := iamControllers.NewMFAController(mfaCommandService, authService, userQueryService)
+	jwksController := iamControllers.NewJWKSController(signingKeyQueryService)
+	apiKeyController := iamControllers.NewAPIKeyController(apiKeyCommandService, apiKeyQueryService)
+	accountController := iamControllers.NewAccountController(accountCommandService, accountQueryService)
+
+	// JWKS público para que otros servicios validen tokens sin compartir secretos
+	router.GET("/.well-known/jwks.json", jwksController.GetJWKS)
 
 	// Routes
 	iamGroup := router.Group("/api/v1/iam")
 	{
 		iamGroup.POST("/register", userController.Register)
 		iamGroup.POST("/login", userController.Login)
-
-		// Protected routes
-		iamGroup.PUT("/password", authMiddleware, userController.UpdatePassword)
+		iamGroup.POST("/login/mfa", mfaController.VerifyLogin)
+
+		// Protected routes (solo sesión de usuario, no API keys)
+		iamGroup.PUT("/password", authMiddleware, userSession, userController.UpdatePassword)
+		iamGroup.POST("/mfa/enroll", authMiddleware, userSession, mfaController.Enroll)
+		iamGroup.POST("/mfa/confirm", authMiddleware, userSession, mfaController.Confirm)
+		iamGroup.POST("/mfa/disable", authMiddleware, userSession, mfaController.Disable)
+		iamGroup.POST("/api-keys", authMiddleware, userSession, apiKeyController.CreateAPIKey)
+		iamGroup.GET("/api-keys", authMiddleware, userSession, apiKeyController.ListAPIKeys)
+		iamGroup.DELETE("/api-keys/:id", authMiddleware, userSession, apiKeyController.RevokeAPIKey)
+		iamGroup.GET("/account/export", authMiddleware, userSession, accountController.ExportData)
+		iamGroup.POST("/account/deletion", authMiddleware, userSession, accountController.RequestDeletion)
+		iamGroup.DELETE("/account/deletion", authMiddleware, userSession, accountController.CancelDeletion)
 	}
 
 	return iamFacade
 }
 
-func setupMortgageContext(router *gin.Engine, db *gorm.DB, iamFacade iamACL.IAMContextFacade) {
+func setupMortgageContext(router *gin.Engine, db *gorm.DB, cfg *config.Config, iamFacade iamACL.IAMContextFacade, profileFacade profileACL.ProfileContextFacade, exchangeRateFacade exchangeRateACL.ExchangeRateContextFacade) {
 	// External Services (ACL)
 	externalAuthService := mortgageACL.NewExternalAuthenticationService(iamFacade)
+	externalExchangeRateService := mortgageACL.NewExternalExchangeRateService(exchangeRateFacade)
+	externalProfileService := mortgageACL.NewExternalProfileService(profileFacade, externalExchangeRateService)
 
 	// Middleware
 	authMiddleware := mortgageMiddleware.JWTAuthMiddleware(externalAuthService)
+	adminOnly := mortgageMiddleware.RequireAdmin(externalAuthService, strings.Split(cfg.Admin.Emails, ","))
 
 	// Repositories
 	mortgageRepo := mortgageRepos.NewMortgageRepository(db)
+	productRepo := mortgageRepos.NewLenderProductRepository(db)
+	offerRepo := mortgageRepos.NewLenderOfferFileRepository(cfg.Mortgage.OffersFile)
+	indexRepo := mortgageRepos.NewPriceIndexRepository(db)
+	paymentRepo := mortgageRepos.NewMortgagePaymentRepository(db)
 
 	// Services
-	mortgageCommandService := mortgageCommandServices.NewMortgageCommandService(mortgageRepo)
-	mortgageQueryService := mortgageQueryServices.NewMortgageQueryService(mortgageRepo)
+	mortgageCommandService := mortgageCommandServices.NewMortgageCommandService(mortgageRepo, productRepo, indexRepo, paymentRepo, externalProfileService)
+	mortgageQueryService := mortgageQueryServices.NewMortgageQueryService(mortgageRepo, paymentRepo)
+	productCommandService := mortgageCommandServices.NewLenderProductCommandService(productRepo)
+	productQueryService := mortgageQueryServices.NewLenderProductQueryService(productRepo)
+	quoteService := mortgageCommandServices.NewMortgageQuoteService(offerRepo, externalProfileService)
+	indexCommandService := mortgageCommandServices.NewPriceIndexCommandService(indexRepo)
+	indexQueryService := mortgageQueryServices.NewPriceIndexQueryService(indexRepo)
+	paymentCommandService := mortgageCommandServices.NewMortgagePaymentCommandService(mortgageRepo, paymentRepo)
+	paymentQueryService := mortgageQueryServices.NewMortgagePaymentQueryService(mortgageRepo, paymentRepo)
+
+	// Background job: importación de la tabla de índices VAC/IPC
+	if cfg.Mortgage.IndexFile != "" && cfg.Mortgage.IndexImportMins > 0 {
+		importInterval := time.Minute * time.Duration(cfg.Mortgage.IndexImportMins)
+		go mortgageJobs.NewPriceIndexImportJob(indexCommandService, cfg.Mortgage.IndexFile, importInterval).Start(context.Background())
+	}
 
 	// Controllers
-	mortgageController := mortgageControllers.NewMortgageController(mortgageCommandService, mortgageQueryService)
+	mortgageController := mortgageControllers.NewMortgageController(mortgageCommandService, mortgageQueryService, externalExchangeRateService)
+	productController := mortgageControllers.NewLenderProductController(productCommandService, productQueryService)
+	quoteController := mortgageControllers.NewMortgageQuoteController(quoteService)
+	indexController := mortgageControllers.NewPriceIndexController(indexQueryService)
+	paymentController := mortgageControllers.NewMortgagePaymentController(paymentCommandService, paymentQueryService)
+
+	// Scopes requeridos cuando se accede con API key
+	canRead := mortgageMiddleware.RequireScope(iamValueObjects.ScopeMortgageRead)
+	canWrite := mortgageMiddleware.RequireScope(iamValueObjects.ScopeMortgageWrite)
 
-	// Routes - Mortgage (todas protegidas con JWT)
+	// Routes - Mortgage (todas protegidas con JWT o API key)
 	mortgageGroup := router.Group("/api/v1/mortgage")
 	mortgageGroup.Use(authMiddleware) // Aplicar middleware a todas las rutas
 	{
-		mortgageGroup.POST("/calculate", mortgageController.CalculateMortgage)
-		mortgageGroup.GET("/:id", mortgageController.GetMortgageByID)
-		mortgageGroup.PUT("/:id", mortgageController.UpdateMortgage)
-		mortgageGroup.DELETE("/:id", mortgageController.DeleteMortgage)
-		mortgageGroup.GET("/history", mortgageController.GetMortgageHistory)
+		mortgageGroup.POST("/calculate", canWrite, mortgageController.CalculateMortgage)
+		mortgageGroup.POST("/quote", canWrite, quoteController.QuoteMortgage)
+		mortgageGroup.GET("/:id", canRead, mortgageController.GetMortgageByID)
+		mortgageGroup.PUT("/:id", canWrite, mortgageController.UpdateMortgage)
+		mortgageGroup.DELETE("/:id", canWrite, mortgageController.DeleteMortgage)
+		mortgageGroup.GET("/history", canRead, mortgageController.GetMortgageHistory)
+		mortgageGroup.POST("/:id/refinance-analysis", canRead, mortgageController.AnalyzeRefinance)
+		mortgageGroup.POST("/:id/reprogram", canWrite, mortgageController.ReprogramMortgage)
+		mortgageGroup.GET("/:id/reprogramming", canRead, mortgageController.GetReprogramming)
+		mortgageGroup.GET("/:id/payoff", canRead, mortgageController.GetPayoffQuote)
+		mortgageGroup.GET("/:id/versions", canRead, mortgageController.ListVersions)
+		mortgageGroup.GET("/:id/versions/compare", canRead, mortgageController.CompareVersions)
+		mortgageGroup.GET("/:id/versions/:version", canRead, mortgageController.GetVersion)
+		mortgageGroup.GET("/:id/recompute", canRead, mortgageController.RecomputeMortgage)
+
+		// Seguimiento de pagos reales del crédito desembolsado
+		mortgageGroup.PUT("/:id/disbursement", canWrite, paymentController.RegisterDisbursement)
+		mortgageGroup.POST("/:id/payments", canWrite, paymentController.RecordPayment)
+		mortgageGroup.GET("/:id/payments", canRead, paymentController.ListPayments)
+		mortgageGroup.DELETE("/:id/payments/:paymentId", canWrite, paymentController.DeletePayment)
+		mortgageGroup.GET("/:id/statement", canRead, paymentController.GetStatement)
+	}
+
+	// Routes - Catálogo de productos (consulta para usuarios, gestión solo para administradores)
+	productGroup := router.Group("/api/v1/lender-products")
+	productGroup.Use(authMiddleware)
+	{
+		productGroup.GET("", canRead, productController.ListProducts)
+		productGroup.GET("/:id", canRead, productController.GetProduct)
+		productGroup.POST("", adminOnly, productController.CreateProduct)
+		productGroup.PUT("/:id", adminOnly, productController.UpdateProduct)
+		productGroup.DELETE("/:id", adminOnly, productController.DeactivateProduct)
 	}
+
+	// Routes - Índices VAC/IPC de los créditos en Soles VAC
+	router.GET("/api/v1/price-index", authMiddleware, canRead, indexController.ListPriceIndex)
 }
 
-func setupProfileContext(router *gin.Engine, db *gorm.DB, cfg *config.Config) profileACL.ProfileContextFacade {
-	// Initialize encryption service
-	encryptionService, err := security.NewEncryptionService(cfg.Encryption.Key)
-	if err != nil {
-		log.Fatalf("Failed to initialize encryption service: %v", err)
+func setupExchangeRateContext(router *gin.Engine, db *gorm.DB, cfg config.ExchangeRateConfig, iamFacade iamACL.IAMContextFacade) {
+	// External Services (ACL)
+	externalAuthService := mortgageACL.NewExternalAuthenticationService(iamFacade)
+
+	// Middleware
+	authMiddleware := mortgageMiddleware.JWTAuthMiddleware(externalAuthService)
+
+	// Repositories
+	exchangeRateRepo := exchangeRateRepos.NewExchangeRateRepository(db)
+
+	// Services
+	exchangeRateCommandService := exchangeRateCommandServices.NewExchangeRateCommandService(exchangeRateRepo)
+	exchangeRateQueryService := exchangeRateQueryServices.NewExchangeRateQueryService(exchangeRateRepo)
+
+	// Background job: importación de la tabla manual y de las descargas de la SBS/BCRP
+	rateFiles := make([]exchangeRateJobs.RateFile, 0, 3)
+	for _, file := range []exchangeRateJobs.RateFile{
+		{Path: cfg.ManualFile, Source: "MANUAL"},
+		{Path: cfg.SBSFile, Source: "SBS"},
+		{Path: cfg.BCRPFile, Source: "BCRP"},
+	} {
+		if file.Path != "" {
+			rateFiles = append(rateFiles, file)
+		}
+	}
+	if len(rateFiles) > 0 && cfg.ImportMins > 0 {
+		importInterval := time.Minute * time.Duration(cfg.ImportMins)
+		go exchangeRateJobs.NewExchangeRateImportJob(exchangeRateCommandService, rateFiles, importInterval).Start(context.Background())
 	}
 
+	// Controllers
+	exchangeRateController := exchangeRateControllers.NewExchangeRateController(exchangeRateQueryService, cfg.MaxAgeDays)
+
+	// Routes - Exchange rates (lectura para cualquier usuario autenticado)
+	exchangeRateGroup := router.Group("/api/v1/exchange-rates")
+	exchangeRateGroup.Use(authMiddleware)
+	{
+		exchangeRateGroup.GET("", exchangeRateController.ListRates)
+		exchangeRateGroup.GET("/current", exchangeRateController.GetCurrentRate)
+		exchangeRateGroup.GET("/convert", exchangeRateController.Convert)
+	}
+}
+
+func setupProfileContext(router *gin.Engine, db *gorm.DB, encryptionService *security.EncryptionService, blindIndexService *security.BlindIndexService, profileEncryptedFields []string, identityProvider identity.Provider, jwtService *iamSecurity.JWTService, exchangeRateFacade exchangeRateACL.ExchangeRateContextFacade) profileACL.ProfileContextFacade {
+	// External Services (ACL)
+	externalExchangeRateService := profileACLImpl.NewExternalExchangeRateService(exchangeRateFacade)
+
 	// Repositories
-	profileRepo := profileRepos.NewProfileRepository(db, encryptionService)
+	profileRepo := profileRepos.NewProfileRepository(db, encryptionService, blindIndexService, profileEncryptedFields)
+	coBorrowerRepo := profileRepos.NewCoBorrowerRepository(db, encryptionService, blindIndexService)
+	obligationRepo := profileRepos.NewFinancialObligationRepository(db)
 
 	// ACL Facade (expuesto a otros bounded contexts)
-	profileFacade := profileACLImpl.NewProfileContextFacade(profileRepo)
+	profileFacade := profileACLImpl.NewProfileContextFacade(profileRepo, coBorrowerRepo, obligationRepo, externalExchangeRateService)
 
 	// External Services (ACL) - Necesitamos IAM facade temporalmente
 	// NOTA: Este es un acoplamiento temporal para el middleware
 	// En producción, el middleware debería estar en IAM o en un contexto compartido
 	iamFacade := iamACLImpl.NewIAMContextFacade(
-		iamSecurity.NewJWTService(cfg.JWT.SecretKey, cfg.JWT.Issuer, cfg.JWT.ExpirationHrs),
-		iamRepos.NewUserRepository(db),
+		jwtService,
+		iamRepos.NewUserRepository(db, encryptionService),
+		iamRepos.NewAPIKeyRepository(db),
 	)
 	externalAuthService := mortgageACL.NewExternalAuthenticationService(iamFacade)
 
 	// Middleware
 	authMiddleware := mortgageMiddleware.JWTAuthMiddleware(externalAuthService)
 
-	// External Services
-	reniecService := profileExternal.NewReniecService(cfg.Reniec.APIKey)
-
 	// Services
-	profileCommandService := profileCommandServices.NewProfileCommandService(profileRepo, reniecService)
+	profileCommandService := profileCommandServices.NewProfileCommandService(profileRepo, identityProvider)
 	profileQueryService := profileQueryServices.NewProfileQueryService(profileRepo)
+	coBorrowerCommandService := profileCommandServices.NewCoBorrowerCommandService(profileRepo, coBorrowerRepo, identityProvider)
+	coBorrowerQueryService := profileQueryServices.NewCoBorrowerQueryService(profileRepo, coBorrowerRepo)
+	obligationCommandService := profileCommandServices.NewFinancialObligationCommandService(profileRepo, obligationRepo)
+	obligationQueryService := profileQueryServices.NewFinancialObligationQueryService(profileRepo, obligationRepo, externalExchangeRateService)
 
 	// Controllers
 	profileController := profileControllers.NewProfileController(profileCommandService, profileQueryService)
+	coBorrowerController := profileControllers.NewCoBorrowerController(coBorrowerCommandService, coBorrowerQueryService)
+	obligationController := profileControllers.NewFinancialObligationController(obligationCommandService, obligationQueryService)
 
 	// Routes - Profile
 	profileGroup := router.Group("/api/v1/profile")
 	{
 		// Protected routes
-		profileGroup.GET("", authMiddleware, profileController.GetProfile)
-		profileGroup.PUT("", authMiddleware, profileController.UpdateProfile)
+		profileGroup.GET("", authMiddleware, mortgageMiddleware.RequireScope(iamValueObjects.ScopeProfileRead), profileController.GetProfile)
+		profileGroup.PUT("", authMiddleware, mortgageMiddleware.RequireScope(iamValueObjects.ScopeProfileWrite), profileController.UpdateProfile)
+
+		// Co-prestatarios (crédito mancomunado)
+		profileGroup.GET("/co-borrowers", authMiddleware, mortgageMiddleware.RequireScope(iamValueObjects.ScopeProfileRead), coBorrowerController.GetCoBorrowers)
+		profileGroup.POST("/co-borrowers", authMiddleware, mortgageMiddleware.RequireScope(iamValueObjects.ScopeProfileWrite), coBorrowerController.AddCoBorrower)
+		profileGroup.PUT("/co-borrowers/:id", authMiddleware, mortgageMiddleware.RequireScope(iamValueObjects.ScopeProfileWrite), coBorrowerController.UpdateCoBorrower)
+		profileGroup.DELETE("/co-borrowers/:id", authMiddleware, mortgageMiddleware.RequireScope(iamValueObjects.ScopeProfileWrite), coBorrowerController.RemoveCoBorrower)
+
+		// Deudas y gastos fijos; situación financiera del hogar
+		profileGroup.GET("/obligations", authMiddleware, mortgageMiddleware.RequireScope(iamValueObjects.ScopeProfileRead), obligationController.GetObligations)
+		profileGroup.POST("/obligations", authMiddleware, mortgageMiddleware.RequireScope(iamValueObjects.ScopeProfileWrite), obligationController.AddObligation)
+		profileGroup.PUT("/obligations/:id", authMiddleware, mortgageMiddleware.RequireScope(iamValueObjects.ScopeProfileWrite), obligationController.UpdateObligation)
+		profileGroup.DELETE("/obligations/:id", authMiddleware, mortgageMiddleware.RequireScope(iamValueObjects.ScopeProfileWrite), obligationController.RemoveObligation)
+		profileGroup.GET("/financial-snapshot", authMiddleware, mortgageMiddleware.RequireScope(iamValueObjects.ScopeProfileRead), obligationController.GetFinancialSnapshot)
 	}
 
 	return profileFacade
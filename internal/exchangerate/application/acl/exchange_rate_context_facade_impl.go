@@ -0,0 +1,63 @@
+package acl
+
+import (
+	"context"
+	"time"
+
+	"finanzas-backend/internal/exchangerate/domain/model/queries"
+	"finanzas-backend/internal/exchangerate/domain/model/valueobjects"
+	"finanzas-backend/internal/exchangerate/domain/services"
+	"finanzas-backend/internal/exchangerate/interfaces/acl"
+)
+
+type exchangeRateContextFacadeImpl struct {
+	queryService services.ExchangeRateQueryService
+	maxAgeDays   int
+}
+
+// NewExchangeRateContextFacade crea el facade; un tipo de cambio con más de maxAgeDays días no se usa (0 lo permite siempre)
+func NewExchangeRateContextFacade(queryService services.ExchangeRateQueryService, maxAgeDays int) acl.ExchangeRateContextFacade {
+	return &exchangeRateContextFacadeImpl{
+		queryService: queryService,
+		maxAgeDays:   maxAgeDays,
+	}
+}
+
+func (f *exchangeRateContextFacadeImpl) GetCurrentRate(ctx context.Context) (*acl.ExchangeRateData, error) {
+	now := time.Now()
+	rate, err := f.queryService.HandleGetRate(ctx, queries.NewGetExchangeRateQuery(now))
+	if err != nil || rate == nil || rate.IsStale(now, f.maxAgeDays) {
+		return nil, err
+	}
+
+	return &acl.ExchangeRateData{
+		Date:   rate.Date(),
+		Buy:    rate.Buy(),
+		Sell:   rate.Sell(),
+		Source: rate.Source().String(),
+	}, nil
+}
+
+func (f *exchangeRateContextFacadeImpl) Convert(ctx context.Context, amount float64, from, to string) (float64, bool, error) {
+	fromCurrency, err := valueobjects.NewCurrency(from)
+	if err != nil {
+		return 0, false, err
+	}
+	toCurrency, err := valueobjects.NewCurrency(to)
+	if err != nil {
+		return 0, false, err
+	}
+	if fromCurrency == toCurrency {
+		return amount, true, nil
+	}
+
+	now := time.Now()
+	rate, err := f.queryService.HandleGetRate(ctx, queries.NewGetExchangeRateQuery(now))
+	if err != nil {
+		return 0, false, err
+	}
+	if rate == nil || rate.IsStale(now, f.maxAgeDays) {
+		return 0, false, nil
+	}
+	return rate.Convert(amount, fromCurrency, toCurrency), true, nil
+}
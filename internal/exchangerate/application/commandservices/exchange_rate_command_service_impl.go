@@ -0,0 +1,38 @@
+package commandservices
+
+import (
+	"context"
+	"fmt"
+
+	"finanzas-backend/internal/exchangerate/domain/model/commands"
+	"finanzas-backend/internal/exchangerate/domain/model/entities"
+	"finanzas-backend/internal/exchangerate/domain/repositories"
+	"finanzas-backend/internal/exchangerate/domain/services"
+)
+
+type exchangeRateCommandServiceImpl struct {
+	repo repositories.ExchangeRateRepository
+}
+
+func NewExchangeRateCommandService(repo repositories.ExchangeRateRepository) services.ExchangeRateCommandService {
+	return &exchangeRateCommandServiceImpl{repo: repo}
+}
+
+// HandleRecord valida todas las filas antes de guardar, de modo que un archivo con errores no se importe a medias
+func (s *exchangeRateCommandServiceImpl) HandleRecord(ctx context.Context, cmd commands.RecordExchangeRatesCommand) (int, error) {
+	rates := make([]*entities.ExchangeRate, 0, len(cmd.Entries()))
+	for _, entry := range cmd.Entries() {
+		rate, err := entities.NewExchangeRate(entry.Date, entry.Buy, entry.Sell, cmd.Source())
+		if err != nil {
+			return 0, fmt.Errorf("exchange rate for %s: %w", entry.Date.Format("2006-01-02"), err)
+		}
+		rates = append(rates, rate)
+	}
+
+	for i, rate := range rates {
+		if err := s.repo.Upsert(ctx, rate); err != nil {
+			return i, err
+		}
+	}
+	return len(rates), nil
+}
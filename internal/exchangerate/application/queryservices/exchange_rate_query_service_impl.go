@@ -0,0 +1,30 @@
+package queryservices
+
+import (
+	"context"
+
+	"finanzas-backend/internal/exchangerate/domain/model/entities"
+	"finanzas-backend/internal/exchangerate/domain/model/queries"
+	"finanzas-backend/internal/exchangerate/domain/repositories"
+	"finanzas-backend/internal/exchangerate/domain/services"
+)
+
+type exchangeRateQueryServiceImpl struct {
+	repo repositories.ExchangeRateRepository
+}
+
+func NewExchangeRateQueryService(repo repositories.ExchangeRateRepository) services.ExchangeRateQueryService {
+	return &exchangeRateQueryServiceImpl{repo: repo}
+}
+
+func (s *exchangeRateQueryServiceImpl) HandleGetRate(ctx context.Context, query queries.GetExchangeRateQuery) (*entities.ExchangeRate, error) {
+	rates, err := s.repo.FindLatestOnOrBefore(ctx, query.Date())
+	if err != nil {
+		return nil, err
+	}
+	return entities.PreferredExchangeRate(rates), nil
+}
+
+func (s *exchangeRateQueryServiceImpl) HandleList(ctx context.Context, query queries.ListExchangeRatesQuery) ([]*entities.ExchangeRate, error) {
+	return s.repo.FindBetween(ctx, query.From(), query.To())
+}
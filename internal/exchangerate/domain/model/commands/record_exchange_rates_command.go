@@ -0,0 +1,37 @@
+package commands
+
+import (
+	"errors"
+	"time"
+
+	"finanzas-backend/internal/exchangerate/domain/model/valueobjects"
+)
+
+// ExchangeRateEntry es una fila de la tabla de tipos de cambio (S/ por US$)
+type ExchangeRateEntry struct {
+	Date time.Time
+	Buy  float64
+	Sell float64
+}
+
+// RecordExchangeRatesCommand registra tipos de cambio de una misma fuente;
+// un día ya registrado para esa fuente se sobrescribe
+type RecordExchangeRatesCommand struct {
+	source  valueobjects.RateSource
+	entries []ExchangeRateEntry
+}
+
+func NewRecordExchangeRatesCommand(source string, entries []ExchangeRateEntry) (RecordExchangeRatesCommand, error) {
+	rateSource, err := valueobjects.NewRateSource(source)
+	if err != nil {
+		return RecordExchangeRatesCommand{}, err
+	}
+	if len(entries) == 0 {
+		return RecordExchangeRatesCommand{}, errors.New("at least one exchange rate is required")
+	}
+
+	return RecordExchangeRatesCommand{source: rateSource, entries: entries}, nil
+}
+
+func (c RecordExchangeRatesCommand) Source() valueobjects.RateSource { return c.source }
+func (c RecordExchangeRatesCommand) Entries() []ExchangeRateEntry    { return c.entries }
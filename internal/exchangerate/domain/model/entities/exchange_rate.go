@@ -0,0 +1,90 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"finanzas-backend/internal/exchangerate/domain/model/valueobjects"
+)
+
+// ExchangeRate es el tipo de cambio de un día en soles por dólar (S/ por US$).
+// Compra se usa para convertir dólares a soles y venta para convertir soles a dólares.
+type ExchangeRate struct {
+	date      time.Time
+	buy       float64
+	sell      float64
+	source    valueobjects.RateSource
+	updatedAt time.Time
+}
+
+func NewExchangeRate(date time.Time, buy, sell float64, source valueobjects.RateSource) (*ExchangeRate, error) {
+	if date.IsZero() {
+		return nil, errors.New("exchange rate date is required")
+	}
+	if buy <= 0 || sell <= 0 {
+		return nil, errors.New("exchange rates must be greater than 0")
+	}
+	if buy > sell {
+		return nil, errors.New("buy rate cannot exceed sell rate")
+	}
+
+	return &ExchangeRate{
+		date:      TruncateToDate(date),
+		buy:       buy,
+		sell:      sell,
+		source:    source,
+		updatedAt: time.Now(),
+	}, nil
+}
+
+func ReconstructExchangeRate(date time.Time, buy, sell float64, source valueobjects.RateSource, updatedAt time.Time) *ExchangeRate {
+	return &ExchangeRate{
+		date:      TruncateToDate(date),
+		buy:       buy,
+		sell:      sell,
+		source:    source,
+		updatedAt: updatedAt,
+	}
+}
+
+func (r *ExchangeRate) Date() time.Time                 { return r.date }
+func (r *ExchangeRate) Buy() float64                    { return r.buy }
+func (r *ExchangeRate) Sell() float64                   { return r.sell }
+func (r *ExchangeRate) Source() valueobjects.RateSource { return r.source }
+func (r *ExchangeRate) UpdatedAt() time.Time            { return r.updatedAt }
+
+// Convert expresa amount en la moneda to: USD→PEN multiplica por la compra, PEN→USD divide entre la venta
+func (r *ExchangeRate) Convert(amount float64, from, to valueobjects.Currency) float64 {
+	switch {
+	case from == to:
+		return amount
+	case from == valueobjects.CurrencyUSD && to == valueobjects.CurrencyPEN:
+		return amount * r.buy
+	default:
+		return amount / r.sell
+	}
+}
+
+// IsStale indica si el tipo de cambio tiene más de maxAgeDays días respecto de asOf (0 desactiva el control)
+func (r *ExchangeRate) IsStale(asOf time.Time, maxAgeDays int) bool {
+	if maxAgeDays <= 0 {
+		return false
+	}
+	return TruncateToDate(asOf).Sub(r.date) > time.Duration(maxAgeDays)*24*time.Hour
+}
+
+// PreferredExchangeRate elige, entre los tipos de cambio de un mismo día, el de la fuente con mayor prioridad
+func PreferredExchangeRate(rates []*ExchangeRate) *ExchangeRate {
+	var preferred *ExchangeRate
+	for _, rate := range rates {
+		if preferred == nil || rate.source.Priority() > preferred.source.Priority() {
+			preferred = rate
+		}
+	}
+	return preferred
+}
+
+// TruncateToDate normaliza una fecha a medianoche UTC; los tipos de cambio son diarios
+func TruncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,19 @@
+package queries
+
+import "time"
+
+// GetExchangeRateQuery busca el tipo de cambio vigente a una fecha: el último publicado en o antes de ella
+type GetExchangeRateQuery struct {
+	date time.Time
+}
+
+func NewGetExchangeRateQuery(date time.Time) GetExchangeRateQuery {
+	if date.IsZero() {
+		date = time.Now()
+	}
+	return GetExchangeRateQuery{date: date}
+}
+
+func (q GetExchangeRateQuery) Date() time.Time {
+	return q.date
+}
@@ -0,0 +1,27 @@
+package queries
+
+import (
+	"errors"
+	"time"
+)
+
+// maxListDays acota el rango de fechas de una consulta de tipos de cambio
+const maxListDays = 366
+
+type ListExchangeRatesQuery struct {
+	from time.Time
+	to   time.Time
+}
+
+func NewListExchangeRatesQuery(from, to time.Time) (ListExchangeRatesQuery, error) {
+	if from.After(to) {
+		return ListExchangeRatesQuery{}, errors.New("from date cannot be after to date")
+	}
+	if to.Sub(from) > maxListDays*24*time.Hour {
+		return ListExchangeRatesQuery{}, errors.New("date range cannot exceed 366 days")
+	}
+	return ListExchangeRatesQuery{from: from, to: to}, nil
+}
+
+func (q ListExchangeRatesQuery) From() time.Time { return q.from }
+func (q ListExchangeRatesQuery) To() time.Time   { return q.to }
@@ -0,0 +1,44 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+)
+
+// RateSource es el origen de un tipo de cambio: la tabla mantenida manualmente
+// o los archivos diarios publicados por la SBS o el BCRP
+type RateSource string
+
+const (
+	RateSourceManual RateSource = "MANUAL"
+	RateSourceSBS    RateSource = "SBS"
+	RateSourceBCRP   RateSource = "BCRP"
+)
+
+func NewRateSource(value string) (RateSource, error) {
+	source := RateSource(strings.ToUpper(strings.TrimSpace(value)))
+	switch source {
+	case RateSourceManual, RateSourceSBS, RateSourceBCRP:
+		return source, nil
+	default:
+		return "", errors.New("invalid exchange rate source, must be MANUAL, SBS or BCRP")
+	}
+}
+
+// Priority ordena las fuentes de un mismo día: el valor manual prevalece sobre los importados
+func (s RateSource) Priority() int {
+	switch s {
+	case RateSourceManual:
+		return 3
+	case RateSourceSBS:
+		return 2
+	case RateSourceBCRP:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (s RateSource) String() string {
+	return string(s)
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"finanzas-backend/internal/exchangerate/domain/model/entities"
+)
+
+type ExchangeRateRepository interface {
+	// Upsert registra el tipo de cambio del día para su fuente, sobrescribiendo el existente
+	Upsert(ctx context.Context, rate *entities.ExchangeRate) error
+
+	// FindLatestOnOrBefore retorna los tipos de cambio (uno por fuente) del último día registrado
+	// en o antes de date; vacío si no hay ninguno
+	FindLatestOnOrBefore(ctx context.Context, date time.Time) ([]*entities.ExchangeRate, error)
+
+	// FindBetween retorna los tipos de cambio registrados entre from y to (inclusive), ordenados por fecha
+	FindBetween(ctx context.Context, from, to time.Time) ([]*entities.ExchangeRate, error)
+}
@@ -0,0 +1,12 @@
+package services
+
+import (
+	"context"
+
+	"finanzas-backend/internal/exchangerate/domain/model/commands"
+)
+
+type ExchangeRateCommandService interface {
+	// HandleRecord registra los tipos de cambio del comando y retorna cuántos se guardaron
+	HandleRecord(ctx context.Context, cmd commands.RecordExchangeRatesCommand) (int, error)
+}
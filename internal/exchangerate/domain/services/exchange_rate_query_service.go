@@ -0,0 +1,14 @@
+package services
+
+import (
+	"context"
+
+	"finanzas-backend/internal/exchangerate/domain/model/entities"
+	"finanzas-backend/internal/exchangerate/domain/model/queries"
+)
+
+type ExchangeRateQueryService interface {
+	// HandleGetRate retorna el tipo de cambio vigente a la fecha de la consulta, o nil si no hay
+	HandleGetRate(ctx context.Context, query queries.GetExchangeRateQuery) (*entities.ExchangeRate, error)
+	HandleList(ctx context.Context, query queries.ListExchangeRatesQuery) ([]*entities.ExchangeRate, error)
+}
@@ -0,0 +1,120 @@
+package importers
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"finanzas-backend/internal/exchangerate/domain/model/commands"
+)
+
+// bcrpMonths son las abreviaturas de mes de las series del BCRP ("02.Ene.24")
+var bcrpMonths = map[string]time.Month{
+	"ene": time.January, "feb": time.February, "mar": time.March, "abr": time.April,
+	"may": time.May, "jun": time.June, "jul": time.July, "ago": time.August,
+	"set": time.September, "sep": time.September, "oct": time.October, "nov": time.November, "dic": time.December,
+}
+
+// ParseRateFile lee un archivo de tipos de cambio con columnas fecha, compra y venta (S/ por US$).
+// Acepta la tabla manual (2024-01-02,3.70,3.72), la descarga de la SBS (02/01/2024;3,700;3,720)
+// y la serie del BCRP ("02.Ene.24","3.700","3.720"). Se omiten los encabezados y los días sin dato (n.d.).
+func ParseRateFile(r io.Reader) ([]commands.ExchangeRateEntry, error) {
+	scanner := bufio.NewScanner(r)
+	entries := make([]commands.ExchangeRateEntry, 0)
+	lineNumber := 0
+
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(strings.TrimPrefix(scanner.Text(), "\ufeff"))
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := splitFields(line)
+		if len(fields) < 3 {
+			return nil, fmt.Errorf("line %d: expected date, buy and sell columns", lineNumber)
+		}
+
+		date, err := parseDate(fields[0])
+		if err != nil {
+			if len(entries) == 0 {
+				continue // Encabezado
+			}
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+
+		if isMissing(fields[1]) || isMissing(fields[2]) {
+			continue
+		}
+		buy, err := parseRate(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid buy rate: %w", lineNumber, err)
+		}
+		sell, err := parseRate(fields[2])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid sell rate: %w", lineNumber, err)
+		}
+
+		entries = append(entries, commands.ExchangeRateEntry{Date: date, Buy: buy, Sell: sell})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, errors.New("file has no exchange rates")
+	}
+	return entries, nil
+}
+
+// splitFields separa por punto y coma, tabulador o coma (en ese orden de preferencia)
+func splitFields(line string) []string {
+	separator := ","
+	if strings.Contains(line, ";") {
+		separator = ";"
+	} else if strings.Contains(line, "\t") {
+		separator = "\t"
+	}
+
+	fields := strings.Split(line, separator)
+	for i, field := range fields {
+		fields[i] = strings.Trim(strings.TrimSpace(field), `"`)
+	}
+	return fields
+}
+
+func parseDate(value string) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02", "02/01/2006"} {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+
+	parts := strings.Split(value, ".")
+	if len(parts) == 3 {
+		month, ok := bcrpMonths[strings.ToLower(parts[1])]
+		day, dayErr := strconv.Atoi(parts[0])
+		year, yearErr := strconv.Atoi(parts[2])
+		if ok && dayErr == nil && yearErr == nil {
+			if year < 100 {
+				year += 2000
+			}
+			return time.Date(year, month, day, 0, 0, 0, 0, time.UTC), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
+
+// parseRate acepta punto o coma decimal
+func parseRate(value string) (float64, error) {
+	return strconv.ParseFloat(strings.Replace(value, ",", ".", 1), 64)
+}
+
+func isMissing(value string) bool {
+	value = strings.ToLower(value)
+	return value == "" || value == "n.d." || value == "nd" || value == "-"
+}
@@ -0,0 +1,92 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"finanzas-backend/internal/exchangerate/domain/model/commands"
+	"finanzas-backend/internal/exchangerate/domain/services"
+	"finanzas-backend/internal/exchangerate/infrastructure/importers"
+)
+
+// RateFile es un archivo de tipos de cambio y la fuente con la que se registran sus filas
+type RateFile struct {
+	Path   string
+	Source string
+}
+
+// ExchangeRateImportJob importa periódicamente los archivos de tipos de cambio configurados
+// (tabla manual y descargas de la SBS o el BCRP); un archivo solo se relee si cambió
+type ExchangeRateImportJob struct {
+	commandService services.ExchangeRateCommandService
+	files          []RateFile
+	interval       time.Duration
+	imported       map[string]time.Time
+}
+
+func NewExchangeRateImportJob(commandService services.ExchangeRateCommandService, files []RateFile, interval time.Duration) *ExchangeRateImportJob {
+	return &ExchangeRateImportJob{
+		commandService: commandService,
+		files:          files,
+		interval:       interval,
+		imported:       make(map[string]time.Time),
+	}
+}
+
+// Start importa al iniciar y luego cada intervalo, hasta que ctx se cancele
+func (j *ExchangeRateImportJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.run(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *ExchangeRateImportJob) run(ctx context.Context) {
+	for _, file := range j.files {
+		info, err := os.Stat(file.Path)
+		if err != nil {
+			log.Printf("Exchange rate import: cannot read %s: %v", file.Path, err)
+			continue
+		}
+		if modified, ok := j.imported[file.Path]; ok && !info.ModTime().After(modified) {
+			continue
+		}
+
+		imported, err := j.importFile(ctx, file)
+		if err != nil {
+			log.Printf("Exchange rate import of %s failed: %v", file.Path, err)
+			continue
+		}
+		j.imported[file.Path] = info.ModTime()
+		log.Printf("Exchange rate import: %d %s rate(s) loaded from %s", imported, file.Source, file.Path)
+	}
+}
+
+func (j *ExchangeRateImportJob) importFile(ctx context.Context, file RateFile) (int, error) {
+	f, err := os.Open(file.Path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	entries, err := importers.ParseRateFile(f)
+	if err != nil {
+		return 0, err
+	}
+
+	cmd, err := commands.NewRecordExchangeRatesCommand(file.Source, entries)
+	if err != nil {
+		return 0, err
+	}
+	return j.commandService.HandleRecord(ctx, cmd)
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"finanzas-backend/internal/exchangerate/domain/model/entities"
+	"finanzas-backend/internal/exchangerate/domain/model/valueobjects"
+)
+
+type ExchangeRateModel struct {
+	ID        uint64    `gorm:"primaryKey;autoIncrement;column:id"`
+	Date      time.Time `gorm:"type:date;not null;uniqueIndex:idx_exchange_rates_date_source;column:date"`
+	Source    string    `gorm:"type:varchar(10);not null;uniqueIndex:idx_exchange_rates_date_source;column:source"`
+	Buy       float64   `gorm:"type:decimal(10,4);not null;column:buy"`
+	Sell      float64   `gorm:"type:decimal(10,4);not null;column:sell"`
+	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;column:updated_at"`
+}
+
+func (ExchangeRateModel) TableName() string {
+	return "exchange_rates"
+}
+
+func (m *ExchangeRateModel) ToEntity() (*entities.ExchangeRate, error) {
+	source, err := valueobjects.NewRateSource(m.Source)
+	if err != nil {
+		return nil, err
+	}
+	return entities.ReconstructExchangeRate(m.Date, m.Buy, m.Sell, source, m.UpdatedAt), nil
+}
+
+func ExchangeRateFromEntity(rate *entities.ExchangeRate) *ExchangeRateModel {
+	return &ExchangeRateModel{
+		Date:      rate.Date(),
+		Source:    rate.Source().String(),
+		Buy:       rate.Buy(),
+		Sell:      rate.Sell(),
+		UpdatedAt: rate.UpdatedAt(),
+	}
+}
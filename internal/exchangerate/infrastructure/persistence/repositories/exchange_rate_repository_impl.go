@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"finanzas-backend/internal/exchangerate/domain/model/entities"
+	domain_repos "finanzas-backend/internal/exchangerate/domain/repositories"
+	"finanzas-backend/internal/exchangerate/infrastructure/persistence/models"
+	"finanzas-backend/internal/shared/infrastructure/persistence"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type exchangeRateRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewExchangeRateRepository(db *gorm.DB) domain_repos.ExchangeRateRepository {
+	return &exchangeRateRepositoryImpl{db: db}
+}
+
+func (r *exchangeRateRepositoryImpl) Upsert(ctx context.Context, rate *entities.ExchangeRate) error {
+	return persistence.Conn(ctx, r.db).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "date"}, {Name: "source"}},
+		DoUpdates: clause.AssignmentColumns([]string{"buy", "sell", "updated_at"}),
+	}).Create(models.ExchangeRateFromEntity(rate)).Error
+}
+
+func (r *exchangeRateRepositoryImpl) FindLatestOnOrBefore(ctx context.Context, date time.Time) ([]*entities.ExchangeRate, error) {
+	conn := persistence.Conn(ctx, r.db)
+	latest := conn.Model(&models.ExchangeRateModel{}).
+		Select("MAX(date)").
+		Where("date <= ?", entities.TruncateToDate(date))
+
+	var rows []models.ExchangeRateModel
+	if err := conn.Where("date = (?)", latest).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	return toEntities(rows)
+}
+
+func (r *exchangeRateRepositoryImpl) FindBetween(ctx context.Context, from, to time.Time) ([]*entities.ExchangeRate, error) {
+	var rows []models.ExchangeRateModel
+	err := persistence.Conn(ctx, r.db).
+		Where("date BETWEEN ? AND ?", entities.TruncateToDate(from), entities.TruncateToDate(to)).
+		Order("date, source").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return toEntities(rows)
+}
+
+func toEntities(rows []models.ExchangeRateModel) ([]*entities.ExchangeRate, error) {
+	rates := make([]*entities.ExchangeRate, 0, len(rows))
+	for i := range rows {
+		rate, err := rows[i].ToEntity()
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+	return rates, nil
+}
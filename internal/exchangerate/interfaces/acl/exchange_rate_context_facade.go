@@ -0,0 +1,24 @@
+package acl
+
+import (
+	"context"
+	"time"
+)
+
+// ExchangeRateContextFacade define el contrato ACL para que otros bounded contexts conviertan montos entre PEN y USD
+type ExchangeRateContextFacade interface {
+	// GetCurrentRate retorna el tipo de cambio vigente, o nil si no hay uno registrado o está desactualizado
+	GetCurrentRate(ctx context.Context) (*ExchangeRateData, error)
+
+	// Convert expresa amount (en from) en la moneda to con el tipo de cambio vigente.
+	// ok es false si no hay un tipo de cambio vigente; entre la misma moneda siempre es true.
+	Convert(ctx context.Context, amount float64, from, to string) (converted float64, ok bool, err error)
+}
+
+// ExchangeRateData es el tipo de cambio en soles por dólar (S/ por US$)
+type ExchangeRateData struct {
+	Date   time.Time
+	Buy    float64
+	Sell   float64
+	Source string
+}
@@ -0,0 +1,172 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"finanzas-backend/internal/exchangerate/domain/model/entities"
+	"finanzas-backend/internal/exchangerate/domain/model/queries"
+	"finanzas-backend/internal/exchangerate/domain/model/valueobjects"
+	"finanzas-backend/internal/exchangerate/domain/services"
+	"finanzas-backend/internal/exchangerate/interfaces/rest/resources"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultListDays es el rango consultado cuando no se indica from
+const defaultListDays = 30
+
+type ExchangeRateController struct {
+	queryService services.ExchangeRateQueryService
+	maxAgeDays   int
+}
+
+func NewExchangeRateController(queryService services.ExchangeRateQueryService, maxAgeDays int) *ExchangeRateController {
+	return &ExchangeRateController{
+		queryService: queryService,
+		maxAgeDays:   maxAgeDays,
+	}
+}
+
+// GetCurrentRate godoc
+// @Summary Get current exchange rate
+// @Description Returns the PEN per USD exchange rate in force on a date (latest published on or before it)
+// @Tags ExchangeRate
+// @Produce json
+// @Param date query string false "Date (YYYY-MM-DD), defaults to today"
+// @Success 200 {object} resources.CurrentExchangeRateResource
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/exchange-rates/current [get]
+func (c *ExchangeRateController) GetCurrentRate(ctx *gin.Context) {
+	date, ok := parseDateParam(ctx, "date", time.Now())
+	if !ok {
+		return
+	}
+
+	rate, err := c.queryService.HandleGetRate(ctx.Request.Context(), queries.NewGetExchangeRateQuery(date))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if rate == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "exchange rate not found"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resources.CurrentExchangeRateResource{
+		ExchangeRateResource: resources.TransformToExchangeRateResource(rate),
+		Stale:                rate.IsStale(date, c.maxAgeDays),
+	})
+}
+
+// ListRates godoc
+// @Summary List exchange rates
+// @Description Lists the exchange rates registered between two dates, one per source and day
+// @Tags ExchangeRate
+// @Produce json
+// @Param from query string false "From date (YYYY-MM-DD), defaults to 30 days before to"
+// @Param to query string false "To date (YYYY-MM-DD), defaults to today"
+// @Success 200 {array} resources.ExchangeRateResource
+// @Failure 400 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/exchange-rates [get]
+func (c *ExchangeRateController) ListRates(ctx *gin.Context) {
+	to, ok := parseDateParam(ctx, "to", time.Now())
+	if !ok {
+		return
+	}
+	from, ok := parseDateParam(ctx, "from", to.AddDate(0, 0, -defaultListDays))
+	if !ok {
+		return
+	}
+
+	query, err := queries.NewListExchangeRatesQuery(from, to)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rates, err := c.queryService.HandleList(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]resources.ExchangeRateResource, 0, len(rates))
+	for _, rate := range rates {
+		response = append(response, resources.TransformToExchangeRateResource(rate))
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Convert godoc
+// @Summary Convert an amount between PEN and USD
+// @Description Converts an amount with the exchange rate in force on a date: USD to PEN uses the buy rate, PEN to USD the sell rate
+// @Tags ExchangeRate
+// @Produce json
+// @Param amount query number true "Amount"
+// @Param from query string true "Source currency (PEN or USD)"
+// @Param to query string true "Target currency (PEN or USD)"
+// @Param date query string false "Date (YYYY-MM-DD), defaults to today"
+// @Success 200 {object} resources.ConversionResource
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/exchange-rates/convert [get]
+func (c *ExchangeRateController) Convert(ctx *gin.Context) {
+	amount, err := strconv.ParseFloat(ctx.Query("amount"), 64)
+	if err != nil || amount < 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "amount must be a non-negative number"})
+		return
+	}
+	from, err := valueobjects.NewCurrency(ctx.Query("from"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	to, err := valueobjects.NewCurrency(ctx.Query("to"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	date, ok := parseDateParam(ctx, "date", time.Now())
+	if !ok {
+		return
+	}
+
+	rate, err := c.queryService.HandleGetRate(ctx.Request.Context(), queries.NewGetExchangeRateQuery(date))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if rate == nil || rate.IsStale(date, c.maxAgeDays) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "exchange rate not found"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resources.ConversionResource{
+		Amount:    amount,
+		From:      from.String(),
+		Converted: rate.Convert(amount, from, to),
+		To:        to.String(),
+		Rate:      resources.TransformToExchangeRateResource(rate),
+	})
+}
+
+// parseDateParam lee un parámetro de fecha YYYY-MM-DD; si falta retorna fallback. Responde 400 si es inválido.
+func parseDateParam(ctx *gin.Context, name string, fallback time.Time) (time.Time, bool) {
+	value := ctx.Query(name)
+	if value == "" {
+		return entities.TruncateToDate(fallback), true
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid " + name + " date, expected YYYY-MM-DD"})
+		return time.Time{}, false
+	}
+	return date, true
+}
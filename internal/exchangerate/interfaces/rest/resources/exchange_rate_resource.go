@@ -0,0 +1,41 @@
+package resources
+
+import (
+	"time"
+
+	"finanzas-backend/internal/exchangerate/domain/model/entities"
+)
+
+// ExchangeRateResource es un tipo de cambio en soles por dólar (S/ por US$)
+type ExchangeRateResource struct {
+	Date      string    `json:"date"`
+	Buy       float64   `json:"buy"`
+	Sell      float64   `json:"sell"`
+	Source    string    `json:"source"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CurrentExchangeRateResource es el tipo de cambio vigente a una fecha
+type CurrentExchangeRateResource struct {
+	ExchangeRateResource
+	Stale bool `json:"stale"` // Más antiguo que lo permitido para convertir montos
+}
+
+// ConversionResource es el resultado de convertir un monto entre PEN y USD
+type ConversionResource struct {
+	Amount    float64              `json:"amount"`
+	From      string               `json:"from"`
+	Converted float64              `json:"converted"`
+	To        string               `json:"to"`
+	Rate      ExchangeRateResource `json:"rate"`
+}
+
+func TransformToExchangeRateResource(rate *entities.ExchangeRate) ExchangeRateResource {
+	return ExchangeRateResource{
+		Date:      rate.Date().Format("2006-01-02"),
+		Buy:       rate.Buy(),
+		Sell:      rate.Sell(),
+		Source:    rate.Source().String(),
+		UpdatedAt: rate.UpdatedAt(),
+	}
+}
@@ -3,7 +3,10 @@ package acl
 import (
 	"context"
 	"errors"
+	"log"
+	"time"
 
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
 	"finanzas-backend/internal/iam/domain/repositories"
 	"finanzas-backend/internal/iam/infrastructure/security"
 	"finanzas-backend/internal/iam/interfaces/acl"
@@ -12,22 +15,25 @@ import (
 type iamContextFacadeImpl struct {
 	jwtService *security.JWTService
 	userRepo   repositories.UserRepository
+	apiKeyRepo repositories.APIKeyRepository
 }
 
 // NewIAMContextFacade crea una nueva instancia del facade ACL de IAM
 func NewIAMContextFacade(
 	jwtService *security.JWTService,
 	userRepo repositories.UserRepository,
+	apiKeyRepo repositories.APIKeyRepository,
 ) acl.IAMContextFacade {
 	return &iamContextFacadeImpl{
 		jwtService: jwtService,
 		userRepo:   userRepo,
+		apiKeyRepo: apiKeyRepo,
 	}
 }
 
 // ValidateToken valida un token JWT y retorna el UserID como string si es válido
 func (f *iamContextFacadeImpl) ValidateToken(ctx context.Context, token string) (string, error) {
-	claims, err := f.jwtService.ValidateToken(token)
+	claims, err := f.jwtService.ValidateToken(ctx, token)
 	if err != nil {
 		return "", errors.New("invalid or expired token")
 	}
@@ -44,6 +50,33 @@ func (f *iamContextFacadeImpl) ValidateToken(ctx context.Context, token string)
 	return claims.UserID, nil
 }
 
+// ValidateAPIKey valida una API key y retorna el UserID de su dueño y los scopes otorgados
+func (f *iamContextFacadeImpl) ValidateAPIKey(ctx context.Context, apiKey string) (string, []string, error) {
+	secret, err := valueobjects.ParseAPIKeySecret(apiKey)
+	if err != nil {
+		return "", nil, errors.New("invalid API key")
+	}
+
+	key, err := f.apiKeyRepo.FindByPrefix(ctx, secret.Prefix())
+	if err != nil {
+		return "", nil, err
+	}
+
+	now := time.Now()
+	if key == nil || !key.Matches(secret) || !key.IsUsable(now) {
+		return "", nil, errors.New("invalid, expired or revoked API key")
+	}
+
+	// Registrar el último uso (con resolución de un minuto para no escribir en cada request)
+	if key.MarkUsed(now) {
+		if err := f.apiKeyRepo.TouchLastUsed(ctx, key.ID(), now); err != nil {
+			log.Printf("Failed to record API key usage: %v", err)
+		}
+	}
+
+	return key.UserID().String(), key.ScopeValues(), nil
+}
+
 // GetUserEmailByID obtiene el email de un usuario por su ID (UUID string)
 func (f *iamContextFacadeImpl) GetUserEmailByID(ctx context.Context, userID string) (string, error) {
 	user, err := f.userRepo.FindByIDValue(ctx, userID)
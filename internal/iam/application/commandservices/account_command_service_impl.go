@@ -0,0 +1,124 @@
+package commandservices
+
+import (
+	"context"
+	"errors"
+	"finanzas-backend/internal/iam/application/outboundservices/acl"
+	"finanzas-backend/internal/iam/domain/model/commands"
+	"finanzas-backend/internal/iam/domain/repositories"
+	"finanzas-backend/internal/iam/domain/services"
+	"log"
+	"time"
+)
+
+type accountCommandServiceImpl struct {
+	userRepo                repositories.UserRepository
+	unitOfWork              repositories.UnitOfWork
+	externalProfileService  *acl.ExternalProfileService
+	externalMortgageService *acl.ExternalMortgageService
+	deletionGracePeriod     time.Duration
+}
+
+func NewAccountCommandService(
+	userRepo repositories.UserRepository,
+	unitOfWork repositories.UnitOfWork,
+	externalProfileService *acl.ExternalProfileService,
+	externalMortgageService *acl.ExternalMortgageService,
+	deletionGracePeriod time.Duration,
+) services.AccountCommandService {
+	return &accountCommandServiceImpl{
+		userRepo:                userRepo,
+		unitOfWork:              unitOfWork,
+		externalProfileService:  externalProfileService,
+		externalMortgageService: externalMortgageService,
+		deletionGracePeriod:     deletionGracePeriod,
+	}
+}
+
+func (s *accountCommandServiceImpl) HandleRequestDeletion(ctx context.Context, cmd commands.RequestAccountDeletionCommand) (time.Time, error) {
+	user, err := s.userRepo.FindByID(ctx, cmd.UserID())
+	if err != nil {
+		return time.Time{}, err
+	}
+	if user == nil {
+		return time.Time{}, errors.New("user not found")
+	}
+
+	// Re-confirm the password before scheduling an irreversible action
+	if !user.VerifyPassword(cmd.Password()) {
+		return time.Time{}, errors.New("invalid password")
+	}
+
+	if err := user.RequestDeletion(time.Now(), s.deletionGracePeriod); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return time.Time{}, err
+	}
+
+	return *user.DeletionScheduledFor(), nil
+}
+
+func (s *accountCommandServiceImpl) HandleCancelDeletion(ctx context.Context, cmd commands.CancelAccountDeletionCommand) error {
+	user, err := s.userRepo.FindByID(ctx, cmd.UserID())
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return errors.New("user not found")
+	}
+
+	if err := user.CancelDeletion(); err != nil {
+		return err
+	}
+
+	return s.userRepo.Update(ctx, user)
+}
+
+// errDeletionNoLongerDue indica que la cuenta dejó de estar pendiente de eliminación tras listarla
+var errDeletionNoLongerDue = errors.New("account deletion is no longer due")
+
+// HandlePurgeDueAccounts borra las simulaciones, el perfil y el usuario en una sola transacción
+// por cuenta; si algo falla la cuenta sigue pendiente y se reintenta en la siguiente ejecución.
+func (s *accountCommandServiceImpl) HandlePurgeDueAccounts(ctx context.Context, now time.Time) (int, error) {
+	users, err := s.userRepo.FindDueForDeletion(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, user := range users {
+		userID := user.ID().String()
+
+		err := s.unitOfWork.WithinTransaction(ctx, func(txCtx context.Context) error {
+			// El usuario pudo cancelar la eliminación después del listado: se bloquea la fila y se revalida
+			due, err := s.userRepo.LockDueForDeletion(txCtx, user.ID(), now)
+			if err != nil {
+				return err
+			}
+			if !due {
+				return errDeletionNoLongerDue
+			}
+
+			if err := s.externalMortgageService.DeleteMortgages(txCtx, userID); err != nil {
+				return errors.New("failed to delete mortgages: " + err.Error())
+			}
+			if err := s.externalProfileService.DeleteProfile(txCtx, userID); err != nil {
+				return errors.New("failed to delete profile: " + err.Error())
+			}
+			return s.userRepo.Delete(txCtx, user.ID())
+		})
+		if errors.Is(err, errDeletionNoLongerDue) {
+			continue
+		}
+		if err != nil {
+			log.Printf("Account purge: user %s: %v", userID, err)
+			continue
+		}
+
+		purged++
+	}
+
+	return purged, nil
+}
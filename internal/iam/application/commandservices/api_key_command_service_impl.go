@@ -0,0 +1,88 @@
+package commandservices
+
+import (
+	"context"
+	"errors"
+	"finanzas-backend/internal/iam/domain/model/commands"
+	"finanzas-backend/internal/iam/domain/model/entities"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+	"finanzas-backend/internal/iam/domain/repositories"
+	"finanzas-backend/internal/iam/domain/services"
+	"time"
+)
+
+// maxActiveAPIKeys limita las keys vigentes por usuario
+const maxActiveAPIKeys = 10
+
+type apiKeyCommandServiceImpl struct {
+	apiKeyRepo repositories.APIKeyRepository
+	userRepo   repositories.UserRepository
+}
+
+func NewAPIKeyCommandService(
+	apiKeyRepo repositories.APIKeyRepository,
+	userRepo repositories.UserRepository,
+) services.APIKeyCommandService {
+	return &apiKeyCommandServiceImpl{
+		apiKeyRepo: apiKeyRepo,
+		userRepo:   userRepo,
+	}
+}
+
+func (s *apiKeyCommandServiceImpl) HandleCreate(ctx context.Context, cmd commands.CreateAPIKeyCommand) (*entities.APIKey, valueobjects.APIKeySecret, error) {
+	user, err := s.userRepo.FindByID(ctx, cmd.UserID())
+	if err != nil {
+		return nil, valueobjects.APIKeySecret{}, err
+	}
+	if user == nil {
+		return nil, valueobjects.APIKeySecret{}, errors.New("user not found")
+	}
+
+	existing, err := s.apiKeyRepo.FindByUserID(ctx, cmd.UserID())
+	if err != nil {
+		return nil, valueobjects.APIKeySecret{}, err
+	}
+	active := 0
+	now := time.Now()
+	for _, apiKey := range existing {
+		if apiKey.IsUsable(now) {
+			active++
+		}
+	}
+	if active >= maxActiveAPIKeys {
+		return nil, valueobjects.APIKeySecret{}, errors.New("maximum number of active API keys reached")
+	}
+
+	secret, err := valueobjects.GenerateAPIKeySecret()
+	if err != nil {
+		return nil, valueobjects.APIKeySecret{}, err
+	}
+
+	apiKey, err := entities.NewAPIKey(cmd.UserID(), cmd.Name(), secret, cmd.Scopes(), cmd.ExpiresAt())
+	if err != nil {
+		return nil, valueobjects.APIKeySecret{}, err
+	}
+
+	if err := s.apiKeyRepo.Save(ctx, apiKey); err != nil {
+		return nil, valueobjects.APIKeySecret{}, err
+	}
+
+	return apiKey, secret, nil
+}
+
+func (s *apiKeyCommandServiceImpl) HandleRevoke(ctx context.Context, cmd commands.RevokeAPIKeyCommand) error {
+	apiKey, err := s.apiKeyRepo.FindByID(ctx, cmd.APIKeyID())
+	if err != nil {
+		return err
+	}
+	// Una key de otro usuario se reporta igual que una inexistente
+	if apiKey == nil || apiKey.UserID() != cmd.UserID() {
+		return errors.New("API key not found")
+	}
+
+	if err := apiKey.Revoke(time.Now()); err != nil {
+		return err
+	}
+
+	return s.apiKeyRepo.Update(ctx, apiKey)
+}
@@ -4,46 +4,140 @@ import (
 	"context"
 	"errors"
 	"finanzas-backend/internal/iam/domain/model/commands"
+	"finanzas-backend/internal/iam/domain/model/entities"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
 	"finanzas-backend/internal/iam/domain/repositories"
 	"finanzas-backend/internal/iam/domain/services"
 	"finanzas-backend/internal/iam/infrastructure/security"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 type authenticationServiceImpl struct {
-	userRepo   repositories.UserRepository
-	jwtService *security.JWTService
+	userRepo    repositories.UserRepository
+	unitOfWork  repositories.UnitOfWork
+	jwtService  *security.JWTService
+	totpService *security.TOTPService
 }
 
 func NewAuthenticationService(
 	userRepo repositories.UserRepository,
+	unitOfWork repositories.UnitOfWork,
 	jwtService *security.JWTService,
+	totpService *security.TOTPService,
 ) services.AuthenticationService {
 	return &authenticationServiceImpl{
-		userRepo:   userRepo,
-		jwtService: jwtService,
+		userRepo:    userRepo,
+		unitOfWork:  unitOfWork,
+		jwtService:  jwtService,
+		totpService: totpService,
 	}
 }
 
-func (s *authenticationServiceImpl) HandleLogin(ctx context.Context, cmd commands.LoginCommand) (string, error) {
+func (s *authenticationServiceImpl) HandleLogin(ctx context.Context, cmd commands.LoginCommand) (valueobjects.AuthenticationResult, error) {
 	// Find user by email
 	user, err := s.userRepo.FindByEmail(ctx, cmd.Email())
 	if err != nil {
-		return "", err
+		return valueobjects.AuthenticationResult{}, err
 	}
 	if user == nil {
-		return "", errors.New("invalid credentials")
+		return valueobjects.AuthenticationResult{}, errors.New("invalid credentials")
 	}
 
 	// Verify password
 	if !user.VerifyPassword(cmd.Password()) {
-		return "", errors.New("invalid credentials")
+		return valueobjects.AuthenticationResult{}, errors.New("invalid credentials")
+	}
+
+	// With 2FA enabled, issue a short-lived challenge instead of the access token
+	if user.MFAEnabled() {
+		// Only the latest challenge is accepted, and only once
+		challengeID := uuid.NewString()
+		challengeToken, err := s.jwtService.GenerateMFAChallengeToken(user.ID().String(), challengeID)
+		if err != nil {
+			return valueobjects.AuthenticationResult{}, errors.New("failed to generate MFA challenge")
+		}
+
+		// The row is re-read under lock so this write cannot overwrite a concurrent failure count
+		err = s.unitOfWork.WithinTransaction(ctx, func(txCtx context.Context) error {
+			locked, err := s.userRepo.FindByIDForUpdate(txCtx, user.ID().String())
+			if err != nil {
+				return err
+			}
+			if locked == nil {
+				return errors.New("invalid credentials")
+			}
+			if locked.IsMFALocked(time.Now()) {
+				return entities.ErrMFALocked
+			}
+			locked.StartMFAChallenge(challengeID)
+			return s.userRepo.Update(txCtx, locked)
+		})
+		if err != nil {
+			return valueobjects.AuthenticationResult{}, err
+		}
+		return valueobjects.NewMFAChallengeAuthenticationResult(challengeToken), nil
 	}
 
 	// Generate JWT token
 	token, err := s.jwtService.GenerateToken(user.ID().String(), user.Email().Value())
 	if err != nil {
-		return "", errors.New("failed to generate token")
+		return valueobjects.AuthenticationResult{}, errors.New("failed to generate token")
+	}
+
+	return valueobjects.NewTokenAuthenticationResult(token), nil
+}
+
+func (s *authenticationServiceImpl) HandleVerifyMFALogin(ctx context.Context, cmd commands.VerifyMFALoginCommand) (string, valueobjects.UserID, error) {
+	// Validate challenge token
+	claims, err := s.jwtService.ValidateMFAChallengeToken(ctx, cmd.ChallengeToken())
+	if err != nil {
+		return "", valueobjects.UserID{}, errors.New("invalid or expired MFA token")
+	}
+
+	// The user row stays locked until commit: concurrent attempts run one after another and
+	// each one sees the failure count and used codes left by the previous one
+	var user *entities.User
+	var verifyErr error
+	err = s.unitOfWork.WithinTransaction(ctx, func(txCtx context.Context) error {
+		locked, err := s.userRepo.FindByIDForUpdate(txCtx, claims.UserID)
+		if err != nil {
+			return err
+		}
+		if locked == nil || !locked.MFAEnabled() || !locked.IsCurrentMFAChallenge(claims.ID) {
+			return errors.New("invalid or expired MFA token")
+		}
+		if locked.IsMFALocked(time.Now()) {
+			return entities.ErrMFALocked
+		}
+
+		ok, err := verifyMFACode(txCtx, s.userRepo, s.totpService, locked, cmd.Code())
+		if err != nil {
+			return err
+		}
+		if !ok {
+			// Wrong TOTP and recovery codes count against the same limit; the failure is committed
+			locked.RegisterMFAFailure(time.Now())
+			verifyErr = errors.New("invalid verification code")
+			return s.userRepo.Update(txCtx, locked)
+		}
+
+		locked.CompleteMFAVerification()
+		user = locked
+		return s.userRepo.Update(txCtx, locked)
+	})
+	if err != nil {
+		return "", valueobjects.UserID{}, err
+	}
+	if verifyErr != nil {
+		return "", valueobjects.UserID{}, verifyErr
+	}
+
+	token, err := s.jwtService.GenerateToken(user.ID().String(), user.Email().Value())
+	if err != nil {
+		return "", valueobjects.UserID{}, errors.New("failed to generate token")
 	}
 
-	return token, nil
+	return token, user.ID(), nil
 }
@@ -0,0 +1,181 @@
+package commandservices
+
+import (
+	"context"
+	"errors"
+	"finanzas-backend/internal/iam/domain/model/commands"
+	"finanzas-backend/internal/iam/domain/model/entities"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+	"finanzas-backend/internal/iam/domain/repositories"
+	"finanzas-backend/internal/iam/domain/services"
+	"finanzas-backend/internal/iam/infrastructure/security"
+	"time"
+)
+
+type mfaCommandServiceImpl struct {
+	userRepo    repositories.UserRepository
+	unitOfWork  repositories.UnitOfWork
+	totpService *security.TOTPService
+}
+
+func NewMFACommandService(
+	userRepo repositories.UserRepository,
+	unitOfWork repositories.UnitOfWork,
+	totpService *security.TOTPService,
+) services.MFACommandService {
+	return &mfaCommandServiceImpl{
+		userRepo:    userRepo,
+		unitOfWork:  unitOfWork,
+		totpService: totpService,
+	}
+}
+
+func (s *mfaCommandServiceImpl) HandleEnroll(ctx context.Context, cmd commands.EnrollMFACommand) (valueobjects.MFAEnrollment, error) {
+	user, err := s.findUser(ctx, cmd.UserID())
+	if err != nil {
+		return valueobjects.MFAEnrollment{}, err
+	}
+
+	// Generate a new secret; any previous unconfirmed enrollment is replaced
+	secret, err := valueobjects.GenerateTOTPSecret()
+	if err != nil {
+		return valueobjects.MFAEnrollment{}, err
+	}
+
+	if err := user.StartMFAEnrollment(secret); err != nil {
+		return valueobjects.MFAEnrollment{}, err
+	}
+
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return valueobjects.MFAEnrollment{}, err
+	}
+
+	uri := s.totpService.ProvisioningURI(secret, user.Email().Value())
+	return valueobjects.NewMFAEnrollment(secret, uri), nil
+}
+
+func (s *mfaCommandServiceImpl) HandleConfirm(ctx context.Context, cmd commands.ConfirmMFACommand) ([]string, error) {
+	var plainCodes []string
+	err := s.unitOfWork.WithinTransaction(ctx, func(txCtx context.Context) error {
+		user, err := s.findUserForUpdate(txCtx, cmd.UserID())
+		if err != nil {
+			return err
+		}
+		if !user.HasPendingMFAEnrollment() {
+			return errors.New("there is no pending two-factor enrollment")
+		}
+
+		step, ok := s.totpService.Validate(user.MFASecret(), cmd.Code(), user.MFALastUsedStep())
+		if !ok {
+			return errors.New("invalid verification code")
+		}
+
+		codes, recoveryCodes, err := valueobjects.GenerateRecoveryCodes(valueobjects.RecoveryCodeCount)
+		if err != nil {
+			return err
+		}
+
+		if err := user.ConfirmMFA(step, recoveryCodes); err != nil {
+			return err
+		}
+
+		if err := s.userRepo.Update(txCtx, user); err != nil {
+			return err
+		}
+		if err := s.userRepo.ReplaceRecoveryCodes(txCtx, user); err != nil {
+			return err
+		}
+
+		plainCodes = codes
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The plain recovery codes are only returned once
+	return plainCodes, nil
+}
+
+func (s *mfaCommandServiceImpl) HandleDisable(ctx context.Context, cmd commands.DisableMFACommand) error {
+	var verifyErr error
+	err := s.unitOfWork.WithinTransaction(ctx, func(txCtx context.Context) error {
+		// Locked like the login check, so parallel attempts cannot bypass the lockout
+		user, err := s.findUserForUpdate(txCtx, cmd.UserID())
+		if err != nil {
+			return err
+		}
+		if !user.MFAEnabled() {
+			return errors.New("two-factor authentication is not enabled")
+		}
+		if user.IsMFALocked(time.Now()) {
+			return entities.ErrMFALocked
+		}
+
+		// Same check as login: the TOTP step is recorded so the code cannot be replayed
+		ok, err := verifyMFACode(txCtx, s.userRepo, s.totpService, user, cmd.Code())
+		if err != nil {
+			return err
+		}
+		if !ok {
+			user.RegisterMFAFailure(time.Now())
+			verifyErr = errors.New("invalid verification code")
+			return s.userRepo.Update(txCtx, user)
+		}
+
+		user.CompleteMFAVerification()
+		user.DisableMFA()
+		if err := s.userRepo.Update(txCtx, user); err != nil {
+			return err
+		}
+		return s.userRepo.ReplaceRecoveryCodes(txCtx, user)
+	})
+	if err != nil {
+		return err
+	}
+	return verifyErr
+}
+
+func (s *mfaCommandServiceImpl) findUser(ctx context.Context, userID valueobjects.UserID) (*entities.User, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+	return user, nil
+}
+
+func (s *mfaCommandServiceImpl) findUserForUpdate(ctx context.Context, userID valueobjects.UserID) (*entities.User, error) {
+	user, err := s.userRepo.FindByIDForUpdate(ctx, userID.String())
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+	return user, nil
+}
+
+// verifyMFACode acepta un código TOTP o, en su defecto, un código de recuperación de un solo uso.
+// Se llama con la fila del usuario bloqueada; el código de recuperación se consume con un borrado
+// condicional, así que si otra petición ya lo usó no se acepta.
+func verifyMFACode(
+	ctx context.Context,
+	userRepo repositories.UserRepository,
+	totpService *security.TOTPService,
+	user *entities.User,
+	code string,
+) (bool, error) {
+	if step, ok := totpService.Validate(user.MFASecret(), code, user.MFALastUsedStep()); ok {
+		user.RecordTOTPStep(step)
+		return true, nil
+	}
+
+	recoveryCode, ok := user.UseRecoveryCode(code)
+	if !ok {
+		return false, nil
+	}
+	return userRepo.ConsumeRecoveryCode(ctx, user.ID(), recoveryCode.Hash())
+}
@@ -9,32 +9,36 @@ import (
 	"finanzas-backend/internal/iam/domain/model/valueobjects"
 	"finanzas-backend/internal/iam/domain/repositories"
 	"finanzas-backend/internal/iam/domain/services"
-	"finanzas-backend/internal/iam/infrastructure/external"
+	"finanzas-backend/internal/shared/infrastructure/identity"
+	"fmt"
 )
 
 type userCommandServiceImpl struct {
-	userRepo              repositories.UserRepository
-	reniecService         *external.ReniecService
+	userRepo               repositories.UserRepository
+	unitOfWork             repositories.UnitOfWork
+	identityProvider       identity.Provider
 	externalProfileService *acl.ExternalProfileService
 }
 
 func NewUserCommandService(
 	userRepo repositories.UserRepository,
-	reniecService *external.ReniecService,
+	unitOfWork repositories.UnitOfWork,
+	identityProvider identity.Provider,
 	externalProfileService *acl.ExternalProfileService,
 ) services.UserCommandService {
 	return &userCommandServiceImpl{
-		userRepo:              userRepo,
-		reniecService:         reniecService,
+		userRepo:               userRepo,
+		unitOfWork:             unitOfWork,
+		identityProvider:       identityProvider,
 		externalProfileService: externalProfileService,
 	}
 }
 
 func (s *userCommandServiceImpl) HandleRegister(ctx context.Context, cmd commands.RegisterUserCommand) (*valueobjects.UserID, error) {
 	// Step 1: Validate DNI with RENIEC and get person data
-	personData, err := s.reniecService.GetPersonData(ctx, cmd.DNI())
+	personData, err := s.identityProvider.LookupDNI(ctx, cmd.DNI())
 	if err != nil {
-		return nil, errors.New("DNI validation failed: " + err.Error())
+		return nil, fmt.Errorf("DNI validation failed: %w", err)
 	}
 
 	// Step 2: Check if DNI is already registered in Profile context
@@ -72,26 +76,31 @@ func (s *userCommandServiceImpl) HandleRegister(ctx context.Context, cmd command
 		return nil, err
 	}
 
-	// Step 6: Persist (only email and password)
-	if err := s.userRepo.Save(ctx, user); err != nil {
+	// Step 6 and 7: Persist the user and create its Profile with RENIEC data atomically.
+	// If the profile cannot be created the user insert is rolled back, so no orphan user is left.
+	err = s.unitOfWork.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.userRepo.Save(txCtx, user); err != nil {
+			return err
+		}
+
+		if err := s.externalProfileService.CreateProfile(
+			txCtx,
+			user.ID().String(),
+			cmd.DNI(),
+			personData.FirstName,
+			personData.FirstLastName,
+			personData.SecondLastName,
+		); err != nil {
+			return errors.New("failed to create profile: " + err.Error())
+		}
+
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	// Step 7: Create Profile automatically with RENIEC data
 	userID := user.ID()
-	if err := s.externalProfileService.CreateProfile(
-		ctx,
-		userID.String(),
-		cmd.DNI(),
-		personData.FirstName,
-		personData.FirstLastName,
-		personData.SecondLastName,
-	); err != nil {
-		// If profile creation fails, we should consider rolling back the user
-		// For now, we'll just return the error
-		return nil, errors.New("failed to create profile: " + err.Error())
-	}
-
 	return &userID, nil
 }
 
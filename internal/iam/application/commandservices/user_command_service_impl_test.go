@@ -0,0 +1,104 @@
+package commandservices_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"finanzas-backend/internal/iam/application/commandservices"
+	"finanzas-backend/internal/iam/application/outboundservices/acl"
+	"finanzas-backend/internal/iam/domain/model/commands"
+	iamRepos "finanzas-backend/internal/iam/infrastructure/persistence/repositories"
+	profileACL "finanzas-backend/internal/profile/interfaces/acl"
+	"finanzas-backend/internal/shared/infrastructure/identity"
+	"finanzas-backend/internal/shared/infrastructure/persistence"
+	"finanzas-backend/internal/shared/infrastructure/persistence/persistencetest"
+	"finanzas-backend/internal/shared/infrastructure/security"
+)
+
+// profileFacadeStub responde que el DNI no existe y falla (o no) al crear el perfil.
+// Los demás métodos de la fachada no se usan en el registro.
+type profileFacadeStub struct {
+	profileACL.ProfileContextFacade
+	createErr error
+	created   int
+}
+
+func (f *profileFacadeStub) ExistsByDNI(ctx context.Context, dni string) (bool, error) {
+	return false, nil
+}
+
+func (f *profileFacadeStub) CreateProfile(ctx context.Context, userID, dni, firstName, firstLastName, secondLastName string) error {
+	if f.createErr != nil {
+		return f.createErr
+	}
+	f.created++
+	return nil
+}
+
+func newRegisterFixture(t *testing.T, facade *profileFacadeStub) (func(context.Context, commands.RegisterUserCommand) error, *persistencetest.Store) {
+	t.Helper()
+	db, store := persistencetest.Open(t)
+
+	keyProvider, err := security.NewEnvKeyProvider("", "", strings.Repeat("k", 32))
+	if err != nil {
+		t.Fatalf("key provider: %v", err)
+	}
+	encryptionService, err := security.NewEncryptionService(keyProvider, "")
+	if err != nil {
+		t.Fatalf("encryption service: %v", err)
+	}
+
+	service := commandservices.NewUserCommandService(
+		iamRepos.NewUserRepository(db, encryptionService),
+		persistence.NewTransactionManager(db),
+		identity.NewStubProvider(nil),
+		acl.NewExternalProfileService(facade),
+	)
+	register := func(ctx context.Context, cmd commands.RegisterUserCommand) error {
+		_, err := service.HandleRegister(ctx, cmd)
+		return err
+	}
+	return register, store
+}
+
+func newRegisterCommand(t *testing.T) commands.RegisterUserCommand {
+	t.Helper()
+	cmd, err := commands.NewRegisterUserCommand("12345678", "ana@example.com", "Secreta123!")
+	if err != nil {
+		t.Fatalf("register command: %v", err)
+	}
+	return cmd
+}
+
+func TestHandleRegisterRollsBackUserWhenProfileFails(t *testing.T) {
+	facade := &profileFacadeStub{createErr: errors.New("profile store unavailable")}
+	register, store := newRegisterFixture(t, facade)
+
+	err := register(context.Background(), newRegisterCommand(t))
+	if err == nil || !strings.Contains(err.Error(), "failed to create profile") {
+		t.Fatalf("expected a profile creation error, got %v", err)
+	}
+	if got := store.Rows("users"); got != 0 {
+		t.Fatalf("expected no orphan user after the profile failure, got %d rows in users", got)
+	}
+	if store.Rollbacks() != 1 || store.Commits() != 0 {
+		t.Fatalf("expected 1 rollback and no commit, got %d and %d", store.Rollbacks(), store.Commits())
+	}
+}
+
+func TestHandleRegisterCommitsUserWithProfile(t *testing.T) {
+	facade := &profileFacadeStub{}
+	register, store := newRegisterFixture(t, facade)
+
+	if err := register(context.Background(), newRegisterCommand(t)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := store.Rows("users"); got != 1 {
+		t.Fatalf("expected the user to be committed, got %d rows in users", got)
+	}
+	if facade.created != 1 {
+		t.Fatalf("expected 1 profile to be created, got %d", facade.created)
+	}
+}
@@ -0,0 +1,28 @@
+package acl
+
+import (
+	"context"
+	"encoding/json"
+	mortgage_acl "finanzas-backend/internal/mortgage/interfaces/acl"
+)
+
+// ExternalMortgageService - ACL implementation for accessing Mortgage from IAM context
+type ExternalMortgageService struct {
+	mortgageFacade mortgage_acl.MortgageContextFacade
+}
+
+func NewExternalMortgageService(mortgageFacade mortgage_acl.MortgageContextFacade) *ExternalMortgageService {
+	return &ExternalMortgageService{
+		mortgageFacade: mortgageFacade,
+	}
+}
+
+// ExportMortgages returns all of the user's simulations as a JSON array
+func (s *ExternalMortgageService) ExportMortgages(ctx context.Context, userID string) (json.RawMessage, error) {
+	return s.mortgageFacade.ExportUserData(ctx, userID)
+}
+
+// DeleteMortgages permanently deletes all of the user's simulations
+func (s *ExternalMortgageService) DeleteMortgages(ctx context.Context, userID string) error {
+	return s.mortgageFacade.DeleteUserData(ctx, userID)
+}
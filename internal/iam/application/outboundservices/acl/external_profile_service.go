@@ -2,6 +2,7 @@ package acl
 
 import (
 	"context"
+	"encoding/json"
 	profile_acl "finanzas-backend/internal/profile/interfaces/acl"
 )
 
@@ -25,3 +26,13 @@ func (s *ExternalProfileService) DNIExists(ctx context.Context, dni string) (boo
 func (s *ExternalProfileService) CreateProfile(ctx context.Context, userID, dni, firstName, firstLastName, secondLastName string) error {
 	return s.profileFacade.CreateProfile(ctx, userID, dni, firstName, firstLastName, secondLastName)
 }
+
+// ExportProfile returns the user's decrypted profile as JSON (null if the user has no profile)
+func (s *ExternalProfileService) ExportProfile(ctx context.Context, userID string) (json.RawMessage, error) {
+	return s.profileFacade.ExportUserData(ctx, userID)
+}
+
+// DeleteProfile permanently deletes the user's profile
+func (s *ExternalProfileService) DeleteProfile(ctx context.Context, userID string) error {
+	return s.profileFacade.DeleteUserData(ctx, userID)
+}
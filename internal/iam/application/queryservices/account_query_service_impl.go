@@ -0,0 +1,60 @@
+package queryservices
+
+import (
+	"context"
+	"errors"
+	"finanzas-backend/internal/iam/application/outboundservices/acl"
+	"finanzas-backend/internal/iam/domain/model/entities"
+	"finanzas-backend/internal/iam/domain/model/queries"
+	"finanzas-backend/internal/iam/domain/repositories"
+	"finanzas-backend/internal/iam/domain/services"
+	"time"
+)
+
+type accountQueryServiceImpl struct {
+	userRepo                repositories.UserRepository
+	apiKeyRepo              repositories.APIKeyRepository
+	externalProfileService  *acl.ExternalProfileService
+	externalMortgageService *acl.ExternalMortgageService
+}
+
+func NewAccountQueryService(
+	userRepo repositories.UserRepository,
+	apiKeyRepo repositories.APIKeyRepository,
+	externalProfileService *acl.ExternalProfileService,
+	externalMortgageService *acl.ExternalMortgageService,
+) services.AccountQueryService {
+	return &accountQueryServiceImpl{
+		userRepo:                userRepo,
+		apiKeyRepo:              apiKeyRepo,
+		externalProfileService:  externalProfileService,
+		externalMortgageService: externalMortgageService,
+	}
+}
+
+func (s *accountQueryServiceImpl) HandleExportData(ctx context.Context, query queries.ExportAccountDataQuery) (*entities.AccountDataExport, error) {
+	user, err := s.userRepo.FindByID(ctx, query.UserID())
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	apiKeys, err := s.apiKeyRepo.FindByUserID(ctx, query.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := s.externalProfileService.ExportProfile(ctx, user.ID().String())
+	if err != nil {
+		return nil, err
+	}
+
+	mortgages, err := s.externalMortgageService.ExportMortgages(ctx, user.ID().String())
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.NewAccountDataExport(time.Now(), user, apiKeys, profile, mortgages), nil
+}
@@ -0,0 +1,23 @@
+package queryservices
+
+import (
+	"context"
+	"finanzas-backend/internal/iam/domain/model/entities"
+	"finanzas-backend/internal/iam/domain/model/queries"
+	"finanzas-backend/internal/iam/domain/repositories"
+	"finanzas-backend/internal/iam/domain/services"
+)
+
+type apiKeyQueryServiceImpl struct {
+	apiKeyRepo repositories.APIKeyRepository
+}
+
+func NewAPIKeyQueryService(apiKeyRepo repositories.APIKeyRepository) services.APIKeyQueryService {
+	return &apiKeyQueryServiceImpl{
+		apiKeyRepo: apiKeyRepo,
+	}
+}
+
+func (s *apiKeyQueryServiceImpl) HandleFindByUserID(ctx context.Context, query queries.FindAPIKeysByUserIDQuery) ([]*entities.APIKey, error) {
+	return s.apiKeyRepo.FindByUserID(ctx, query.UserID())
+}
@@ -0,0 +1,29 @@
+package queryservices
+
+import (
+	"context"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+	"finanzas-backend/internal/iam/domain/services"
+	"finanzas-backend/internal/iam/infrastructure/security"
+)
+
+type signingKeyQueryServiceImpl struct {
+	keyManager *security.KeyManager
+}
+
+func NewSigningKeyQueryService(keyManager *security.KeyManager) services.SigningKeyQueryService {
+	return &signingKeyQueryServiceImpl{
+		keyManager: keyManager,
+	}
+}
+
+// HandleGetPublicKeys retorna las llaves públicas no retiradas (las que aún verifican tokens)
+func (s *signingKeyQueryServiceImpl) HandleGetPublicKeys(ctx context.Context) ([]valueobjects.PublicSigningKey, error) {
+	keys := s.keyManager.ActiveKeys()
+
+	publicKeys := make([]valueobjects.PublicSigningKey, 0, len(keys))
+	for _, key := range keys {
+		publicKeys = append(publicKeys, valueobjects.NewPublicSigningKey(key.KID, key.Algorithm, key.PublicKey()))
+	}
+	return publicKeys, nil
+}
@@ -0,0 +1,19 @@
+package commands
+
+import (
+	"errors"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+)
+
+type CancelAccountDeletionCommand struct {
+	userID valueobjects.UserID
+}
+
+func NewCancelAccountDeletionCommand(userID valueobjects.UserID) (CancelAccountDeletionCommand, error) {
+	if userID.IsZero() {
+		return CancelAccountDeletionCommand{}, errors.New("user ID is required")
+	}
+	return CancelAccountDeletionCommand{userID: userID}, nil
+}
+
+func (c CancelAccountDeletionCommand) UserID() valueobjects.UserID { return c.userID }
@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"errors"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+	"strings"
+)
+
+type ConfirmMFACommand struct {
+	userID valueobjects.UserID
+	code   string
+}
+
+func NewConfirmMFACommand(userID valueobjects.UserID, code string) (ConfirmMFACommand, error) {
+	if userID.IsZero() {
+		return ConfirmMFACommand{}, errors.New("user ID is required")
+	}
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return ConfirmMFACommand{}, errors.New("verification code cannot be empty")
+	}
+	return ConfirmMFACommand{userID: userID, code: code}, nil
+}
+
+func (c ConfirmMFACommand) UserID() valueobjects.UserID { return c.userID }
+func (c ConfirmMFACommand) Code() string                { return c.code }
@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"errors"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+	"strings"
+	"time"
+)
+
+type CreateAPIKeyCommand struct {
+	userID    valueobjects.UserID
+	name      string
+	scopes    []valueobjects.APIKeyScope
+	expiresAt *time.Time
+}
+
+func NewCreateAPIKeyCommand(userID valueobjects.UserID, name string, scopes []string, expiresAt *time.Time) (CreateAPIKeyCommand, error) {
+	if userID.IsZero() {
+		return CreateAPIKeyCommand{}, errors.New("user ID is required")
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return CreateAPIKeyCommand{}, errors.New("API key name cannot be empty")
+	}
+
+	parsedScopes, err := valueobjects.NewAPIKeyScopes(scopes)
+	if err != nil {
+		return CreateAPIKeyCommand{}, err
+	}
+
+	return CreateAPIKeyCommand{
+		userID:    userID,
+		name:      name,
+		scopes:    parsedScopes,
+		expiresAt: expiresAt,
+	}, nil
+}
+
+func (c CreateAPIKeyCommand) UserID() valueobjects.UserID        { return c.userID }
+func (c CreateAPIKeyCommand) Name() string                       { return c.name }
+func (c CreateAPIKeyCommand) Scopes() []valueobjects.APIKeyScope { return c.scopes }
+func (c CreateAPIKeyCommand) ExpiresAt() *time.Time              { return c.expiresAt }
@@ -0,0 +1,27 @@
+package commands
+
+import (
+	"errors"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+	"strings"
+)
+
+// DisableMFACommand requiere un código TOTP o de recuperación vigente para desactivar 2FA
+type DisableMFACommand struct {
+	userID valueobjects.UserID
+	code   string
+}
+
+func NewDisableMFACommand(userID valueobjects.UserID, code string) (DisableMFACommand, error) {
+	if userID.IsZero() {
+		return DisableMFACommand{}, errors.New("user ID is required")
+	}
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return DisableMFACommand{}, errors.New("verification code cannot be empty")
+	}
+	return DisableMFACommand{userID: userID, code: code}, nil
+}
+
+func (c DisableMFACommand) UserID() valueobjects.UserID { return c.userID }
+func (c DisableMFACommand) Code() string                { return c.code }
@@ -0,0 +1,19 @@
+package commands
+
+import (
+	"errors"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+)
+
+type EnrollMFACommand struct {
+	userID valueobjects.UserID
+}
+
+func NewEnrollMFACommand(userID valueobjects.UserID) (EnrollMFACommand, error) {
+	if userID.IsZero() {
+		return EnrollMFACommand{}, errors.New("user ID is required")
+	}
+	return EnrollMFACommand{userID: userID}, nil
+}
+
+func (c EnrollMFACommand) UserID() valueobjects.UserID { return c.userID }
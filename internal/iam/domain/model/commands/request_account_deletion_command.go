@@ -0,0 +1,24 @@
+package commands
+
+import (
+	"errors"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+)
+
+type RequestAccountDeletionCommand struct {
+	userID   valueobjects.UserID
+	password string
+}
+
+func NewRequestAccountDeletionCommand(userID valueobjects.UserID, password string) (RequestAccountDeletionCommand, error) {
+	if userID.IsZero() {
+		return RequestAccountDeletionCommand{}, errors.New("user ID is required")
+	}
+	if password == "" {
+		return RequestAccountDeletionCommand{}, errors.New("password confirmation is required")
+	}
+	return RequestAccountDeletionCommand{userID: userID, password: password}, nil
+}
+
+func (c RequestAccountDeletionCommand) UserID() valueobjects.UserID { return c.userID }
+func (c RequestAccountDeletionCommand) Password() string            { return c.password }
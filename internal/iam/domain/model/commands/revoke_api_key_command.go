@@ -0,0 +1,25 @@
+package commands
+
+import (
+	"errors"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+)
+
+type RevokeAPIKeyCommand struct {
+	userID   valueobjects.UserID
+	apiKeyID valueobjects.APIKeyID
+}
+
+func NewRevokeAPIKeyCommand(userID valueobjects.UserID, apiKeyID string) (RevokeAPIKeyCommand, error) {
+	if userID.IsZero() {
+		return RevokeAPIKeyCommand{}, errors.New("user ID is required")
+	}
+	id, err := valueobjects.NewAPIKeyIDFromString(apiKeyID)
+	if err != nil {
+		return RevokeAPIKeyCommand{}, err
+	}
+	return RevokeAPIKeyCommand{userID: userID, apiKeyID: id}, nil
+}
+
+func (c RevokeAPIKeyCommand) UserID() valueobjects.UserID     { return c.userID }
+func (c RevokeAPIKeyCommand) APIKeyID() valueobjects.APIKeyID { return c.apiKeyID }
@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"errors"
+	"strings"
+)
+
+// VerifyMFALoginCommand canjea el token de desafío MFA y un código por el JWT definitivo
+type VerifyMFALoginCommand struct {
+	challengeToken string
+	code           string
+}
+
+func NewVerifyMFALoginCommand(challengeToken, code string) (VerifyMFALoginCommand, error) {
+	if challengeToken == "" {
+		return VerifyMFALoginCommand{}, errors.New("MFA token cannot be empty")
+	}
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return VerifyMFALoginCommand{}, errors.New("verification code cannot be empty")
+	}
+	return VerifyMFALoginCommand{challengeToken: challengeToken, code: code}, nil
+}
+
+func (c VerifyMFALoginCommand) ChallengeToken() string { return c.challengeToken }
+func (c VerifyMFALoginCommand) Code() string           { return c.code }
@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AccountDataExport reúne los datos personales del usuario en todos los bounded contexts
+// (derecho de acceso, Ley 29733). Profile y Mortgage entregan su parte ya serializada.
+type AccountDataExport struct {
+	exportedAt time.Time
+	user       *User
+	apiKeys    []*APIKey
+	profile    json.RawMessage
+	mortgages  json.RawMessage
+}
+
+func NewAccountDataExport(
+	exportedAt time.Time,
+	user *User,
+	apiKeys []*APIKey,
+	profile json.RawMessage,
+	mortgages json.RawMessage,
+) *AccountDataExport {
+	return &AccountDataExport{
+		exportedAt: exportedAt,
+		user:       user,
+		apiKeys:    apiKeys,
+		profile:    profile,
+		mortgages:  mortgages,
+	}
+}
+
+func (e *AccountDataExport) ExportedAt() time.Time      { return e.exportedAt }
+func (e *AccountDataExport) User() *User                { return e.user }
+func (e *AccountDataExport) APIKeys() []*APIKey         { return e.apiKeys }
+func (e *AccountDataExport) Profile() json.RawMessage   { return e.profile }
+func (e *AccountDataExport) Mortgages() json.RawMessage { return e.mortgages }
@@ -0,0 +1,131 @@
+package entities
+
+import (
+	"errors"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+	"strings"
+	"time"
+)
+
+// lastUsedResolution evita escribir en la base de datos en cada request autenticado con la key
+const lastUsedResolution = time.Minute
+
+// APIKey es una credencial de máquina a máquina asociada a un usuario
+type APIKey struct {
+	id         valueobjects.APIKeyID
+	userID     valueobjects.UserID
+	name       string
+	prefix     string
+	keyHash    string
+	scopes     []valueobjects.APIKeyScope
+	expiresAt  *time.Time
+	lastUsedAt *time.Time
+	revokedAt  *time.Time
+	createdAt  time.Time
+}
+
+func NewAPIKey(
+	userID valueobjects.UserID,
+	name string,
+	secret valueobjects.APIKeySecret,
+	scopes []valueobjects.APIKeyScope,
+	expiresAt *time.Time,
+) (*APIKey, error) {
+	if userID.IsZero() {
+		return nil, errors.New("user ID is required")
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("API key name cannot be empty")
+	}
+	if len(name) > 100 {
+		return nil, errors.New("API key name cannot exceed 100 characters")
+	}
+	if len(scopes) == 0 {
+		scopes = valueobjects.DefaultAPIKeyScopes()
+	}
+
+	now := time.Now()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, errors.New("API key expiration must be in the future")
+	}
+
+	return &APIKey{
+		id:        valueobjects.GenerateAPIKeyID(),
+		userID:    userID,
+		name:      name,
+		prefix:    secret.Prefix(),
+		keyHash:   secret.Hash(),
+		scopes:    scopes,
+		expiresAt: expiresAt,
+		createdAt: now,
+	}, nil
+}
+
+func ReconstructAPIKey(
+	id valueobjects.APIKeyID,
+	userID valueobjects.UserID,
+	name, prefix, keyHash string,
+	scopes []valueobjects.APIKeyScope,
+	expiresAt, lastUsedAt, revokedAt *time.Time,
+	createdAt time.Time,
+) *APIKey {
+	return &APIKey{
+		id:         id,
+		userID:     userID,
+		name:       name,
+		prefix:     prefix,
+		keyHash:    keyHash,
+		scopes:     scopes,
+		expiresAt:  expiresAt,
+		lastUsedAt: lastUsedAt,
+		revokedAt:  revokedAt,
+		createdAt:  createdAt,
+	}
+}
+
+func (k *APIKey) ID() valueobjects.APIKeyID          { return k.id }
+func (k *APIKey) UserID() valueobjects.UserID        { return k.userID }
+func (k *APIKey) Name() string                       { return k.name }
+func (k *APIKey) Prefix() string                     { return k.prefix }
+func (k *APIKey) KeyHash() string                    { return k.keyHash }
+func (k *APIKey) Scopes() []valueobjects.APIKeyScope { return k.scopes }
+func (k *APIKey) ExpiresAt() *time.Time              { return k.expiresAt }
+func (k *APIKey) LastUsedAt() *time.Time             { return k.lastUsedAt }
+func (k *APIKey) RevokedAt() *time.Time              { return k.revokedAt }
+func (k *APIKey) CreatedAt() time.Time               { return k.createdAt }
+func (k *APIKey) IsRevoked() bool                    { return k.revokedAt != nil }
+func (k *APIKey) IsExpired(now time.Time) bool {
+	return k.expiresAt != nil && !now.Before(*k.expiresAt)
+}
+func (k *APIKey) IsUsable(now time.Time) bool { return !k.IsRevoked() && !k.IsExpired(now) }
+func (k *APIKey) Matches(secret valueobjects.APIKeySecret) bool {
+	return secret.Prefix() == k.prefix && secret.MatchesHash(k.keyHash)
+}
+
+// ScopeValues retorna los scopes como strings
+func (k *APIKey) ScopeValues() []string {
+	values := make([]string, 0, len(k.scopes))
+	for _, scope := range k.scopes {
+		values = append(values, scope.Value())
+	}
+	return values
+}
+
+// Revoke invalida la key de forma permanente
+func (k *APIKey) Revoke(now time.Time) error {
+	if k.IsRevoked() {
+		return errors.New("API key is already revoked")
+	}
+	k.revokedAt = &now
+	return nil
+}
+
+// MarkUsed registra el uso; retorna false si el último registro es reciente y no hace falta persistir
+func (k *APIKey) MarkUsed(now time.Time) bool {
+	if k.lastUsedAt != nil && now.Sub(*k.lastUsedAt) < lastUsedResolution {
+		return false
+	}
+	k.lastUsedAt = &now
+	return true
+}
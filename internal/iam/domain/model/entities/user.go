@@ -1,16 +1,41 @@
 package entities
 
 import (
+	"errors"
 	"finanzas-backend/internal/iam/domain/model/valueobjects"
 	"time"
 )
 
+// MaxMFAFailedAttempts es la cantidad de códigos incorrectos (TOTP o de recuperación)
+// tolerados antes de bloquear el segundo factor durante MFALockoutDuration
+const MaxMFAFailedAttempts = 5
+
+const MFALockoutDuration = 15 * time.Minute
+
+// ErrMFALocked indica que el segundo factor está bloqueado por intentos fallidos
+var ErrMFALocked = errors.New("too many failed verification attempts, try again later")
+
 type User struct {
 	id        valueobjects.UserID
 	email     valueobjects.Email
 	password  valueobjects.Password
 	createdAt time.Time
 	updatedAt time.Time
+
+	// Autenticación de dos factores (TOTP)
+	mfaEnabled      bool
+	mfaSecret       valueobjects.TOTPSecret
+	mfaLastUsedStep int64 // Último paso TOTP aceptado (evita reutilizar un código)
+	recoveryCodes   []valueobjects.RecoveryCode
+
+	// Límite de intentos del segundo factor: el desafío pendiente es de un solo uso
+	mfaChallengeID    string
+	mfaFailedAttempts int
+	mfaLockedUntil    *time.Time
+
+	// Eliminación de cuenta (Ley 29733): se borra definitivamente al vencer el plazo de gracia
+	deletionRequestedAt  *time.Time
+	deletionScheduledFor *time.Time
 }
 
 func NewUser(email valueobjects.Email, password valueobjects.Password) (*User, error) {
@@ -22,26 +47,64 @@ func NewUser(email valueobjects.Email, password valueobjects.Password) (*User, e
 	}, nil
 }
 
-func ReconstructUser(id valueobjects.UserID, email valueobjects.Email, password valueobjects.Password, createdAt, updatedAt time.Time) *User {
+func ReconstructUser(
+	id valueobjects.UserID,
+	email valueobjects.Email,
+	password valueobjects.Password,
+	createdAt, updatedAt time.Time,
+	mfaEnabled bool,
+	mfaSecret valueobjects.TOTPSecret,
+	mfaLastUsedStep int64,
+	recoveryCodes []valueobjects.RecoveryCode,
+	mfaChallengeID string,
+	mfaFailedAttempts int,
+	mfaLockedUntil *time.Time,
+	deletionRequestedAt, deletionScheduledFor *time.Time,
+) *User {
 	return &User{
-		id:        id,
-		email:     email,
-		password:  password,
-		createdAt: createdAt,
-		updatedAt: updatedAt,
+		id:              id,
+		email:           email,
+		password:        password,
+		createdAt:       createdAt,
+		updatedAt:       updatedAt,
+		mfaEnabled:      mfaEnabled,
+		mfaSecret:       mfaSecret,
+		mfaLastUsedStep: mfaLastUsedStep,
+		recoveryCodes:   recoveryCodes,
+
+		mfaChallengeID:    mfaChallengeID,
+		mfaFailedAttempts: mfaFailedAttempts,
+		mfaLockedUntil:    mfaLockedUntil,
+
+		deletionRequestedAt:  deletionRequestedAt,
+		deletionScheduledFor: deletionScheduledFor,
 	}
 }
 
-func (u *User) ID() valueobjects.UserID         { return u.id }
-func (u *User) Email() valueobjects.Email       { return u.email }
-func (u *User) Password() valueobjects.Password { return u.password }
-func (u *User) CreatedAt() time.Time            { return u.createdAt }
-func (u *User) UpdatedAt() time.Time            { return u.updatedAt }
+func (u *User) ID() valueobjects.UserID                    { return u.id }
+func (u *User) Email() valueobjects.Email                  { return u.email }
+func (u *User) Password() valueobjects.Password            { return u.password }
+func (u *User) CreatedAt() time.Time                       { return u.createdAt }
+func (u *User) UpdatedAt() time.Time                       { return u.updatedAt }
+func (u *User) MFAEnabled() bool                           { return u.mfaEnabled }
+func (u *User) MFASecret() valueobjects.TOTPSecret         { return u.mfaSecret }
+func (u *User) MFALastUsedStep() int64                     { return u.mfaLastUsedStep }
+func (u *User) RecoveryCodes() []valueobjects.RecoveryCode { return u.recoveryCodes }
+func (u *User) MFAChallengeID() string                     { return u.mfaChallengeID }
+func (u *User) MFAFailedAttempts() int                     { return u.mfaFailedAttempts }
+func (u *User) MFALockedUntil() *time.Time                 { return u.mfaLockedUntil }
+func (u *User) DeletionRequestedAt() *time.Time            { return u.deletionRequestedAt }
+func (u *User) DeletionScheduledFor() *time.Time           { return u.deletionScheduledFor }
+func (u *User) IsDeletionPending() bool                    { return u.deletionScheduledFor != nil }
 
 func (u *User) SetID(id valueobjects.UserID) {
 	u.id = id
 }
 
+func (u *User) SetMFASecret(secret valueobjects.TOTPSecret) {
+	u.mfaSecret = secret
+}
+
 func (u *User) VerifyPassword(plainPassword string) bool {
 	return u.password.Matches(plainPassword)
 }
@@ -55,3 +118,134 @@ func (u *User) UpdatePassword(password valueobjects.Password) {
 	u.password = password
 	u.updatedAt = time.Now()
 }
+
+// HasPendingMFAEnrollment indica si hay un secreto generado que aún no fue confirmado
+func (u *User) HasPendingMFAEnrollment() bool {
+	return !u.mfaEnabled && !u.mfaSecret.IsZero()
+}
+
+// StartMFAEnrollment guarda un nuevo secreto pendiente de confirmación
+func (u *User) StartMFAEnrollment(secret valueobjects.TOTPSecret) error {
+	if u.mfaEnabled {
+		return errors.New("two-factor authentication is already enabled")
+	}
+	u.mfaSecret = secret
+	u.mfaLastUsedStep = 0
+	u.recoveryCodes = nil
+	u.updatedAt = time.Now()
+	return nil
+}
+
+// ConfirmMFA activa 2FA una vez que el usuario demostró poseer el secreto
+func (u *User) ConfirmMFA(step int64, recoveryCodes []valueobjects.RecoveryCode) error {
+	if !u.HasPendingMFAEnrollment() {
+		return errors.New("there is no pending two-factor enrollment")
+	}
+	u.mfaEnabled = true
+	u.mfaLastUsedStep = step
+	u.recoveryCodes = recoveryCodes
+	u.updatedAt = time.Now()
+	return nil
+}
+
+// DisableMFA elimina el secreto y los códigos de recuperación. El último paso TOTP se
+// conserva: el código usado para desactivar no debe poder reutilizarse.
+func (u *User) DisableMFA() {
+	u.mfaEnabled = false
+	u.mfaSecret = valueobjects.EmptyTOTPSecret()
+	u.recoveryCodes = nil
+	u.updatedAt = time.Now()
+}
+
+// RecordTOTPStep registra el paso TOTP usado para impedir su reutilización
+func (u *User) RecordTOTPStep(step int64) {
+	u.mfaLastUsedStep = step
+	u.updatedAt = time.Now()
+}
+
+// IsMFALocked indica si el segundo factor está bloqueado por demasiados intentos fallidos
+func (u *User) IsMFALocked(now time.Time) bool {
+	return u.mfaLockedUntil != nil && now.Before(*u.mfaLockedUntil)
+}
+
+// StartMFAChallenge registra el desafío emitido en el login; reemplaza cualquier desafío anterior
+func (u *User) StartMFAChallenge(challengeID string) {
+	u.mfaChallengeID = challengeID
+	u.updatedAt = time.Now()
+}
+
+// IsCurrentMFAChallenge indica si el desafío sigue pendiente (no fue usado ni reemplazado)
+func (u *User) IsCurrentMFAChallenge(challengeID string) bool {
+	return challengeID != "" && u.mfaChallengeID == challengeID
+}
+
+// RegisterMFAFailure cuenta un código incorrecto. Al llegar al máximo bloquea el segundo
+// factor, reinicia el contador e invalida el desafío pendiente.
+func (u *User) RegisterMFAFailure(now time.Time) {
+	u.mfaFailedAttempts++
+	if u.mfaFailedAttempts >= MaxMFAFailedAttempts {
+		lockedUntil := now.Add(MFALockoutDuration)
+		u.mfaLockedUntil = &lockedUntil
+		u.mfaFailedAttempts = 0
+		u.mfaChallengeID = ""
+	}
+	u.updatedAt = now
+}
+
+// CompleteMFAVerification reinicia el contador de intentos y consume el desafío pendiente
+func (u *User) CompleteMFAVerification() {
+	u.mfaFailedAttempts = 0
+	u.mfaLockedUntil = nil
+	u.mfaChallengeID = ""
+	u.updatedAt = time.Now()
+}
+
+// UseRecoveryCode consume un código de recuperación y lo retorna; false si no es válido o ya fue usado
+func (u *User) UseRecoveryCode(plain string) (valueobjects.RecoveryCode, bool) {
+	updated, idx, ok := valueobjects.MarkRecoveryCodeUsed(u.recoveryCodes, plain, time.Now())
+	if !ok {
+		return valueobjects.RecoveryCode{}, false
+	}
+	u.recoveryCodes = updated
+	u.updatedAt = time.Now()
+	return updated[idx], true
+}
+
+// RemainingRecoveryCodes retorna la cantidad de códigos de recuperación aún disponibles
+func (u *User) RemainingRecoveryCodes() int {
+	remaining := 0
+	for _, code := range u.recoveryCodes {
+		if !code.IsUsed() {
+			remaining++
+		}
+	}
+	return remaining
+}
+
+// RequestDeletion programa la eliminación definitiva de la cuenta tras el plazo de gracia
+func (u *User) RequestDeletion(now time.Time, gracePeriod time.Duration) error {
+	if u.IsDeletionPending() {
+		return errors.New("account deletion is already scheduled")
+	}
+	scheduledFor := now.Add(gracePeriod)
+	u.deletionRequestedAt = &now
+	u.deletionScheduledFor = &scheduledFor
+	u.updatedAt = now
+	return nil
+}
+
+// CancelDeletion anula una solicitud de eliminación dentro del plazo de gracia
+func (u *User) CancelDeletion() error {
+	if !u.IsDeletionPending() {
+		return errors.New("there is no scheduled account deletion")
+	}
+	u.deletionRequestedAt = nil
+	u.deletionScheduledFor = nil
+	u.updatedAt = time.Now()
+	return nil
+}
+
+// IsDeletionDue indica si ya venció el plazo de gracia
+func (u *User) IsDeletionDue(now time.Time) bool {
+	return u.deletionScheduledFor != nil && !now.Before(*u.deletionScheduledFor)
+}
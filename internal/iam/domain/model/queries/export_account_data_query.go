@@ -0,0 +1,19 @@
+package queries
+
+import (
+	"errors"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+)
+
+type ExportAccountDataQuery struct {
+	userID valueobjects.UserID
+}
+
+func NewExportAccountDataQuery(userID valueobjects.UserID) (ExportAccountDataQuery, error) {
+	if userID.IsZero() {
+		return ExportAccountDataQuery{}, errors.New("user ID cannot be zero")
+	}
+	return ExportAccountDataQuery{userID: userID}, nil
+}
+
+func (q ExportAccountDataQuery) UserID() valueobjects.UserID { return q.userID }
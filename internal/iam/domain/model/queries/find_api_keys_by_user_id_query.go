@@ -0,0 +1,19 @@
+package queries
+
+import (
+	"errors"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+)
+
+type FindAPIKeysByUserIDQuery struct {
+	userID valueobjects.UserID
+}
+
+func NewFindAPIKeysByUserIDQuery(userID valueobjects.UserID) (FindAPIKeysByUserIDQuery, error) {
+	if userID.IsZero() {
+		return FindAPIKeysByUserIDQuery{}, errors.New("user ID cannot be zero")
+	}
+	return FindAPIKeysByUserIDQuery{userID: userID}, nil
+}
+
+func (q FindAPIKeysByUserIDQuery) UserID() valueobjects.UserID { return q.userID }
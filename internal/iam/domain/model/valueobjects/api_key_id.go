@@ -0,0 +1,42 @@
+package valueobjects
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type APIKeyID struct {
+	value uuid.UUID
+}
+
+func NewAPIKeyID(value uuid.UUID) (APIKeyID, error) {
+	if value == uuid.Nil {
+		return APIKeyID{}, errors.New("API key ID cannot be nil")
+	}
+	return APIKeyID{value: value}, nil
+}
+
+func NewAPIKeyIDFromString(value string) (APIKeyID, error) {
+	parsedUUID, err := uuid.Parse(value)
+	if err != nil {
+		return APIKeyID{}, errors.New("invalid UUID format")
+	}
+	return NewAPIKeyID(parsedUUID)
+}
+
+func GenerateAPIKeyID() APIKeyID {
+	return APIKeyID{value: uuid.New()}
+}
+
+func (a APIKeyID) Value() uuid.UUID {
+	return a.value
+}
+
+func (a APIKeyID) String() string {
+	return a.value.String()
+}
+
+func (a APIKeyID) IsZero() bool {
+	return a.value == uuid.Nil
+}
@@ -0,0 +1,65 @@
+package valueobjects
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Scopes que se pueden otorgar a una API key. La gestión de la cuenta (IAM)
+// nunca es accesible con API keys, solo con una sesión de usuario.
+const (
+	ScopeMortgageRead  = "mortgage:read"
+	ScopeMortgageWrite = "mortgage:write"
+	ScopeProfileRead   = "profile:read"
+	ScopeProfileWrite  = "profile:write"
+)
+
+var assignableScopes = map[string]bool{
+	ScopeMortgageRead:  true,
+	ScopeMortgageWrite: true,
+	ScopeProfileRead:   true,
+	ScopeProfileWrite:  true,
+}
+
+type APIKeyScope struct {
+	value string
+}
+
+func NewAPIKeyScope(value string) (APIKeyScope, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if !assignableScopes[value] {
+		return APIKeyScope{}, fmt.Errorf("invalid API key scope %q", value)
+	}
+	return APIKeyScope{value: value}, nil
+}
+
+// NewAPIKeyScopes valida y deduplica una lista de scopes
+func NewAPIKeyScopes(values []string) ([]APIKeyScope, error) {
+	seen := make(map[string]bool, len(values))
+	scopes := make([]APIKeyScope, 0, len(values))
+	for _, value := range values {
+		scope, err := NewAPIKeyScope(value)
+		if err != nil {
+			return nil, err
+		}
+		if seen[scope.value] {
+			continue
+		}
+		seen[scope.value] = true
+		scopes = append(scopes, scope)
+	}
+	return scopes, nil
+}
+
+// DefaultAPIKeyScopes son los scopes de una key creada sin especificarlos: solo el simulador
+func DefaultAPIKeyScopes() []APIKeyScope {
+	return []APIKeyScope{{value: ScopeMortgageWrite}}
+}
+
+func (s APIKeyScope) Value() string {
+	return s.value
+}
+
+func (s APIKeyScope) String() string {
+	return s.value
+}
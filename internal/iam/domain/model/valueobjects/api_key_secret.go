@@ -0,0 +1,66 @@
+package valueobjects
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// Formato de una API key: fk_<prefijo>_<secreto>. El prefijo es público y permite
+// ubicar la key; del secreto solo se guarda el hash.
+const (
+	apiKeyMarker      = "fk"
+	apiKeyPrefixBytes = 6
+	apiKeySecretBytes = 24
+)
+
+// APIKeySecret es la key en texto plano; solo existe al crearla
+type APIKeySecret struct {
+	prefix string
+	value  string
+}
+
+func GenerateAPIKeySecret() (APIKeySecret, error) {
+	prefix := make([]byte, apiKeyPrefixBytes)
+	if _, err := rand.Read(prefix); err != nil {
+		return APIKeySecret{}, err
+	}
+	secret := make([]byte, apiKeySecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return APIKeySecret{}, err
+	}
+
+	prefixHex := hex.EncodeToString(prefix)
+	return APIKeySecret{
+		prefix: prefixHex,
+		value:  apiKeyMarker + "_" + prefixHex + "_" + hex.EncodeToString(secret),
+	}, nil
+}
+
+// ParseAPIKeySecret valida el formato de una key recibida
+func ParseAPIKeySecret(value string) (APIKeySecret, error) {
+	value = strings.TrimSpace(value)
+	parts := strings.Split(value, "_")
+	if len(parts) != 3 || parts[0] != apiKeyMarker ||
+		len(parts[1]) != apiKeyPrefixBytes*2 || len(parts[2]) != apiKeySecretBytes*2 {
+		return APIKeySecret{}, errors.New("invalid API key format")
+	}
+	return APIKeySecret{prefix: parts[1], value: value}, nil
+}
+
+func (s APIKeySecret) Prefix() string { return s.prefix }
+func (s APIKeySecret) Value() string  { return s.value }
+
+// Hash retorna el SHA-256 de la key (suficiente para secretos aleatorios de alta entropía)
+func (s APIKeySecret) Hash() string {
+	sum := sha256.Sum256([]byte(s.value))
+	return hex.EncodeToString(sum[:])
+}
+
+// MatchesHash compara en tiempo constante con el hash almacenado
+func (s APIKeySecret) MatchesHash(hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(s.Hash()), []byte(hash)) == 1
+}
@@ -0,0 +1,21 @@
+package valueobjects
+
+// AuthenticationResult representa el resultado del primer paso de login:
+// un token de acceso, o un desafío MFA cuando el usuario tiene 2FA habilitado
+type AuthenticationResult struct {
+	token          string
+	mfaRequired    bool
+	challengeToken string
+}
+
+func NewTokenAuthenticationResult(token string) AuthenticationResult {
+	return AuthenticationResult{token: token}
+}
+
+func NewMFAChallengeAuthenticationResult(challengeToken string) AuthenticationResult {
+	return AuthenticationResult{mfaRequired: true, challengeToken: challengeToken}
+}
+
+func (r AuthenticationResult) Token() string          { return r.token }
+func (r AuthenticationResult) MFARequired() bool      { return r.mfaRequired }
+func (r AuthenticationResult) ChallengeToken() string { return r.challengeToken }
@@ -6,7 +6,7 @@ import (
 )
 
 type DNI struct {
-	value string `json:"value" gorm:"column:dni"`
+	value string
 }
 
 var dniRegex = regexp.MustCompile(`^\d{8}$`)
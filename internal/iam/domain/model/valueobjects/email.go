@@ -7,7 +7,7 @@ import (
 )
 
 type Email struct {
-	value string `json:"value" gorm:"column:email"`
+	value string
 }
 
 var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
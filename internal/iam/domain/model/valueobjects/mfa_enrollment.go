@@ -0,0 +1,14 @@
+package valueobjects
+
+// MFAEnrollment contiene los datos que el usuario necesita para registrar su app autenticadora
+type MFAEnrollment struct {
+	secret          TOTPSecret
+	provisioningURI string
+}
+
+func NewMFAEnrollment(secret TOTPSecret, provisioningURI string) MFAEnrollment {
+	return MFAEnrollment{secret: secret, provisioningURI: provisioningURI}
+}
+
+func (e MFAEnrollment) Secret() TOTPSecret      { return e.secret }
+func (e MFAEnrollment) ProvisioningURI() string { return e.provisioningURI }
@@ -6,7 +6,7 @@ import (
 )
 
 type Password struct {
-	hashedValue string `json:"-" gorm:"column:password_hash"`
+	hashedValue string
 }
 
 func NewPassword(plainPassword string) (Password, error) {
@@ -0,0 +1,22 @@
+package valueobjects
+
+import "crypto"
+
+// PublicSigningKey es la parte pública de una llave de firma JWT, publicada vía JWKS
+type PublicSigningKey struct {
+	kid       string
+	algorithm string
+	publicKey crypto.PublicKey
+}
+
+func NewPublicSigningKey(kid, algorithm string, publicKey crypto.PublicKey) PublicSigningKey {
+	return PublicSigningKey{
+		kid:       kid,
+		algorithm: algorithm,
+		publicKey: publicKey,
+	}
+}
+
+func (k PublicSigningKey) KID() string                 { return k.kid }
+func (k PublicSigningKey) Algorithm() string           { return k.algorithm }
+func (k PublicSigningKey) PublicKey() crypto.PublicKey { return k.publicKey }
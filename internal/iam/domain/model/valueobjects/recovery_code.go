@@ -0,0 +1,93 @@
+package valueobjects
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// RecoveryCodeCount es la cantidad de códigos de recuperación emitidos por enrolamiento
+const RecoveryCodeCount = 10
+
+const recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+
+var alphabetSize = big.NewInt(int64(len(recoveryCodeAlphabet)))
+
+// RecoveryCode representa un código de recuperación de un solo uso (solo se guarda su hash)
+type RecoveryCode struct {
+	hash   string
+	usedAt *time.Time
+}
+
+// GenerateRecoveryCodes genera n códigos en texto plano (formato xxxxx-xxxxx) y sus hashes
+func GenerateRecoveryCodes(n int) ([]string, []RecoveryCode, error) {
+	if n <= 0 {
+		return nil, nil, errors.New("recovery code count must be greater than zero")
+	}
+
+	plain := make([]string, 0, n)
+	codes := make([]RecoveryCode, 0, n)
+	for i := 0; i < n; i++ {
+		var sb strings.Builder
+		for idx := 0; idx < 10; idx++ {
+			if idx == 5 {
+				sb.WriteByte('-')
+			}
+			// rand.Int descarta internamente los valores fuera de rango, sin sesgo de módulo
+			pos, err := rand.Int(rand.Reader, alphabetSize)
+			if err != nil {
+				return nil, nil, err
+			}
+			sb.WriteByte(recoveryCodeAlphabet[pos.Int64()])
+		}
+
+		code := sb.String()
+		plain = append(plain, code)
+		codes = append(codes, RecoveryCode{hash: hashRecoveryCode(code)})
+	}
+
+	return plain, codes, nil
+}
+
+func NewRecoveryCodeFromHash(hash string, usedAt *time.Time) RecoveryCode {
+	return RecoveryCode{hash: hash, usedAt: usedAt}
+}
+
+func (r RecoveryCode) Hash() string       { return r.hash }
+func (r RecoveryCode) UsedAt() *time.Time { return r.usedAt }
+func (r RecoveryCode) IsUsed() bool       { return r.usedAt != nil }
+
+// Matches compara en tiempo constante el código ingresado con el hash almacenado
+func (r RecoveryCode) Matches(plain string) bool {
+	candidate := hashRecoveryCode(plain)
+	return subtle.ConstantTimeCompare([]byte(candidate), []byte(r.hash)) == 1
+}
+
+func (r RecoveryCode) markUsed(at time.Time) RecoveryCode {
+	r.usedAt = &at
+	return r
+}
+
+// MarkRecoveryCodeUsed busca un código no usado que coincida, lo marca como usado y retorna su posición
+func MarkRecoveryCodeUsed(codes []RecoveryCode, plain string, at time.Time) ([]RecoveryCode, int, bool) {
+	for idx, code := range codes {
+		if !code.IsUsed() && code.Matches(plain) {
+			updated := make([]RecoveryCode, len(codes))
+			copy(updated, codes)
+			updated[idx] = code.markUsed(at)
+			return updated, idx, true
+		}
+	}
+	return codes, -1, false
+}
+
+func hashRecoveryCode(plain string) string {
+	normalized := strings.ToLower(strings.TrimSpace(plain))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,55 @@
+package valueobjects
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+)
+
+// totpSecretSize es el tamaño recomendado por RFC 4226 para secretos HMAC-SHA1 (160 bits)
+const totpSecretSize = 20
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TOTPSecret representa el secreto compartido (base32) de una app autenticadora
+type TOTPSecret struct {
+	value string
+}
+
+func GenerateTOTPSecret() (TOTPSecret, error) {
+	raw := make([]byte, totpSecretSize)
+	if _, err := rand.Read(raw); err != nil {
+		return TOTPSecret{}, err
+	}
+	return TOTPSecret{value: totpEncoding.EncodeToString(raw)}, nil
+}
+
+func NewTOTPSecret(value string) (TOTPSecret, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if value == "" {
+		return TOTPSecret{}, errors.New("TOTP secret cannot be empty")
+	}
+	if _, err := totpEncoding.DecodeString(value); err != nil {
+		return TOTPSecret{}, errors.New("TOTP secret must be base32 encoded")
+	}
+	return TOTPSecret{value: value}, nil
+}
+
+func EmptyTOTPSecret() TOTPSecret {
+	return TOTPSecret{}
+}
+
+// Value retorna el secreto en base32 (tal como se muestra en la app autenticadora)
+func (s TOTPSecret) Value() string {
+	return s.value
+}
+
+// Bytes retorna el secreto decodificado para el cálculo HMAC
+func (s TOTPSecret) Bytes() ([]byte, error) {
+	return totpEncoding.DecodeString(s.value)
+}
+
+func (s TOTPSecret) IsZero() bool {
+	return s.value == ""
+}
@@ -7,7 +7,7 @@ import (
 )
 
 type UserID struct {
-	value uuid.UUID `json:"value" gorm:"type:uuid;column:user_id"`
+	value uuid.UUID
 }
 
 func NewUserID(value uuid.UUID) (UserID, error) {
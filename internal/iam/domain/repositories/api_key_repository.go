@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+	"finanzas-backend/internal/iam/domain/model/entities"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+	"time"
+)
+
+type APIKeyRepository interface {
+	Save(ctx context.Context, apiKey *entities.APIKey) error
+	Update(ctx context.Context, apiKey *entities.APIKey) error
+	FindByID(ctx context.Context, id valueobjects.APIKeyID) (*entities.APIKey, error)
+	FindByPrefix(ctx context.Context, prefix string) (*entities.APIKey, error)
+	FindByUserID(ctx context.Context, userID valueobjects.UserID) ([]*entities.APIKey, error)
+	// TouchLastUsed registra solo el último uso de una key no revocada; no toca las columnas de seguridad
+	TouchLastUsed(ctx context.Context, id valueobjects.APIKeyID, at time.Time) error
+}
@@ -0,0 +1,9 @@
+package repositories
+
+import "context"
+
+// UnitOfWork agrupa escrituras de varios repositorios (y de otros contextos vía ACL) en una
+// sola transacción. Los repositorios usan la transacción que viaja en el ctx recibido por fn.
+type UnitOfWork interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"finanzas-backend/internal/iam/domain/model/entities"
 	"finanzas-backend/internal/iam/domain/model/valueobjects"
+	"time"
 )
 
 type UserRepository interface {
@@ -11,6 +12,18 @@ type UserRepository interface {
 	Update(ctx context.Context, user *entities.User) error
 	FindByID(ctx context.Context, id valueobjects.UserID) (*entities.User, error)
 	FindByIDValue(ctx context.Context, id string) (*entities.User, error)
+	// FindByIDForUpdate carga el usuario bloqueando su fila hasta el fin de la transacción del ctx
+	FindByIDForUpdate(ctx context.Context, id string) (*entities.User, error)
 	FindByEmail(ctx context.Context, email string) (*entities.User, error)
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
+	FindDueForDeletion(ctx context.Context, now time.Time) ([]*entities.User, error)
+	// LockDueForDeletion bloquea la fila del usuario hasta el fin de la transacción y confirma que
+	// su eliminación sigue programada y vencida
+	LockDueForDeletion(ctx context.Context, id valueobjects.UserID, now time.Time) (bool, error)
+	Delete(ctx context.Context, id valueobjects.UserID) error
+	// ReplaceRecoveryCodes reemplaza los códigos de recuperación guardados por los del usuario;
+	// Update no los escribe para no revivir códigos consumidos desde una lectura anterior
+	ReplaceRecoveryCodes(ctx context.Context, user *entities.User) error
+	// ConsumeRecoveryCode elimina el código solo si sigue sin usar; retorna false si ya fue consumido
+	ConsumeRecoveryCode(ctx context.Context, id valueobjects.UserID, codeHash string) (bool, error)
 }
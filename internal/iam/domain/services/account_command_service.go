@@ -0,0 +1,15 @@
+package services
+
+import (
+	"context"
+	"finanzas-backend/internal/iam/domain/model/commands"
+	"time"
+)
+
+type AccountCommandService interface {
+	// HandleRequestDeletion programa la eliminación y retorna la fecha en que se hará efectiva
+	HandleRequestDeletion(ctx context.Context, cmd commands.RequestAccountDeletionCommand) (time.Time, error)
+	HandleCancelDeletion(ctx context.Context, cmd commands.CancelAccountDeletionCommand) error
+	// HandlePurgeDueAccounts elimina definitivamente las cuentas con plazo de gracia vencido
+	HandlePurgeDueAccounts(ctx context.Context, now time.Time) (int, error)
+}
@@ -0,0 +1,11 @@
+package services
+
+import (
+	"context"
+	"finanzas-backend/internal/iam/domain/model/entities"
+	"finanzas-backend/internal/iam/domain/model/queries"
+)
+
+type AccountQueryService interface {
+	HandleExportData(ctx context.Context, query queries.ExportAccountDataQuery) (*entities.AccountDataExport, error)
+}
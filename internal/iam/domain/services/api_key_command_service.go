@@ -0,0 +1,14 @@
+package services
+
+import (
+	"context"
+	"finanzas-backend/internal/iam/domain/model/commands"
+	"finanzas-backend/internal/iam/domain/model/entities"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+)
+
+type APIKeyCommandService interface {
+	// HandleCreate retorna la key creada y su valor en texto plano (solo se muestra una vez)
+	HandleCreate(ctx context.Context, cmd commands.CreateAPIKeyCommand) (*entities.APIKey, valueobjects.APIKeySecret, error)
+	HandleRevoke(ctx context.Context, cmd commands.RevokeAPIKeyCommand) error
+}
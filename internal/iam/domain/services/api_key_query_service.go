@@ -0,0 +1,11 @@
+package services
+
+import (
+	"context"
+	"finanzas-backend/internal/iam/domain/model/entities"
+	"finanzas-backend/internal/iam/domain/model/queries"
+)
+
+type APIKeyQueryService interface {
+	HandleFindByUserID(ctx context.Context, query queries.FindAPIKeysByUserIDQuery) ([]*entities.APIKey, error)
+}
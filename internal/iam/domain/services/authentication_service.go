@@ -3,8 +3,10 @@ package services
 import (
 	"context"
 	"finanzas-backend/internal/iam/domain/model/commands"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
 )
 
 type AuthenticationService interface {
-	HandleLogin(ctx context.Context, cmd commands.LoginCommand) (string, error)
+	HandleLogin(ctx context.Context, cmd commands.LoginCommand) (valueobjects.AuthenticationResult, error)
+	HandleVerifyMFALogin(ctx context.Context, cmd commands.VerifyMFALoginCommand) (string, valueobjects.UserID, error)
 }
@@ -0,0 +1,13 @@
+package services
+
+import (
+	"context"
+	"finanzas-backend/internal/iam/domain/model/commands"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+)
+
+type MFACommandService interface {
+	HandleEnroll(ctx context.Context, cmd commands.EnrollMFACommand) (valueobjects.MFAEnrollment, error)
+	HandleConfirm(ctx context.Context, cmd commands.ConfirmMFACommand) ([]string, error)
+	HandleDisable(ctx context.Context, cmd commands.DisableMFACommand) error
+}
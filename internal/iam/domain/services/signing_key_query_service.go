@@ -0,0 +1,10 @@
+package services
+
+import (
+	"context"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+)
+
+type SigningKeyQueryService interface {
+	HandleGetPublicKeys(ctx context.Context) ([]valueobjects.PublicSigningKey, error)
+}
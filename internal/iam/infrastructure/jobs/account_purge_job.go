@@ -0,0 +1,49 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"finanzas-backend/internal/iam/domain/services"
+)
+
+// AccountPurgeJob elimina periódicamente las cuentas cuyo plazo de gracia de eliminación venció
+type AccountPurgeJob struct {
+	accountCommandService services.AccountCommandService
+	interval              time.Duration
+}
+
+func NewAccountPurgeJob(accountCommandService services.AccountCommandService, interval time.Duration) *AccountPurgeJob {
+	return &AccountPurgeJob{
+		accountCommandService: accountCommandService,
+		interval:              interval,
+	}
+}
+
+// Start ejecuta la purga al iniciar y luego cada intervalo, hasta que ctx se cancele
+func (j *AccountPurgeJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.run(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *AccountPurgeJob) run(ctx context.Context) {
+	purged, err := j.accountCommandService.HandlePurgeDueAccounts(ctx, time.Now())
+	if err != nil {
+		log.Printf("Account purge failed: %v", err)
+		return
+	}
+	if purged > 0 {
+		log.Printf("Account purge: %d account(s) permanently deleted", purged)
+	}
+}
@@ -30,7 +30,7 @@ func JWTAuthMiddleware(jwtService *security.JWTService) gin.HandlerFunc {
 		tokenString := parts[1]
 
 		// Validar el token
-		claims, err := jwtService.ValidateToken(tokenString)
+		claims, err := jwtService.ValidateToken(c.Request.Context(), tokenString)
 		if err != nil {
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
 			c.Abort()
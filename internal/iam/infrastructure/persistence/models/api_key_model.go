@@ -0,0 +1,79 @@
+package models
+
+import (
+	"finanzas-backend/internal/iam/domain/model/entities"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKeyModel almacena una API key; del secreto solo se guarda su hash SHA-256
+type APIKeyModel struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primaryKey;column:id"`
+	UserID     uuid.UUID  `gorm:"type:uuid;not null;index;column:user_id"`
+	Name       string     `gorm:"type:varchar(100);not null;column:name"`
+	Prefix     string     `gorm:"type:varchar(16);not null;uniqueIndex;column:prefix"`
+	KeyHash    string     `gorm:"type:varchar(64);not null;column:key_hash"`
+	Scopes     string     `gorm:"type:varchar(255);not null;column:scopes"` // separados por coma
+	ExpiresAt  *time.Time `gorm:"column:expires_at"`
+	LastUsedAt *time.Time `gorm:"column:last_used_at"`
+	RevokedAt  *time.Time `gorm:"column:revoked_at"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime;column:created_at"`
+
+	User UserModel `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
+
+func (APIKeyModel) TableName() string {
+	return "api_keys"
+}
+
+func (m *APIKeyModel) ToEntity() (*entities.APIKey, error) {
+	id, err := valueobjects.NewAPIKeyID(m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := valueobjects.NewUserID(m.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var scopeValues []string
+	if m.Scopes != "" {
+		scopeValues = strings.Split(m.Scopes, ",")
+	}
+	scopes, err := valueobjects.NewAPIKeyScopes(scopeValues)
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.ReconstructAPIKey(
+		id,
+		userID,
+		m.Name,
+		m.Prefix,
+		m.KeyHash,
+		scopes,
+		m.ExpiresAt,
+		m.LastUsedAt,
+		m.RevokedAt,
+		m.CreatedAt,
+	), nil
+}
+
+func APIKeyModelFromEntity(apiKey *entities.APIKey) *APIKeyModel {
+	return &APIKeyModel{
+		ID:         apiKey.ID().Value(),
+		UserID:     apiKey.UserID().Value(),
+		Name:       apiKey.Name(),
+		Prefix:     apiKey.Prefix(),
+		KeyHash:    apiKey.KeyHash(),
+		Scopes:     strings.Join(apiKey.ScopeValues(), ","),
+		ExpiresAt:  apiKey.ExpiresAt(),
+		LastUsedAt: apiKey.LastUsedAt(),
+		RevokedAt:  apiKey.RevokedAt(),
+		CreatedAt:  apiKey.CreatedAt(),
+	}
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecoveryCodeModel almacena el hash de un código de recuperación de 2FA
+type RecoveryCodeModel struct {
+	ID       uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid();column:id"`
+	UserID   uuid.UUID  `gorm:"type:uuid;not null;index;column:user_id"`
+	CodeHash string     `gorm:"type:varchar(64);not null;column:code_hash"`
+	UsedAt   *time.Time `gorm:"column:used_at"`
+}
+
+func (RecoveryCodeModel) TableName() string {
+	return "user_recovery_codes"
+}
@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// SigningKeyModel almacena una llave de firma JWT; la llave privada se guarda cifrada
+type SigningKeyModel struct {
+	KID                 string     `gorm:"type:varchar(64);primaryKey;column:kid"`
+	Algorithm           string     `gorm:"type:varchar(16);not null;column:algorithm"`
+	PrivateKeyEncrypted string     `gorm:"type:text;not null;column:private_key_encrypted"`
+	PublicKeyPEM        string     `gorm:"type:text;not null;column:public_key_pem"`
+	CreatedAt           time.Time  `gorm:"not null;index;column:created_at"`
+	RetiredAt           *time.Time `gorm:"index;column:retired_at"`
+
+	// Vacíos en llaves creadas antes de registrar la activación y el reemplazo
+	ActivatedAt  *time.Time `gorm:"column:activated_at"`
+	SupersededAt *time.Time `gorm:"column:superseded_at"`
+}
+
+func (SigningKeyModel) TableName() string {
+	return "jwt_signing_keys"
+}
@@ -9,17 +9,33 @@ import (
 )
 
 type UserModel struct {
-	ID           uuid.UUID `gorm:"type:uuid;primaryKey;column:id"`
-	Email        string    `gorm:"uniqueIndex;not null;column:email"`
-	PasswordHash string    `gorm:"not null;column:password_hash"`
-	CreatedAt    time.Time `gorm:"autoCreateTime;column:created_at"`
-	UpdatedAt    time.Time `gorm:"autoUpdateTime;column:updated_at"`
+	ID                 uuid.UUID `gorm:"type:uuid;primaryKey;column:id"`
+	Email              string    `gorm:"uniqueIndex;not null;column:email"`
+	PasswordHash       string    `gorm:"not null;column:password_hash"`
+	MFAEnabled         bool      `gorm:"not null;default:false;column:mfa_enabled"`
+	MFASecretEncrypted string    `gorm:"type:varchar(255);default:'';column:mfa_secret_encrypted"`
+	MFALastUsedStep    int64     `gorm:"not null;default:0;column:mfa_last_used_step"`
+	CreatedAt          time.Time `gorm:"autoCreateTime;column:created_at"`
+	UpdatedAt          time.Time `gorm:"autoUpdateTime;column:updated_at"`
+
+	// Desafío MFA pendiente y límite de intentos fallidos
+	MFAChallengeID    string     `gorm:"type:varchar(64);default:'';column:mfa_challenge_id"`
+	MFAFailedAttempts int        `gorm:"not null;default:0;column:mfa_failed_attempts"`
+	MFALockedUntil    *time.Time `gorm:"column:mfa_locked_until"`
+
+	// Eliminación programada de la cuenta
+	DeletionRequestedAt  *time.Time `gorm:"column:deletion_requested_at"`
+	DeletionScheduledFor *time.Time `gorm:"index;column:deletion_scheduled_for"`
+
+	// Relación con los códigos de recuperación de 2FA
+	RecoveryCodes []RecoveryCodeModel `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
 }
 
 func (UserModel) TableName() string {
 	return "users"
 }
 
+// ToEntity convierte el modelo a entidad. El secreto MFA queda vacío: lo descifra el repositorio.
 func (m *UserModel) ToEntity() (*entities.User, error) {
 	userID, err := valueobjects.NewUserID(m.ID)
 	if err != nil {
@@ -33,15 +49,59 @@ func (m *UserModel) ToEntity() (*entities.User, error) {
 
 	password := valueobjects.NewPasswordFromHash(m.PasswordHash)
 
-	return entities.ReconstructUser(userID, email, password, m.CreatedAt, m.UpdatedAt), nil
+	recoveryCodes := make([]valueobjects.RecoveryCode, 0, len(m.RecoveryCodes))
+	for _, code := range m.RecoveryCodes {
+		recoveryCodes = append(recoveryCodes, valueobjects.NewRecoveryCodeFromHash(code.CodeHash, code.UsedAt))
+	}
+
+	return entities.ReconstructUser(
+		userID,
+		email,
+		password,
+		m.CreatedAt,
+		m.UpdatedAt,
+		m.MFAEnabled,
+		valueobjects.EmptyTOTPSecret(),
+		m.MFALastUsedStep,
+		recoveryCodes,
+		m.MFAChallengeID,
+		m.MFAFailedAttempts,
+		m.MFALockedUntil,
+		m.DeletionRequestedAt,
+		m.DeletionScheduledFor,
+	), nil
 }
 
+// FromEntity convierte la entidad a modelo. El secreto MFA cifrado lo asigna el repositorio.
 func FromEntity(user *entities.User) *UserModel {
 	return &UserModel{
-		ID:           user.ID().Value(),
-		Email:        user.Email().Value(),
-		PasswordHash: user.Password().Hash(),
-		CreatedAt:    user.CreatedAt(),
-		UpdatedAt:    user.UpdatedAt(),
+		ID:              user.ID().Value(),
+		Email:           user.Email().Value(),
+		PasswordHash:    user.Password().Hash(),
+		MFAEnabled:      user.MFAEnabled(),
+		MFALastUsedStep: user.MFALastUsedStep(),
+		CreatedAt:       user.CreatedAt(),
+		UpdatedAt:       user.UpdatedAt(),
+
+		MFAChallengeID:    user.MFAChallengeID(),
+		MFAFailedAttempts: user.MFAFailedAttempts(),
+		MFALockedUntil:    user.MFALockedUntil(),
+
+		DeletionRequestedAt:  user.DeletionRequestedAt(),
+		DeletionScheduledFor: user.DeletionScheduledFor(),
+	}
+}
+
+// RecoveryCodeModelsFromEntity convierte los códigos de recuperación de la entidad a modelos
+func RecoveryCodeModelsFromEntity(user *entities.User) []RecoveryCodeModel {
+	codes := user.RecoveryCodes()
+	models := make([]RecoveryCodeModel, 0, len(codes))
+	for _, code := range codes {
+		models = append(models, RecoveryCodeModel{
+			UserID:   user.ID().Value(),
+			CodeHash: code.Hash(),
+			UsedAt:   code.UsedAt(),
+		})
 	}
+	return models
 }
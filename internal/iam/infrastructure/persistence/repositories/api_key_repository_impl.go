@@ -0,0 +1,96 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"finanzas-backend/internal/iam/domain/model/entities"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+	domain_repos "finanzas-backend/internal/iam/domain/repositories"
+	"finanzas-backend/internal/iam/infrastructure/persistence/models"
+	"finanzas-backend/internal/shared/infrastructure/persistence"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type apiKeyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewAPIKeyRepository(db *gorm.DB) domain_repos.APIKeyRepository {
+	return &apiKeyRepositoryImpl{db: db}
+}
+
+func (r *apiKeyRepositoryImpl) Save(ctx context.Context, apiKey *entities.APIKey) error {
+	model := models.APIKeyModelFromEntity(apiKey)
+	return persistence.Conn(ctx, r.db).Omit("User").Create(model).Error
+}
+
+func (r *apiKeyRepositoryImpl) Update(ctx context.Context, apiKey *entities.APIKey) error {
+	model := models.APIKeyModelFromEntity(apiKey)
+
+	result := persistence.Conn(ctx, r.db).Model(&models.APIKeyModel{}).
+		Where("id = ?", apiKey.ID().Value()).
+		Updates(map[string]interface{}{
+			"name":       model.Name,
+			"scopes":     model.Scopes,
+			"expires_at": model.ExpiresAt,
+			"revoked_at": model.RevokedAt,
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("API key not found")
+	}
+
+	return nil
+}
+
+// TouchLastUsed no falla si la key fue revocada entre la lectura y la escritura: simplemente no la actualiza
+func (r *apiKeyRepositoryImpl) TouchLastUsed(ctx context.Context, id valueobjects.APIKeyID, at time.Time) error {
+	return persistence.Conn(ctx, r.db).Model(&models.APIKeyModel{}).
+		Where("id = ? AND revoked_at IS NULL", id.Value()).
+		Update("last_used_at", at).Error
+}
+
+func (r *apiKeyRepositoryImpl) FindByID(ctx context.Context, id valueobjects.APIKeyID) (*entities.APIKey, error) {
+	return r.findOne(ctx, "id = ?", id.Value())
+}
+
+func (r *apiKeyRepositoryImpl) FindByPrefix(ctx context.Context, prefix string) (*entities.APIKey, error) {
+	return r.findOne(ctx, "prefix = ?", prefix)
+}
+
+func (r *apiKeyRepositoryImpl) FindByUserID(ctx context.Context, userID valueobjects.UserID) ([]*entities.APIKey, error) {
+	var rows []models.APIKeyModel
+	if err := persistence.Conn(ctx, r.db).
+		Where("user_id = ?", userID.Value()).
+		Order("created_at DESC").
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	apiKeys := make([]*entities.APIKey, 0, len(rows))
+	for i := range rows {
+		apiKey, err := rows[i].ToEntity()
+		if err != nil {
+			return nil, err
+		}
+		apiKeys = append(apiKeys, apiKey)
+	}
+	return apiKeys, nil
+}
+
+func (r *apiKeyRepositoryImpl) findOne(ctx context.Context, condition string, value interface{}) (*entities.APIKey, error) {
+	var model models.APIKeyModel
+	if err := persistence.Conn(ctx, r.db).Where(condition, value).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return model.ToEntity()
+}
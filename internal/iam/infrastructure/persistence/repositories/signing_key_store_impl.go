@@ -0,0 +1,104 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"finanzas-backend/internal/iam/infrastructure/persistence/models"
+	iamSecurity "finanzas-backend/internal/iam/infrastructure/security"
+	"finanzas-backend/internal/shared/infrastructure/security"
+	"gorm.io/gorm"
+)
+
+type signingKeyStoreImpl struct {
+	db                *gorm.DB
+	encryptionService *security.EncryptionService
+}
+
+func NewSigningKeyStore(db *gorm.DB, encryptionService *security.EncryptionService) iamSecurity.SigningKeyStore {
+	return &signingKeyStoreImpl{
+		db:                db,
+		encryptionService: encryptionService,
+	}
+}
+
+// LoadKeys carga las llaves no retiradas
+func (s *signingKeyStoreImpl) LoadKeys(ctx context.Context) ([]*iamSecurity.SigningKey, error) {
+	var rows []models.SigningKeyModel
+	if err := s.db.WithContext(ctx).Where("retired_at IS NULL").Order("created_at DESC").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	keys := make([]*iamSecurity.SigningKey, 0, len(rows))
+	for _, row := range rows {
+		privatePEM, err := s.encryptionService.Decrypt(row.PrivateKeyEncrypted)
+		if err != nil {
+			return nil, err
+		}
+
+		signer, err := iamSecurity.ParsePrivateKeyPEM(privatePEM)
+		if err != nil {
+			return nil, err
+		}
+
+		activatedAt := row.CreatedAt
+		if row.ActivatedAt != nil {
+			activatedAt = *row.ActivatedAt
+		}
+
+		keys = append(keys, &iamSecurity.SigningKey{
+			KID:        row.KID,
+			Algorithm:  row.Algorithm,
+			PrivateKey: signer,
+			CreatedAt:  row.CreatedAt,
+			RetiredAt:  row.RetiredAt,
+
+			ActivatedAt:  activatedAt,
+			SupersededAt: row.SupersededAt,
+		})
+	}
+
+	return keys, nil
+}
+
+func (s *signingKeyStoreImpl) SaveKey(ctx context.Context, key *iamSecurity.SigningKey) error {
+	privatePEM, err := key.MarshalPrivateKeyPEM()
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := s.encryptionService.Encrypt(privatePEM)
+	if err != nil {
+		return err
+	}
+
+	publicPEM, err := key.MarshalPublicKeyPEM()
+	if err != nil {
+		return err
+	}
+
+	activatedAt := key.ActivatedAt
+	return s.db.WithContext(ctx).Create(&models.SigningKeyModel{
+		KID:                 key.KID,
+		Algorithm:           key.Algorithm,
+		PrivateKeyEncrypted: encrypted,
+		PublicKeyPEM:        publicPEM,
+		CreatedAt:           key.CreatedAt,
+
+		ActivatedAt: &activatedAt,
+	}).Error
+}
+
+// SupersedeKey es idempotente: conserva el primer superseded_at registrado por cualquier instancia
+func (s *signingKeyStoreImpl) SupersedeKey(ctx context.Context, kid string, supersededAt time.Time) error {
+	return s.db.WithContext(ctx).Model(&models.SigningKeyModel{}).
+		Where("kid = ? AND superseded_at IS NULL", kid).
+		Update("superseded_at", supersededAt).Error
+}
+
+// RetireKey es idempotente: otra instancia puede haber retirado la llave antes
+func (s *signingKeyStoreImpl) RetireKey(ctx context.Context, kid string, retiredAt time.Time) error {
+	return s.db.WithContext(ctx).Model(&models.SigningKeyModel{}).
+		Where("kid = ? AND retired_at IS NULL", kid).
+		Update("retired_at", retiredAt).Error
+}
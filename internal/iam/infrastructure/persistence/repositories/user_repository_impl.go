@@ -7,31 +7,43 @@ import (
 	"finanzas-backend/internal/iam/domain/model/valueobjects"
 	domain_repos "finanzas-backend/internal/iam/domain/repositories"
 	"finanzas-backend/internal/iam/infrastructure/persistence/models"
+	"finanzas-backend/internal/shared/infrastructure/persistence"
+	"finanzas-backend/internal/shared/infrastructure/security"
+	"time"
+
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type userRepositoryImpl struct {
-	db *gorm.DB
+	db                *gorm.DB
+	encryptionService *security.EncryptionService
 }
 
-func NewUserRepository(db *gorm.DB) domain_repos.UserRepository {
-	return &userRepositoryImpl{db: db}
+func NewUserRepository(db *gorm.DB, encryptionService *security.EncryptionService) domain_repos.UserRepository {
+	return &userRepositoryImpl{
+		db:                db,
+		encryptionService: encryptionService,
+	}
 }
 
 func (r *userRepositoryImpl) Save(ctx context.Context, user *entities.User) error {
-	model := models.FromEntity(user)
+	model, err := r.toModel(user)
+	if err != nil {
+		return err
+	}
 
 	if user.ID().IsZero() {
 		// Create - Generate new UUID
 		model.ID = valueobjects.GenerateUserID().Value()
-		if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		if err := persistence.Conn(ctx, r.db).Create(model).Error; err != nil {
 			return err
 		}
 		userID, _ := valueobjects.NewUserID(model.ID)
 		user.SetID(userID)
 	} else {
 		// Update
-		if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+		if err := persistence.Conn(ctx, r.db).Save(model).Error; err != nil {
 			return err
 		}
 	}
@@ -40,10 +52,16 @@ func (r *userRepositoryImpl) Save(ctx context.Context, user *entities.User) erro
 }
 
 func (r *userRepositoryImpl) Update(ctx context.Context, user *entities.User) error {
-	model := models.FromEntity(user)
+	model, err := r.toModel(user)
+	if err != nil {
+		return err
+	}
 
-	result := r.db.WithContext(ctx).Model(&models.UserModel{}).
+	// Select("*") para persistir también valores cero (p. ej. al desactivar 2FA)
+	result := persistence.Conn(ctx, r.db).Model(&models.UserModel{}).
 		Where("id = ?", user.ID().Value()).
+		Select("*").
+		Omit("id", "created_at", "RecoveryCodes").
 		Updates(model)
 
 	if result.Error != nil {
@@ -57,36 +75,164 @@ func (r *userRepositoryImpl) Update(ctx context.Context, user *entities.User) er
 	return nil
 }
 
+func (r *userRepositoryImpl) ReplaceRecoveryCodes(ctx context.Context, user *entities.User) error {
+	return persistence.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", user.ID().Value()).
+			Delete(&models.RecoveryCodeModel{}).Error; err != nil {
+			return err
+		}
+
+		recoveryCodes := models.RecoveryCodeModelsFromEntity(user)
+		if len(recoveryCodes) > 0 {
+			if err := tx.Create(&recoveryCodes).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *userRepositoryImpl) ConsumeRecoveryCode(ctx context.Context, id valueobjects.UserID, codeHash string) (bool, error) {
+	// Borrado condicional: de dos peticiones con el mismo código solo una afecta la fila
+	result := persistence.Conn(ctx, r.db).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", id.Value(), codeHash).
+		Delete(&models.RecoveryCodeModel{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
 func (r *userRepositoryImpl) FindByID(ctx context.Context, id valueobjects.UserID) (*entities.User, error) {
 	return r.FindByIDValue(ctx, id.String())
 }
 
 func (r *userRepositoryImpl) FindByIDValue(ctx context.Context, id string) (*entities.User, error) {
 	var model models.UserModel
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+	if err := persistence.Conn(ctx, r.db).Preload("RecoveryCodes").Where("id = ?", id).First(&model).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
 		return nil, err
 	}
-	return model.ToEntity()
+	return r.toEntity(&model)
+}
+
+func (r *userRepositoryImpl) FindByIDForUpdate(ctx context.Context, id string) (*entities.User, error) {
+	var model models.UserModel
+	if err := persistence.Conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Preload("RecoveryCodes").
+		Where("id = ?", id).
+		First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toEntity(&model)
 }
 
 func (r *userRepositoryImpl) FindByEmail(ctx context.Context, email string) (*entities.User, error) {
 	var model models.UserModel
-	if err := r.db.WithContext(ctx).Where("email = ?", email).First(&model).Error; err != nil {
+	if err := persistence.Conn(ctx, r.db).Preload("RecoveryCodes").Where("email = ?", email).First(&model).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
 		return nil, err
 	}
-	return model.ToEntity()
+	return r.toEntity(&model)
 }
 
 func (r *userRepositoryImpl) ExistsByEmail(ctx context.Context, email string) (bool, error) {
 	var count int64
-	if err := r.db.WithContext(ctx).Model(&models.UserModel{}).Where("email = ?", email).Count(&count).Error; err != nil {
+	if err := persistence.Conn(ctx, r.db).Model(&models.UserModel{}).Where("email = ?", email).Count(&count).Error; err != nil {
 		return false, err
 	}
 	return count > 0, nil
 }
+
+// FindDueForDeletion retorna los usuarios cuyo plazo de gracia de eliminación ya venció
+func (r *userRepositoryImpl) FindDueForDeletion(ctx context.Context, now time.Time) ([]*entities.User, error) {
+	var rows []models.UserModel
+	if err := persistence.Conn(ctx, r.db).
+		Where("deletion_scheduled_for IS NOT NULL AND deletion_scheduled_for <= ?", now).
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	users := make([]*entities.User, 0, len(rows))
+	for i := range rows {
+		user, err := rows[i].ToEntity()
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+func (r *userRepositoryImpl) LockDueForDeletion(ctx context.Context, id valueobjects.UserID, now time.Time) (bool, error) {
+	var rows []models.UserModel
+	if err := persistence.Conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		Where("id = ? AND deletion_scheduled_for IS NOT NULL AND deletion_scheduled_for <= ?", id.Value(), now).
+		Limit(1).
+		Find(&rows).Error; err != nil {
+		return false, err
+	}
+	return len(rows) > 0, nil
+}
+
+// Delete elimina definitivamente al usuario; los códigos de recuperación y API keys se borran en cascada
+func (r *userRepositoryImpl) Delete(ctx context.Context, id valueobjects.UserID) error {
+	return persistence.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", id.Value()).Delete(&models.RecoveryCodeModel{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", id.Value()).Delete(&models.APIKeyModel{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id.Value()).Delete(&models.UserModel{}).Error
+	})
+}
+
+// toModel convierte la entidad a modelo cifrando el secreto TOTP
+func (r *userRepositoryImpl) toModel(user *entities.User) (*models.UserModel, error) {
+	model := models.FromEntity(user)
+
+	encryptedSecret, err := r.encryptionService.Encrypt(user.MFASecret().Value())
+	if err != nil {
+		return nil, err
+	}
+	model.MFASecretEncrypted = encryptedSecret
+
+	return model, nil
+}
+
+// toEntity convierte el modelo a entidad descifrando el secreto TOTP
+func (r *userRepositoryImpl) toEntity(model *models.UserModel) (*entities.User, error) {
+	user, err := model.ToEntity()
+	if err != nil {
+		return nil, err
+	}
+
+	if model.MFASecretEncrypted == "" {
+		return user, nil
+	}
+
+	decryptedSecret, err := r.encryptionService.Decrypt(model.MFASecretEncrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := valueobjects.NewTOTPSecret(decryptedSecret)
+	if err != nil {
+		return nil, err
+	}
+	user.SetMFASecret(secret)
+
+	return user, nil
+}
@@ -1,6 +1,7 @@
 package security
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -8,23 +9,31 @@ import (
 )
 
 // JWTService maneja la generación y validación de tokens JWT
+// con llaves asimétricas (RS256/EdDSA) identificadas por kid
 type JWTService struct {
-	secretKey     string
+	keyManager    *KeyManager
 	issuer        string
 	expirationHrs int
 }
 
+// mfaChallengePurpose identifica los tokens de desafío MFA, que no sirven como tokens de acceso
+const mfaChallengePurpose = "mfa_challenge"
+
+// mfaChallengeTTL es la vigencia del token de desafío entre el login y el ingreso del código
+const mfaChallengeTTL = 5 * time.Minute
+
 // JWTClaims representa los claims personalizados del JWT
 type JWTClaims struct {
-	UserID string `json:"user_id"`
-	Email  string `json:"email"`
+	UserID  string `json:"user_id"`
+	Email   string `json:"email"`
+	Purpose string `json:"purpose,omitempty"`
 	jwt.RegisteredClaims
 }
 
 // NewJWTService crea una nueva instancia del servicio JWT
-func NewJWTService(secretKey string, issuer string, expirationHrs int) *JWTService {
+func NewJWTService(keyManager *KeyManager, issuer string, expirationHrs int) *JWTService {
 	return &JWTService{
-		secretKey:     secretKey,
+		keyManager:    keyManager,
 		issuer:        issuer,
 		expirationHrs: expirationHrs,
 	}
@@ -43,8 +52,72 @@ func (s *JWTService) GenerateToken(userID string, email string) (string, error)
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(s.secretKey))
+	return s.sign(claims)
+}
+
+// GenerateMFAChallengeToken genera un token de corta duración que solo permite completar el segundo factor.
+// challengeID va como jti para que el token sea de un solo uso.
+func (s *JWTService) GenerateMFAChallengeToken(userID string, challengeID string) (string, error) {
+	claims := JWTClaims{
+		UserID:  userID,
+		Purpose: mfaChallengePurpose,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        challengeID,
+			Issuer:    s.issuer,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(mfaChallengeTTL)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	return s.sign(claims)
+}
+
+// ValidateToken valida un token JWT de acceso y retorna los claims
+func (s *JWTService) ValidateToken(ctx context.Context, tokenString string) (*JWTClaims, error) {
+	claims, err := s.parse(ctx, tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	// Los tokens de desafío MFA no otorgan acceso a la API
+	if claims.Purpose != "" {
+		return nil, errors.New("invalid token purpose")
+	}
+
+	return claims, nil
+}
+
+// ValidateMFAChallengeToken valida un token de desafío MFA y retorna los claims
+func (s *JWTService) ValidateMFAChallengeToken(ctx context.Context, tokenString string) (*JWTClaims, error) {
+	claims, err := s.parse(ctx, tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Purpose != mfaChallengePurpose {
+		return nil, errors.New("invalid token purpose")
+	}
+
+	return claims, nil
+}
+
+// sign firma con la llave vigente e incluye su kid en el header
+func (s *JWTService) sign(claims JWTClaims) (string, error) {
+	key, err := s.keyManager.Current()
+	if err != nil {
+		return "", err
+	}
+
+	method, err := key.SigningMethod()
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = key.KID
+
+	tokenString, err := token.SignedString(key.PrivateKey)
 	if err != nil {
 		return "", err
 	}
@@ -52,15 +125,26 @@ func (s *JWTService) GenerateToken(userID string, email string) (string, error)
 	return tokenString, nil
 }
 
-// ValidateToken valida un token JWT y retorna los claims
-func (s *JWTService) ValidateToken(tokenString string) (*JWTClaims, error) {
+// parse verifica la firma contra cualquier llave no retirada según el kid del header.
+// ctx es el del request: cancela la recarga de llaves si el cliente se desconecta.
+func (s *JWTService) parse(ctx context.Context, tokenString string) (*JWTClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		// Verificar que el método de firma sea HMAC
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		kid, ok := token.Header["kid"].(string)
+		if !ok || kid == "" {
+			return nil, errors.New("missing token key id")
+		}
+
+		key, err := s.keyManager.Lookup(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+
+		// El algoritmo del token debe coincidir con el de la llave (evita confusión de algoritmos)
+		if token.Method.Alg() != key.Algorithm {
 			return nil, errors.New("invalid token signing method")
 		}
-		return []byte(s.secretKey), nil
-	})
+		return key.PublicKey(), nil
+	}, jwt.WithValidMethods([]string{AlgorithmRS256, AlgorithmEdDSA}), jwt.WithIssuer(s.issuer))
 
 	if err != nil {
 		return nil, err
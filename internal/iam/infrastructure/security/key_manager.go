@@ -0,0 +1,278 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+// rotationCheckInterval es cada cuánto se revisa si corresponde rotar o retirar llaves
+const rotationCheckInterval = time.Hour
+
+// minLookupReloadInterval limita las recargas del store provocadas por kids desconocidos
+const minLookupReloadInterval = 10 * time.Second
+
+// SigningKeyStore persiste las llaves de firma para que sobrevivan reinicios y se compartan entre instancias
+type SigningKeyStore interface {
+	LoadKeys(ctx context.Context) ([]*SigningKey, error)
+	SaveKey(ctx context.Context, key *SigningKey) error
+	SupersedeKey(ctx context.Context, kid string, supersededAt time.Time) error
+	RetireKey(ctx context.Context, kid string, retiredAt time.Time) error
+}
+
+// KeyManager mantiene el conjunto de llaves de firma vigentes.
+// La llave más reciente firma; todas las no retiradas verifican.
+type KeyManager struct {
+	store            SigningKeyStore
+	algorithm        string
+	rotationInterval time.Duration
+	tokenTTL         time.Duration
+	now              func() time.Time
+
+	mu         sync.RWMutex
+	keys       map[string]*SigningKey
+	lastReload time.Time
+
+	// reloadMu agrupa las búsquedas concurrentes de un kid desconocido en una sola recarga
+	reloadMu sync.Mutex
+}
+
+// NewKeyManager crea un gestor de llaves. tokenTTL es la vigencia máxima de un token firmado,
+// de modo que una llave reemplazada se retire solo cuando ya no puede haber tokens válidos firmados con ella.
+func NewKeyManager(store SigningKeyStore, algorithm string, rotationInterval, tokenTTL time.Duration) (*KeyManager, error) {
+	if algorithm != AlgorithmRS256 && algorithm != AlgorithmEdDSA {
+		return nil, errors.New("JWT signing algorithm must be RS256 or EdDSA")
+	}
+	if rotationInterval <= 0 {
+		return nil, errors.New("key rotation interval must be greater than zero")
+	}
+
+	return &KeyManager{
+		store:            store,
+		algorithm:        algorithm,
+		rotationInterval: rotationInterval,
+		tokenTTL:         tokenTTL,
+		now:              time.Now,
+		keys:             make(map[string]*SigningKey),
+	}, nil
+}
+
+// Initialize carga las llaves persistidas y genera la primera si no existe ninguna vigente
+func (m *KeyManager) Initialize(ctx context.Context) error {
+	if err := m.reload(ctx); err != nil {
+		return err
+	}
+	return m.rotateIfDue(ctx)
+}
+
+// StartRotation revisa periódicamente la rotación y el retiro de llaves hasta que ctx se cancele
+func (m *KeyManager) StartRotation(ctx context.Context) {
+	ticker := time.NewTicker(rotationCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.reload(ctx); err != nil {
+				log.Printf("JWT key reload failed: %v", err)
+				continue
+			}
+			if err := m.rotateIfDue(ctx); err != nil {
+				log.Printf("JWT key rotation failed: %v", err)
+			}
+		}
+	}
+}
+
+// Rotate genera una nueva llave de firma y marca las anteriores como reemplazadas;
+// estas siguen verificando hasta su retiro
+func (m *KeyManager) Rotate(ctx context.Context) (*SigningKey, error) {
+	now := m.now()
+	key, err := GenerateSigningKey(m.algorithm, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.store.SaveKey(ctx, key); err != nil {
+		return nil, err
+	}
+
+	previous := m.ActiveKeys()
+
+	m.mu.Lock()
+	m.keys[key.KID] = key
+	m.mu.Unlock()
+
+	for _, old := range previous {
+		if old.IsSuperseded() {
+			continue
+		}
+		if err := m.supersede(ctx, old, now); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Printf("JWT signing key rotated, new kid=%s", key.KID)
+	return key, nil
+}
+
+// Current retorna la llave vigente más reciente (la que firma)
+func (m *KeyManager) Current() (*SigningKey, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var current *SigningKey
+	for _, key := range m.keys {
+		if key.IsRetired() {
+			continue
+		}
+		if current == nil || key.ActivatedAt.After(current.ActivatedAt) {
+			current = key
+		}
+	}
+
+	if current == nil {
+		return nil, errors.New("no active signing key")
+	}
+	return current, nil
+}
+
+// Lookup busca una llave no retirada por kid. Si no está en memoria recarga desde el store,
+// por si otra instancia rotó la llave, pero a lo sumo una vez cada minLookupReloadInterval:
+// un kid inventado no debe traducirse en una consulta a la base de datos por request.
+func (m *KeyManager) Lookup(ctx context.Context, kid string) (*SigningKey, error) {
+	if key := m.find(kid); key != nil {
+		return key, nil
+	}
+
+	m.reloadMu.Lock()
+	defer m.reloadMu.Unlock()
+
+	// Otra búsqueda pudo haber recargado mientras se esperaba el lock
+	if key := m.find(kid); key != nil {
+		return key, nil
+	}
+
+	if m.now().Sub(m.lastReloadAt()) >= minLookupReloadInterval {
+		if err := m.reload(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if key := m.find(kid); key != nil {
+		return key, nil
+	}
+	return nil, errors.New("unknown or retired signing key")
+}
+
+// ActiveKeys retorna las llaves no retiradas, de la más reciente a la más antigua
+func (m *KeyManager) ActiveKeys() []*SigningKey {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	keys := make([]*SigningKey, 0, len(m.keys))
+	for _, key := range m.keys {
+		if !key.IsRetired() {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].ActivatedAt.After(keys[j].ActivatedAt)
+	})
+	return keys
+}
+
+func (m *KeyManager) find(kid string) *SigningKey {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	key, ok := m.keys[kid]
+	if !ok || key.IsRetired() {
+		return nil
+	}
+	return key
+}
+
+func (m *KeyManager) lastReloadAt() time.Time {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.lastReload
+}
+
+func (m *KeyManager) reload(ctx context.Context) error {
+	keys, err := m.store.LoadKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	loaded := make(map[string]*SigningKey, len(keys))
+	for _, key := range keys {
+		loaded[key.KID] = key
+	}
+
+	m.mu.Lock()
+	m.keys = loaded
+	m.lastReload = m.now()
+	m.mu.Unlock()
+	return nil
+}
+
+// rotateIfDue genera una llave nueva si la vigente superó el intervalo de rotación
+// y retira las llaves reemplazadas cuyos tokens ya expiraron
+func (m *KeyManager) rotateIfDue(ctx context.Context) error {
+	now := m.now()
+
+	current, err := m.Current()
+	if err != nil || now.Sub(current.ActivatedAt) >= m.rotationInterval {
+		if current, err = m.Rotate(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Una llave reemplazada firmó tokens hasta superseded_at; se retira cuando
+	// incluso el último de esos tokens ya expiró.
+	for _, key := range m.ActiveKeys() {
+		if key.KID == current.KID {
+			continue
+		}
+		// Llaves de antes de registrar el reemplazo: el plazo corre desde ahora
+		if !key.IsSuperseded() {
+			if err := m.supersede(ctx, key, now); err != nil {
+				return err
+			}
+			continue
+		}
+		if now.Sub(*key.SupersededAt) < m.tokenTTL {
+			continue
+		}
+		if err := m.store.RetireKey(ctx, key.KID, now); err != nil {
+			return err
+		}
+
+		m.mu.Lock()
+		retiredAt := now
+		key.RetiredAt = &retiredAt
+		m.mu.Unlock()
+
+		log.Printf("JWT signing key retired, kid=%s", key.KID)
+	}
+
+	return nil
+}
+
+func (m *KeyManager) supersede(ctx context.Context, key *SigningKey, at time.Time) error {
+	if err := m.store.SupersedeKey(ctx, key.KID, at); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	supersededAt := at
+	key.SupersededAt = &supersededAt
+	m.mu.Unlock()
+	return nil
+}
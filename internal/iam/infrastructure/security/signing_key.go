@@ -0,0 +1,136 @@
+package security
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Algoritmos de firma asimétrica soportados
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+const rsaKeyBits = 2048
+
+// SigningKey es un par de llaves de firma identificado por kid
+type SigningKey struct {
+	KID        string
+	Algorithm  string
+	PrivateKey crypto.Signer
+	CreatedAt  time.Time
+	RetiredAt  *time.Time
+
+	// ActivatedAt es cuando la llave empezó a firmar; SupersededAt, cuando dejó de hacerlo
+	ActivatedAt  time.Time
+	SupersededAt *time.Time
+}
+
+// GenerateSigningKey genera un nuevo par de llaves para el algoritmo indicado
+func GenerateSigningKey(algorithm string, now time.Time) (*SigningKey, error) {
+	var signer crypto.Signer
+	switch algorithm {
+	case AlgorithmRS256:
+		key, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return nil, err
+		}
+		signer = key
+	case AlgorithmEdDSA:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		signer = key
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm %q, must be RS256 or EdDSA", algorithm)
+	}
+
+	kidBytes := make([]byte, 8)
+	if _, err := rand.Read(kidBytes); err != nil {
+		return nil, err
+	}
+
+	return &SigningKey{
+		KID:        now.UTC().Format("20060102") + "-" + hex.EncodeToString(kidBytes),
+		Algorithm:  algorithm,
+		PrivateKey: signer,
+		CreatedAt:  now,
+
+		ActivatedAt: now,
+	}, nil
+}
+
+// PublicKey retorna la llave pública usada para verificar firmas
+func (k *SigningKey) PublicKey() crypto.PublicKey {
+	return k.PrivateKey.Public()
+}
+
+// IsSuperseded indica si una llave más nueva ya la reemplazó para firmar
+func (k *SigningKey) IsSuperseded() bool {
+	return k.SupersededAt != nil
+}
+
+// IsRetired indica si la llave ya no se acepta para verificar tokens
+func (k *SigningKey) IsRetired() bool {
+	return k.RetiredAt != nil
+}
+
+// SigningMethod retorna el método de firma JWT correspondiente al algoritmo
+func (k *SigningKey) SigningMethod() (jwt.SigningMethod, error) {
+	switch k.Algorithm {
+	case AlgorithmRS256:
+		return jwt.SigningMethodRS256, nil
+	case AlgorithmEdDSA:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm %q", k.Algorithm)
+	}
+}
+
+// MarshalPrivateKeyPEM serializa la llave privada en PKCS#8 PEM
+func (k *SigningKey) MarshalPrivateKeyPEM() (string, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(k.PrivateKey)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), nil
+}
+
+// MarshalPublicKeyPEM serializa la llave pública en PKIX PEM
+func (k *SigningKey) MarshalPublicKeyPEM() (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(k.PublicKey())
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})), nil
+}
+
+// ParsePrivateKeyPEM reconstruye una llave privada PKCS#8 PEM
+func ParsePrivateKeyPEM(value string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(value))
+	if block == nil {
+		return nil, errors.New("invalid private key PEM")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("private key cannot sign")
+	}
+	return signer, nil
+}
@@ -0,0 +1,94 @@
+package security
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"time"
+
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+)
+
+const (
+	totpDigits = 6
+	totpPeriod = 30 // segundos
+	totpSkew   = 1  // pasos de tolerancia hacia atrás y adelante (desfase de reloj)
+)
+
+// TOTPService implementa códigos de un solo uso basados en tiempo (RFC 6238, HMAC-SHA1)
+type TOTPService struct {
+	issuer string
+	now    func() time.Time
+}
+
+// NewTOTPService crea una nueva instancia del servicio TOTP
+func NewTOTPService(issuer string) *TOTPService {
+	return &TOTPService{
+		issuer: issuer,
+		now:    time.Now,
+	}
+}
+
+// ProvisioningURI genera el URI otpauth:// que las apps autenticadoras leen como código QR
+func (s *TOTPService) ProvisioningURI(secret valueobjects.TOTPSecret, accountName string) string {
+	label := url.PathEscape(fmt.Sprintf("%s:%s", s.issuer, accountName))
+
+	params := url.Values{}
+	params.Set("secret", secret.Value())
+	params.Set("issuer", s.issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprintf("%d", totpDigits))
+	params.Set("period", fmt.Sprintf("%d", totpPeriod))
+
+	return fmt.Sprintf("otpauth://totp/%s?%s", label, params.Encode())
+}
+
+// Validate verifica el código y retorna el paso de tiempo aceptado.
+// Los pasos menores o iguales a lastUsedStep se rechazan para impedir la reutilización de un código.
+func (s *TOTPService) Validate(secret valueobjects.TOTPSecret, code string, lastUsedStep int64) (int64, bool) {
+	if len(code) != totpDigits {
+		return 0, false
+	}
+
+	key, err := secret.Bytes()
+	if err != nil || len(key) == 0 {
+		return 0, false
+	}
+
+	current := s.now().Unix() / totpPeriod
+	for offset := int64(-totpSkew); offset <= totpSkew; offset++ {
+		step := current + offset
+		if step <= lastUsedStep {
+			continue
+		}
+		expected := generateCode(key, step)
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// generateCode calcula HOTP(K, C) truncado dinámicamente (RFC 4226, sección 5.3)
+func generateCode(key []byte, counter int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	binCode := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", totpDigits, binCode%mod)
+}
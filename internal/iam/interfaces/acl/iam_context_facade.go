@@ -8,6 +8,9 @@ type IAMContextFacade interface {
 	// ValidateToken valida un token JWT y retorna el UserID como string si es válido
 	ValidateToken(ctx context.Context, token string) (string, error)
 
+	// ValidateAPIKey valida una API key y retorna el UserID de su dueño y los scopes otorgados
+	ValidateAPIKey(ctx context.Context, apiKey string) (string, []string, error)
+
 	// GetUserEmailByID obtiene el email de un usuario por su ID (UUID string)
 	GetUserEmailByID(ctx context.Context, userID string) (string, error)
 }
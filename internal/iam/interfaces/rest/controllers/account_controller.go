@@ -0,0 +1,185 @@
+package controllers
+
+import (
+	"net/http"
+
+	"finanzas-backend/internal/iam/domain/model/commands"
+	"finanzas-backend/internal/iam/domain/model/entities"
+	"finanzas-backend/internal/iam/domain/model/queries"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+	"finanzas-backend/internal/iam/domain/services"
+	"finanzas-backend/internal/iam/interfaces/rest/resources"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AccountController struct {
+	accountCommandService services.AccountCommandService
+	accountQueryService   services.AccountQueryService
+}
+
+func NewAccountController(
+	accountCommandService services.AccountCommandService,
+	accountQueryService services.AccountQueryService,
+) *AccountController {
+	return &AccountController{
+		accountCommandService: accountCommandService,
+		accountQueryService:   accountQueryService,
+	}
+}
+
+// ExportData godoc
+// @Summary Export personal data
+// @Description Download a JSON bundle with the authenticated user's account, decrypted profile and all mortgage simulations (Ley 29733 right of access)
+// @Tags IAM
+// @Produce json
+// @Success 200 {object} resources.AccountExportResource
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/iam/account/export [get]
+func (c *AccountController) ExportData(ctx *gin.Context) {
+	userID, ok := c.authenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	query, err := queries.NewExportAccountDataQuery(userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	export, err := c.accountQueryService.HandleExportData(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Header("Content-Disposition", `attachment; filename="finanzas-datos-personales.json"`)
+	ctx.Header("Cache-Control", "no-store")
+	ctx.JSON(http.StatusOK, c.transformExportToResource(export))
+}
+
+// RequestDeletion godoc
+// @Summary Request account deletion
+// @Description Schedule permanent deletion of the account, profile and all simulations after a grace period. Requires the current password.
+// @Tags IAM
+// @Accept json
+// @Produce json
+// @Param request body resources.RequestAccountDeletionResource true "Password confirmation"
+// @Success 202 {object} resources.AccountDeletionResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/iam/account/deletion [post]
+func (c *AccountController) RequestDeletion(ctx *gin.Context) {
+	userID, ok := c.authenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req resources.RequestAccountDeletionResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd, err := commands.NewRequestAccountDeletionCommand(userID, req.Password)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	scheduledFor, err := c.accountCommandService.HandleRequestDeletion(ctx.Request.Context(), cmd)
+	if err != nil {
+		if err.Error() == "invalid password" {
+			ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, resources.AccountDeletionResource{DeletionScheduledFor: scheduledFor})
+}
+
+// CancelDeletion godoc
+// @Summary Cancel account deletion
+// @Description Cancel a scheduled account deletion during the grace period
+// @Tags IAM
+// @Produce json
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/iam/account/deletion [delete]
+func (c *AccountController) CancelDeletion(ctx *gin.Context) {
+	userID, ok := c.authenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	cmd, err := commands.NewCancelAccountDeletionCommand(userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.accountCommandService.HandleCancelDeletion(ctx.Request.Context(), cmd); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *AccountController) authenticatedUserID(ctx *gin.Context) (valueobjects.UserID, bool) {
+	// Get user_id from context (set by JWT middleware)
+	userIDValue, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return valueobjects.UserID{}, false
+	}
+
+	userID, err := valueobjects.NewUserIDFromString(userIDValue.(string))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return valueobjects.UserID{}, false
+	}
+
+	return userID, true
+}
+
+func (c *AccountController) transformExportToResource(export *entities.AccountDataExport) resources.AccountExportResource {
+	user := export.User()
+
+	apiKeys := make([]resources.APIKeyResource, 0, len(export.APIKeys()))
+	for _, apiKey := range export.APIKeys() {
+		apiKeys = append(apiKeys, resources.APIKeyResource{
+			ID:         apiKey.ID().String(),
+			Name:       apiKey.Name(),
+			Prefix:     apiKey.Prefix(),
+			Scopes:     apiKey.ScopeValues(),
+			ExpiresAt:  apiKey.ExpiresAt(),
+			LastUsedAt: apiKey.LastUsedAt(),
+			RevokedAt:  apiKey.RevokedAt(),
+			CreatedAt:  apiKey.CreatedAt(),
+		})
+	}
+
+	return resources.AccountExportResource{
+		ExportedAt: export.ExportedAt(),
+		User: resources.AccountExportUserResource{
+			ID:                   user.ID().String(),
+			Email:                user.Email().Value(),
+			MFAEnabled:           user.MFAEnabled(),
+			DeletionScheduledFor: user.DeletionScheduledFor(),
+			CreatedAt:            user.CreatedAt(),
+			UpdatedAt:            user.UpdatedAt(),
+		},
+		APIKeys:   apiKeys,
+		Profile:   export.Profile(),
+		Mortgages: export.Mortgages(),
+	}
+}
@@ -0,0 +1,173 @@
+package controllers
+
+import (
+	"net/http"
+
+	"finanzas-backend/internal/iam/domain/model/commands"
+	"finanzas-backend/internal/iam/domain/model/entities"
+	"finanzas-backend/internal/iam/domain/model/queries"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+	"finanzas-backend/internal/iam/domain/services"
+	"finanzas-backend/internal/iam/interfaces/rest/resources"
+
+	"github.com/gin-gonic/gin"
+)
+
+type APIKeyController struct {
+	apiKeyCommandService services.APIKeyCommandService
+	apiKeyQueryService   services.APIKeyQueryService
+}
+
+func NewAPIKeyController(
+	apiKeyCommandService services.APIKeyCommandService,
+	apiKeyQueryService services.APIKeyQueryService,
+) *APIKeyController {
+	return &APIKeyController{
+		apiKeyCommandService: apiKeyCommandService,
+		apiKeyQueryService:   apiKeyQueryService,
+	}
+}
+
+// CreateAPIKey godoc
+// @Summary Create an API key
+// @Description Create a personal API key for machine-to-machine access. Send it as "Authorization: ApiKey <key>". The key is only shown once. Scopes: mortgage:read, mortgage:write, profile:read, profile:write (default mortgage:write).
+// @Tags IAM
+// @Accept json
+// @Produce json
+// @Param request body resources.CreateAPIKeyResource true "API key data"
+// @Success 201 {object} resources.CreatedAPIKeyResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/iam/api-keys [post]
+func (c *APIKeyController) CreateAPIKey(ctx *gin.Context) {
+	userID, ok := c.authenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req resources.CreateAPIKeyResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd, err := commands.NewCreateAPIKeyCommand(userID, req.Name, req.Scopes, req.ExpiresAt)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	apiKey, secret, err := c.apiKeyCommandService.HandleCreate(ctx.Request.Context(), cmd)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, resources.CreatedAPIKeyResource{
+		APIKeyResource: c.transformAPIKeyToResource(apiKey),
+		Key:            secret.Value(),
+	})
+}
+
+// ListAPIKeys godoc
+// @Summary List API keys
+// @Description List the authenticated user's API keys, including revoked and expired ones
+// @Tags IAM
+// @Produce json
+// @Success 200 {array} resources.APIKeyResource
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/iam/api-keys [get]
+func (c *APIKeyController) ListAPIKeys(ctx *gin.Context) {
+	userID, ok := c.authenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	query, err := queries.NewFindAPIKeysByUserIDQuery(userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	apiKeys, err := c.apiKeyQueryService.HandleFindByUserID(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]resources.APIKeyResource, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		response = append(response, c.transformAPIKeyToResource(apiKey))
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// RevokeAPIKey godoc
+// @Summary Revoke an API key
+// @Description Permanently revoke one of the authenticated user's API keys
+// @Tags IAM
+// @Produce json
+// @Param id path string true "API key ID (UUID)"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/iam/api-keys/{id} [delete]
+func (c *APIKeyController) RevokeAPIKey(ctx *gin.Context) {
+	userID, ok := c.authenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	cmd, err := commands.NewRevokeAPIKeyCommand(userID, ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.apiKeyCommandService.HandleRevoke(ctx.Request.Context(), cmd); err != nil {
+		if err.Error() == "API key not found" {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *APIKeyController) authenticatedUserID(ctx *gin.Context) (valueobjects.UserID, bool) {
+	// Get user_id from context (set by JWT middleware)
+	userIDValue, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return valueobjects.UserID{}, false
+	}
+
+	userID, err := valueobjects.NewUserIDFromString(userIDValue.(string))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return valueobjects.UserID{}, false
+	}
+
+	return userID, true
+}
+
+func (c *APIKeyController) transformAPIKeyToResource(apiKey *entities.APIKey) resources.APIKeyResource {
+	return resources.APIKeyResource{
+		ID:         apiKey.ID().String(),
+		Name:       apiKey.Name(),
+		Prefix:     apiKey.Prefix(),
+		Scopes:     apiKey.ScopeValues(),
+		ExpiresAt:  apiKey.ExpiresAt(),
+		LastUsedAt: apiKey.LastUsedAt(),
+		RevokedAt:  apiKey.RevokedAt(),
+		CreatedAt:  apiKey.CreatedAt(),
+	}
+}
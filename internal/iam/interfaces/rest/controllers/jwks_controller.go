@@ -0,0 +1,79 @@
+package controllers
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+	"finanzas-backend/internal/iam/domain/services"
+	"finanzas-backend/internal/iam/interfaces/rest/resources"
+
+	"github.com/gin-gonic/gin"
+)
+
+type JWKSController struct {
+	signingKeyQueryService services.SigningKeyQueryService
+}
+
+func NewJWKSController(signingKeyQueryService services.SigningKeyQueryService) *JWKSController {
+	return &JWKSController{
+		signingKeyQueryService: signingKeyQueryService,
+	}
+}
+
+// GetJWKS godoc
+// @Summary Get JSON Web Key Set
+// @Description Public keys that can verify access tokens issued by this service. Keys are selected by the token "kid" header.
+// @Tags IAM
+// @Produce json
+// @Success 200 {object} resources.JWKSResource
+// @Failure 500 {object} map[string]string
+// @Router /.well-known/jwks.json [get]
+func (c *JWKSController) GetJWKS(ctx *gin.Context) {
+	keys, err := c.signingKeyQueryService.HandleGetPublicKeys(ctx.Request.Context())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := resources.JWKSResource{Keys: make([]resources.JWKResource, 0, len(keys))}
+	for _, key := range keys {
+		if jwk, ok := c.transformKeyToResource(key); ok {
+			response.Keys = append(response.Keys, jwk)
+		}
+	}
+
+	// Los consumidores pueden cachear el JWKS; tras una rotación vuelven a consultarlo al ver un kid desconocido
+	ctx.Header("Cache-Control", "public, max-age=300")
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *JWKSController) transformKeyToResource(key valueobjects.PublicSigningKey) (resources.JWKResource, bool) {
+	encode := base64.RawURLEncoding.EncodeToString
+
+	switch publicKey := key.PublicKey().(type) {
+	case *rsa.PublicKey:
+		return resources.JWKResource{
+			Kty: "RSA",
+			Kid: key.KID(),
+			Use: "sig",
+			Alg: key.Algorithm(),
+			N:   encode(publicKey.N.Bytes()),
+			E:   encode(big.NewInt(int64(publicKey.E)).Bytes()),
+		}, true
+	case ed25519.PublicKey:
+		return resources.JWKResource{
+			Kty: "OKP",
+			Kid: key.KID(),
+			Use: "sig",
+			Alg: key.Algorithm(),
+			Crv: "Ed25519",
+			X:   encode(publicKey),
+		}, true
+	default:
+		return resources.JWKResource{}, false
+	}
+}
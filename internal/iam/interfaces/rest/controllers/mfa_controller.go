@@ -0,0 +1,219 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"finanzas-backend/internal/iam/domain/model/commands"
+	"finanzas-backend/internal/iam/domain/model/entities"
+	"finanzas-backend/internal/iam/domain/model/queries"
+	"finanzas-backend/internal/iam/domain/model/valueobjects"
+	"finanzas-backend/internal/iam/domain/services"
+	"finanzas-backend/internal/iam/interfaces/rest/resources"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MFAController struct {
+	mfaCommandService services.MFACommandService
+	authService       services.AuthenticationService
+	userQueryService  services.UserQueryService
+}
+
+func NewMFAController(
+	mfaCommandService services.MFACommandService,
+	authService services.AuthenticationService,
+	userQueryService services.UserQueryService,
+) *MFAController {
+	return &MFAController{
+		mfaCommandService: mfaCommandService,
+		authService:       authService,
+		userQueryService:  userQueryService,
+	}
+}
+
+// VerifyLogin godoc
+// @Summary Complete login with a second factor
+// @Description Exchange the MFA challenge token returned by login and a TOTP or recovery code for a JWT
+// @Tags IAM
+// @Accept json
+// @Produce json
+// @Param request body resources.VerifyMFALoginResource true "MFA challenge token and code"
+// @Success 200 {object} resources.LoginResponseResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 429 {object} map[string]string
+// @Router /api/v1/iam/login/mfa [post]
+func (c *MFAController) VerifyLogin(ctx *gin.Context) {
+	var req resources.VerifyMFALoginResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd, err := commands.NewVerifyMFALoginCommand(req.MFAToken, req.Code)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	token, userID, err := c.authService.HandleVerifyMFALogin(ctx.Request.Context(), cmd)
+	if err != nil {
+		ctx.JSON(mfaErrorStatus(err, http.StatusUnauthorized), gin.H{"error": err.Error()})
+		return
+	}
+
+	// Get user details
+	query, _ := queries.NewFindUserByIDQuery(userID.String())
+	user, err := c.userQueryService.HandleFindByID(ctx.Request.Context(), query)
+	if err != nil || user == nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve user"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resources.LoginResponseResource{
+		Token: token,
+		User: resources.UserResource{
+			ID:        user.ID().String(),
+			Email:     user.Email().Value(),
+			CreatedAt: user.CreatedAt(),
+		},
+	})
+}
+
+// Enroll godoc
+// @Summary Start two-factor enrollment
+// @Description Generate a TOTP secret and otpauth URI for the authenticated user. 2FA stays disabled until confirmed.
+// @Tags IAM
+// @Produce json
+// @Success 200 {object} resources.MFAEnrollmentResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/iam/mfa/enroll [post]
+func (c *MFAController) Enroll(ctx *gin.Context) {
+	userID, ok := c.authenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	cmd, err := commands.NewEnrollMFACommand(userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	enrollment, err := c.mfaCommandService.HandleEnroll(ctx.Request.Context(), cmd)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resources.MFAEnrollmentResource{
+		Secret:          enrollment.Secret().Value(),
+		ProvisioningURI: enrollment.ProvisioningURI(),
+	})
+}
+
+// Confirm godoc
+// @Summary Confirm two-factor enrollment
+// @Description Verify a code from the authenticator app, enable 2FA and return single-use recovery codes
+// @Tags IAM
+// @Accept json
+// @Produce json
+// @Param request body resources.MFACodeResource true "TOTP code"
+// @Success 200 {object} resources.MFARecoveryCodesResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/iam/mfa/confirm [post]
+func (c *MFAController) Confirm(ctx *gin.Context) {
+	userID, ok := c.authenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req resources.MFACodeResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd, err := commands.NewConfirmMFACommand(userID, req.Code)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	recoveryCodes, err := c.mfaCommandService.HandleConfirm(ctx.Request.Context(), cmd)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resources.MFARecoveryCodesResource{RecoveryCodes: recoveryCodes})
+}
+
+// Disable godoc
+// @Summary Disable two-factor authentication
+// @Description Disable 2FA for the authenticated user. Requires a current TOTP or recovery code.
+// @Tags IAM
+// @Accept json
+// @Produce json
+// @Param request body resources.MFACodeResource true "TOTP or recovery code"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 429 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/iam/mfa/disable [post]
+func (c *MFAController) Disable(ctx *gin.Context) {
+	userID, ok := c.authenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req resources.MFACodeResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd, err := commands.NewDisableMFACommand(userID, req.Code)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.mfaCommandService.HandleDisable(ctx.Request.Context(), cmd); err != nil {
+		ctx.JSON(mfaErrorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *MFAController) authenticatedUserID(ctx *gin.Context) (valueobjects.UserID, bool) {
+	// Get user_id from context (set by JWT middleware)
+	userIDValue, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return valueobjects.UserID{}, false
+	}
+
+	userID, err := valueobjects.NewUserIDFromString(userIDValue.(string))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return valueobjects.UserID{}, false
+	}
+
+	return userID, true
+}
+
+// mfaErrorStatus responde 429 mientras el segundo factor esté bloqueado por intentos fallidos
+func mfaErrorStatus(err error, fallback int) int {
+	if errors.Is(err, entities.ErrMFALocked) {
+		return http.StatusTooManyRequests
+	}
+	return fallback
+}
@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
 
 	"finanzas-backend/internal/iam/domain/model/commands"
@@ -9,6 +10,7 @@ import (
 	"finanzas-backend/internal/iam/domain/model/valueobjects"
 	"finanzas-backend/internal/iam/domain/services"
 	"finanzas-backend/internal/iam/interfaces/rest/resources"
+	"finanzas-backend/internal/shared/infrastructure/identity"
 
 	"github.com/gin-gonic/gin"
 )
@@ -41,6 +43,7 @@ func NewUserController(
 // @Success 201 {object} resources.UserResource
 // @Failure 400 {object} map[string]string
 // @Failure 409 {object} map[string]string
+// @Failure 503 {object} map[string]string
 // @Router /api/v1/iam/register [post]
 func (c *UserController) Register(ctx *gin.Context) {
 	var req resources.RegisterUserResource
@@ -56,6 +59,10 @@ func (c *UserController) Register(ctx *gin.Context) {
 	}
 
 	userID, err := c.userCommandService.HandleRegister(ctx.Request.Context(), cmd)
+	if errors.Is(err, identity.ErrProviderUnavailable) {
+		ctx.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+		return
+	}
 	if err != nil {
 		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 		return
@@ -81,8 +88,10 @@ func (c *UserController) Register(ctx *gin.Context) {
 // @Produce json
 // @Param request body resources.LoginResource true "Login credentials"
 // @Success 200 {object} resources.LoginResponseResource
+// @Success 202 {object} resources.MFAChallengeResource "Two-factor authentication required"
 // @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
+// @Failure 429 {object} map[string]string
 // @Router /api/v1/iam/login [post]
 func (c *UserController) Login(ctx *gin.Context) {
 	var req resources.LoginResource
@@ -97,9 +106,18 @@ func (c *UserController) Login(ctx *gin.Context) {
 		return
 	}
 
-	token, err := c.authService.HandleLogin(ctx.Request.Context(), cmd)
+	result, err := c.authService.HandleLogin(ctx.Request.Context(), cmd)
 	if err != nil {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		ctx.JSON(mfaErrorStatus(err, http.StatusUnauthorized), gin.H{"error": err.Error()})
+		return
+	}
+
+	// Second factor pending: the client must call /login/mfa with the challenge token
+	if result.MFARequired() {
+		ctx.JSON(http.StatusAccepted, resources.MFAChallengeResource{
+			MFARequired: true,
+			MFAToken:    result.ChallengeToken(),
+		})
 		return
 	}
 
@@ -112,7 +130,7 @@ func (c *UserController) Login(ctx *gin.Context) {
 	}
 
 	response := resources.LoginResponseResource{
-		Token: token,
+		Token: result.Token(),
 		User:  c.transformUserToResource(user),
 	}
 	ctx.JSON(http.StatusOK, response)
@@ -0,0 +1,32 @@
+package resources
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type RequestAccountDeletionResource struct {
+	Password string `json:"password" example:"mypassword123" binding:"required"`
+}
+
+type AccountDeletionResource struct {
+	DeletionScheduledFor time.Time `json:"deletion_scheduled_for" example:"2025-02-14T10:30:00Z"`
+}
+
+type AccountExportUserResource struct {
+	ID                   string     `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Email                string     `json:"email" example:"user@example.com"`
+	MFAEnabled           bool       `json:"mfa_enabled" example:"false"`
+	DeletionScheduledFor *time.Time `json:"deletion_scheduled_for,omitempty"`
+	CreatedAt            time.Time  `json:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt            time.Time  `json:"updated_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// AccountExportResource es el paquete de datos personales del usuario (Ley 29733)
+type AccountExportResource struct {
+	ExportedAt time.Time                 `json:"exported_at" example:"2025-01-15T10:30:00Z"`
+	User       AccountExportUserResource `json:"user"`
+	APIKeys    []APIKeyResource          `json:"api_keys"`
+	Profile    json.RawMessage           `json:"profile" swaggertype:"object"`
+	Mortgages  json.RawMessage           `json:"mortgages" swaggertype:"array,object"`
+}
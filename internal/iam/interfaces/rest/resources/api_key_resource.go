@@ -0,0 +1,26 @@
+package resources
+
+import "time"
+
+type CreateAPIKeyResource struct {
+	Name      string     `json:"name" example:"Portal inmobiliario" binding:"required"`
+	Scopes    []string   `json:"scopes" example:"mortgage:write,mortgage:read"`
+	ExpiresAt *time.Time `json:"expires_at" example:"2026-12-31T23:59:59Z"`
+}
+
+type APIKeyResource struct {
+	ID         string     `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name       string     `json:"name" example:"Portal inmobiliario"`
+	Prefix     string     `json:"prefix" example:"3f9a1c0b7d2e"`
+	Scopes     []string   `json:"scopes" example:"mortgage:write"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty" example:"2026-12-31T23:59:59Z"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" example:"2025-01-15T10:30:00Z"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at" example:"2025-01-01T00:00:00Z"`
+}
+
+// CreatedAPIKeyResource incluye la key en texto plano; solo se retorna al crearla
+type CreatedAPIKeyResource struct {
+	APIKeyResource
+	Key string `json:"key" example:"fk_3f9a1c0b7d2e_5b1c..."`
+}
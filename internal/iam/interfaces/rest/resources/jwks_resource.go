@@ -0,0 +1,17 @@
+package resources
+
+// JWKResource representa una llave pública en formato JWK (RFC 7517)
+type JWKResource struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKSResource struct {
+	Keys []JWKResource `json:"keys"`
+}
@@ -24,6 +24,30 @@ type LoginResponseResource struct {
 	User  UserResource `json:"user"`
 }
 
+// MFAChallengeResource se retorna en el login cuando el usuario tiene 2FA habilitado
+type MFAChallengeResource struct {
+	MFARequired bool   `json:"mfa_required" example:"true"`
+	MFAToken    string `json:"mfa_token" example:"eyJhbGciOiJIUzI1NiIs..."`
+}
+
+type VerifyMFALoginResource struct {
+	MFAToken string `json:"mfa_token" example:"eyJhbGciOiJIUzI1NiIs..." binding:"required"`
+	Code     string `json:"code" example:"123456" binding:"required"`
+}
+
+type MFAEnrollmentResource struct {
+	Secret          string `json:"secret" example:"JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`
+	ProvisioningURI string `json:"provisioning_uri" example:"otpauth://totp/Finanzas%20MiVivienda:user@example.com?secret=JBSWY3DPEHPK3PXP&issuer=Finanzas+MiVivienda"`
+}
+
+type MFACodeResource struct {
+	Code string `json:"code" example:"123456" binding:"required"`
+}
+
+type MFARecoveryCodesResource struct {
+	RecoveryCodes []string `json:"recovery_codes" example:"k7m2p-q9x4z,a3b5c-d7e9f"`
+}
+
 type UpdateUserResource struct {
 	Password *string `json:"password" example:"newpassword123" validate:"required,min=6"`
 }
@@ -34,6 +34,20 @@ func (s *ExternalAuthenticationService) ValidateTokenAndGetUserID(ctx context.Co
 	return userIDString, nil
 }
 
+// ValidateAPIKeyAndGetUserID valida una API key y retorna el UserID de su dueño y los scopes otorgados
+func (s *ExternalAuthenticationService) ValidateAPIKeyAndGetUserID(ctx context.Context, apiKey string) (string, []string, error) {
+	userIDString, scopes, err := s.iamFacade.ValidateAPIKey(ctx, apiKey)
+	if err != nil {
+		return "", nil, errors.New("invalid, expired or revoked API key")
+	}
+
+	if userIDString == "" {
+		return "", nil, errors.New("invalid user ID from API key")
+	}
+
+	return userIDString, scopes, nil
+}
+
 // GetUserEmail obtiene el email de un usuario por su ID (UUID string)
 func (s *ExternalAuthenticationService) GetUserEmail(ctx context.Context, userID string) (string, error) {
 	return s.iamFacade.GetUserEmailByID(ctx, userID)
@@ -0,0 +1,38 @@
+package acl
+
+import (
+	"context"
+
+	exchangerate_acl "finanzas-backend/internal/exchangerate/interfaces/acl"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+)
+
+// ExternalExchangeRateService - ACL implementation para consultar el tipo de cambio desde Mortgage
+type ExternalExchangeRateService struct {
+	exchangeRateFacade exchangerate_acl.ExchangeRateContextFacade
+}
+
+func NewExternalExchangeRateService(exchangeRateFacade exchangerate_acl.ExchangeRateContextFacade) *ExternalExchangeRateService {
+	return &ExternalExchangeRateService{
+		exchangeRateFacade: exchangeRateFacade,
+	}
+}
+
+// CurrentRate retorna el tipo de cambio vigente, o nil si no hay uno registrado
+func (s *ExternalExchangeRateService) CurrentRate(ctx context.Context) (*valueobjects.ExchangeRate, error) {
+	data, err := s.exchangeRateFacade.GetCurrentRate(ctx)
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	rate, err := valueobjects.NewExchangeRate(data.Buy, data.Sell, data.Date)
+	if err != nil {
+		return nil, err
+	}
+	return &rate, nil
+}
+
+// Convert expresa amount en la moneda to; ok es false si las monedas difieren y no hay tipo de cambio vigente
+func (s *ExternalExchangeRateService) Convert(ctx context.Context, amount float64, from, to string) (float64, bool, error) {
+	return s.exchangeRateFacade.Convert(ctx, amount, from, to)
+}
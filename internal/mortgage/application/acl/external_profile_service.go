@@ -0,0 +1,87 @@
+package acl
+
+import (
+	"context"
+	"errors"
+
+	profile_acl "finanzas-backend/internal/profile/interfaces/acl"
+)
+
+// ExternalProfileService - ACL implementation para consultar Profile desde Mortgage
+// (ingresos del titular y co-prestatarios del crédito mancomunado)
+type ExternalProfileService struct {
+	profileFacade profile_acl.ProfileContextFacade
+	exchangeRates *ExternalExchangeRateService
+}
+
+func NewExternalProfileService(profileFacade profile_acl.ProfileContextFacade, exchangeRates *ExternalExchangeRateService) *ExternalProfileService {
+	return &ExternalProfileService{
+		profileFacade: profileFacade,
+		exchangeRates: exchangeRates,
+	}
+}
+
+// ValidateCoBorrower verifica que el co-prestatario exista en el perfil del usuario
+func (s *ExternalProfileService) ValidateCoBorrower(ctx context.Context, userID, coBorrowerID string) error {
+	coBorrower, err := s.profileFacade.FindCoBorrower(ctx, userID, coBorrowerID)
+	if err != nil {
+		return err
+	}
+	if coBorrower == nil {
+		return errors.New("co-borrower not found")
+	}
+	return nil
+}
+
+// ExistingDebtPayments obtiene las cuotas mensuales de deudas vigentes del titular en la moneda del crédito.
+// Si su situación financiera está en otra moneda y no hay tipo de cambio vigente, falla: omitir las
+// deudas inflaría la capacidad de pago.
+func (s *ExternalProfileService) ExistingDebtPayments(ctx context.Context, userID, currency string) (float64, error) {
+	snapshot, err := s.profileFacade.GetFinancialSnapshot(ctx, userID)
+	if err != nil || snapshot == nil {
+		return 0, err
+	}
+
+	debts, ok, err := s.exchangeRates.Convert(ctx, snapshot.TotalDebtPayments, snapshot.Currency, currency)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, errors.New("no current exchange rate to convert existing debts")
+	}
+	return debts, nil
+}
+
+// HouseholdIncome suma el ingreso mensual del titular y el del co-prestatario (si coBorrowerID no está vacío)
+// en la moneda del crédito. Los ingresos en otra moneda sin tipo de cambio vigente no se cuentan.
+func (s *ExternalProfileService) HouseholdIncome(ctx context.Context, userID, coBorrowerID, currency string) (float64, error) {
+	total := 0.0
+
+	amount, incomeCurrency, ok, err := s.profileFacade.GetMonthlyIncome(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if ok {
+		converted, _, err := s.exchangeRates.Convert(ctx, amount, incomeCurrency, currency)
+		if err != nil {
+			return 0, err
+		}
+		total += converted
+	}
+
+	if coBorrowerID != "" {
+		coBorrower, err := s.profileFacade.FindCoBorrower(ctx, userID, coBorrowerID)
+		if err != nil {
+			return 0, err
+		}
+		if coBorrower != nil {
+			converted, _, err := s.exchangeRates.Convert(ctx, coBorrower.MonthlyIncome, coBorrower.Currency, currency)
+			if err != nil {
+				return 0, err
+			}
+			total += converted
+		}
+	}
+
+	return total, nil
+}
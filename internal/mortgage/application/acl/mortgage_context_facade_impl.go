@@ -0,0 +1,52 @@
+package acl
+
+import (
+	"context"
+	"encoding/json"
+
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+	"finanzas-backend/internal/mortgage/domain/repositories"
+	"finanzas-backend/internal/mortgage/interfaces/acl"
+	"finanzas-backend/internal/mortgage/interfaces/rest/resources"
+)
+
+type mortgageContextFacadeImpl struct {
+	mortgageRepo repositories.MortgageRepository
+}
+
+// NewMortgageContextFacade crea una nueva instancia del facade ACL de Mortgage
+func NewMortgageContextFacade(mortgageRepo repositories.MortgageRepository) acl.MortgageContextFacade {
+	return &mortgageContextFacadeImpl{
+		mortgageRepo: mortgageRepo,
+	}
+}
+
+// ExportUserData retorna todas las simulaciones del usuario (con cronograma) como un arreglo JSON
+func (f *mortgageContextFacadeImpl) ExportUserData(ctx context.Context, userID string) (json.RawMessage, error) {
+	userIDVO, err := valueobjects.NewUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	mortgages, err := f.mortgageRepo.FindAllByUserID(ctx, userIDVO)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]resources.MortgageResponse, 0, len(mortgages))
+	for _, mortgage := range mortgages {
+		response = append(response, resources.TransformToMortgageResponse(mortgage))
+	}
+
+	return json.Marshal(response)
+}
+
+// DeleteUserData elimina definitivamente todas las simulaciones del usuario
+func (f *mortgageContextFacadeImpl) DeleteUserData(ctx context.Context, userID string) error {
+	userIDVO, err := valueobjects.NewUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	return f.mortgageRepo.DeleteByUserID(ctx, userIDVO)
+}
@@ -0,0 +1,87 @@
+package commandservices
+
+import (
+	"context"
+	"finanzas-backend/internal/mortgage/domain/model/commands"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/repositories"
+	"finanzas-backend/internal/mortgage/domain/services"
+)
+
+type LenderProductCommandServiceImpl struct {
+	repository repositories.LenderProductRepository
+}
+
+func NewLenderProductCommandService(repository repositories.LenderProductRepository) services.LenderProductCommandService {
+	return &LenderProductCommandServiceImpl{
+		repository: repository,
+	}
+}
+
+func (s *LenderProductCommandServiceImpl) HandleSaveProduct(
+	ctx context.Context,
+	cmd *commands.SaveLenderProductCommand,
+) (*entities.LenderProduct, error) {
+	if cmd.ProductID == nil {
+		product, err := entities.NewLenderProduct(
+			cmd.Bank,
+			cmd.Name,
+			cmd.Currency,
+			cmd.RateTiers,
+			cmd.LifeInsuranceRate,
+			cmd.PropertyInsurance,
+			cmd.EvaluationFee,
+			cmd.DisbursementFee,
+			cmd.Portes,
+			cmd.AdministrationFee,
+		)
+		if err != nil {
+			return nil, err
+		}
+		product.SetActive(cmd.Active)
+
+		if err := s.repository.Save(ctx, product); err != nil {
+			return nil, err
+		}
+		return product, nil
+	}
+
+	product, err := s.repository.FindByID(ctx, *cmd.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := product.Update(
+		cmd.Bank,
+		cmd.Name,
+		cmd.Currency,
+		cmd.RateTiers,
+		cmd.LifeInsuranceRate,
+		cmd.PropertyInsurance,
+		cmd.EvaluationFee,
+		cmd.DisbursementFee,
+		cmd.Portes,
+		cmd.AdministrationFee,
+	); err != nil {
+		return nil, err
+	}
+	product.SetActive(cmd.Active)
+
+	if err := s.repository.Update(ctx, product); err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+func (s *LenderProductCommandServiceImpl) HandleDeactivateProduct(
+	ctx context.Context,
+	cmd *commands.DeactivateLenderProductCommand,
+) error {
+	product, err := s.repository.FindByID(ctx, cmd.ProductID)
+	if err != nil {
+		return err
+	}
+
+	product.SetActive(false)
+	return s.repository.Update(ctx, product)
+}
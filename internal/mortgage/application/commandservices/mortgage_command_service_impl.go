@@ -3,25 +3,42 @@ package commandservices
 import (
 	"context"
 	"errors"
+	"finanzas-backend/internal/mortgage/application/acl"
 	"finanzas-backend/internal/mortgage/domain/model/commands"
 	"finanzas-backend/internal/mortgage/domain/model/entities"
 	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
 	"finanzas-backend/internal/mortgage/domain/repositories"
 	"finanzas-backend/internal/mortgage/domain/services"
 	"math"
+	"time"
 )
 
 type MortgageCommandServiceImpl struct {
-	repository repositories.MortgageRepository
-	calculator *services.FrenchMethodCalculator
+	repository             repositories.MortgageRepository
+	productRepository      repositories.LenderProductRepository
+	indexRepository        repositories.PriceIndexRepository
+	paymentRepository      repositories.MortgagePaymentRepository
+	calculator             *services.FrenchMethodCalculator
+	reprogrammer           *services.Reprogrammer
+	externalProfileService *acl.ExternalProfileService
 }
 
 func NewMortgageCommandService(
 	repository repositories.MortgageRepository,
+	productRepository repositories.LenderProductRepository,
+	indexRepository repositories.PriceIndexRepository,
+	paymentRepository repositories.MortgagePaymentRepository,
+	externalProfileService *acl.ExternalProfileService,
 ) services.MortgageCommandService {
+	calculator := services.NewFrenchMethodCalculator()
 	return &MortgageCommandServiceImpl{
-		repository: repository,
-		calculator: services.NewFrenchMethodCalculator(),
+		repository:             repository,
+		productRepository:      productRepository,
+		indexRepository:        indexRepository,
+		paymentRepository:      paymentRepository,
+		calculator:             calculator,
+		reprogrammer:           services.NewReprogrammer(calculator),
+		externalProfileService: externalProfileService,
 	}
 }
 
@@ -29,6 +46,13 @@ func (s *MortgageCommandServiceImpl) HandleCalculateMortgage(
 	ctx context.Context,
 	cmd *commands.CalculateMortgageCommand,
 ) (*entities.Mortgage, error) {
+	// Producto del catálogo: prellena tasa, seguros y comisiones no indicados
+	if cmd.ProductID != 0 {
+		if err := s.applyLenderProduct(ctx, cmd); err != nil {
+			return nil, err
+		}
+	}
+
 	// Crear value objects
 	userID, err := valueobjects.NewUserID(cmd.UserID)
 	if err != nil {
@@ -80,6 +104,21 @@ func (s *MortgageCommandServiceImpl) HandleCalculateMortgage(
 	// Set payment configuration from command
 	mortgage.SetPaymentFrequencyDays(cmd.PaymentFrequencyDays)
 	mortgage.SetDaysInYear(cmd.DaysInYear)
+	mortgage.SetProductID(cmd.ProductID)
+	mortgage.SetGraduatedPayment(cmd.StepUpRate, cmd.StepUpEveryPeriods)
+	mortgage.SetBalloonRate(cmd.BalloonRate)
+	mortgage.SetGraceWindows(cmd.GraceWindows)
+	mortgage.SetPrepaymentPenaltyRate(cmd.PrepaymentPenaltyRate)
+
+	// Soles VAC: el VAC vigente es la base de la proyección en soles
+	if err := s.applyIndexation(ctx, mortgage, cmd.InflationRate, 0, time.Time{}); err != nil {
+		return nil, err
+	}
+
+	// Crédito mancomunado: el co-prestatario también se asegura y su ingreso suma al familiar
+	if err := s.applyHouseholdFinances(ctx, mortgage, cmd.CoBorrowerID); err != nil {
+		return nil, err
+	}
 
 	// Calcular cronograma usando método francés
 	if err := s.calculator.Calculate(mortgage); err != nil {
@@ -130,6 +169,11 @@ func (s *MortgageCommandServiceImpl) HandleUpdateMortgage(
 		return nil, err
 	}
 
+	// Verificar que pertenece al usuario
+	if mortgage.UserID().String() != cmd.UserID().String() {
+		return nil, ErrUnauthorizedAccess
+	}
+
 	// Valores base actuales
 	propertyPrice := mortgage.PropertyPrice()
 	downPayment := mortgage.DownPayment()
@@ -152,6 +196,15 @@ func (s *MortgageCommandServiceImpl) HandleUpdateMortgage(
 	evaluationFee := mortgage.EvaluationFee()
 	disbursementFee := mortgage.DisbursementFee()
 
+	coBorrowerID := mortgage.CoBorrowerID()
+	productID := mortgage.ProductID()
+	inflationRate := mortgage.InflationRate()
+	stepUpRate := mortgage.StepUpRate()
+	stepUpEvery := mortgage.StepUpEveryPeriods()
+	balloonRate := mortgage.BalloonRate()
+	graceWindows := mortgage.GraceWindows()
+	prepaymentPenalty := mortgage.PrepaymentPenaltyRate()
+
 	discountRate := valueOrDefault(cmd.NPVDiscountRate(), 0)
 	needsRecalculation := false
 
@@ -252,6 +305,47 @@ func (s *MortgageCommandServiceImpl) HandleUpdateMortgage(
 		disbursementFee = *cmd.DisbursementFee()
 		needsRecalculation = true
 	}
+	if cmd.CoBorrowerID() != nil {
+		coBorrowerID = *cmd.CoBorrowerID()
+		needsRecalculation = true
+	}
+	if cmd.InflationRate() != nil {
+		inflationRate = *cmd.InflationRate()
+		needsRecalculation = true
+	}
+	if cmd.StepUpRate() != nil {
+		stepUpRate = *cmd.StepUpRate()
+		needsRecalculation = true
+	}
+	if cmd.StepUpEveryPeriods() != nil {
+		stepUpEvery = *cmd.StepUpEveryPeriods()
+		needsRecalculation = true
+	}
+	if cmd.BalloonRate() != nil {
+		balloonRate = *cmd.BalloonRate()
+		needsRecalculation = true
+	}
+	if cmd.GraceWindows() != nil {
+		graceWindows = *cmd.GraceWindows()
+		needsRecalculation = true
+	}
+	if cmd.PrepaymentPenaltyRate() != nil {
+		prepaymentPenalty = *cmd.PrepaymentPenaltyRate()
+	}
+	if stepUpRate > 0 && stepUpEvery <= 0 {
+		return nil, errors.New("step-up periods must be greater than zero")
+	}
+	// Recalcular desde cero perdería las cuotas históricas que conserva la reprogramación
+	if needsRecalculation && mortgage.IsReprogrammed() {
+		return nil, errors.New("reprogrammed mortgages cannot be recalculated")
+	}
+	// Los pagos registrados se aplicaron al cronograma vigente; reemplazarlo reescribiría
+	// el estado de cuenta, la mora y las cotizaciones de cancelación
+	if needsRecalculation {
+		if err := s.ensureNotDisbursed(ctx, mortgage); err != nil {
+			return nil, err
+		}
+	}
 
 	// Recalcular si corresponde
 	if needsRecalculation {
@@ -287,6 +381,22 @@ func (s *MortgageCommandServiceImpl) HandleUpdateMortgage(
 
 		calculated.SetPaymentFrequencyDays(paymentFrequencyDays)
 		calculated.SetDaysInYear(daysInYear)
+		calculated.SetGraduatedPayment(stepUpRate, stepUpEvery)
+		calculated.SetBalloonRate(balloonRate)
+		calculated.SetGraceWindows(graceWindows)
+
+		// Un crédito que ya estaba en VAC conserva el VAC base de su simulación original
+		indexBase, indexDate := 0.0, time.Time{}
+		if mortgage.Currency() == currency {
+			indexBase, indexDate = mortgage.IndexBase(), mortgage.IndexDate()
+		}
+		if err := s.applyIndexation(ctx, calculated, inflationRate, indexBase, indexDate); err != nil {
+			return nil, err
+		}
+
+		if err := s.applyHouseholdFinances(ctx, calculated, coBorrowerID); err != nil {
+			return nil, err
+		}
 
 		if err := s.calculator.Calculate(calculated); err != nil {
 			return nil, err
@@ -319,6 +429,7 @@ func (s *MortgageCommandServiceImpl) HandleUpdateMortgage(
 		calculated.SetTCEA(tcea)
 
 		// Reconstruir conservando metadata original
+		previous := mortgage
 		mortgage = entities.ReconstructMortgage(
 			mortgage.ID(),
 			mortgage.UserID(),
@@ -358,7 +469,18 @@ func (s *MortgageCommandServiceImpl) HandleUpdateMortgage(
 			mortgage.CreatedAt(),
 		)
 		mortgage.SetPaymentSchedule(calculated.PaymentSchedule())
-	}
+		mortgage.SetCoBorrower(calculated.CoBorrowerID(), calculated.InsuredParties())
+		mortgage.SetHouseholdIncome(calculated.HouseholdIncome())
+		mortgage.SetExistingDebtPayments(calculated.ExistingDebtPayments())
+		mortgage.SetProductID(productID)
+		mortgage.SetIndexation(calculated.IndexBase(), calculated.IndexDate(), calculated.InflationRate())
+		mortgage.SetGraduatedPayment(calculated.StepUpRate(), calculated.StepUpEveryPeriods())
+		mortgage.SetBalloonRate(calculated.BalloonRate())
+		mortgage.SetGraceWindows(calculated.GraceWindows())
+		mortgage.SetDisbursement(previous.DisbursementDate(), previous.LateInterestRate())
+		mortgage.SetCalculation(calculated.EngineVersion(), calculated.CalculationParameters())
+	}
+	mortgage.SetPrepaymentPenaltyRate(prepaymentPenalty)
 
 	// Actualizar en repositorio
 	if err := s.repository.Update(ctx, mortgage); err != nil {
@@ -387,6 +509,179 @@ func (s *MortgageCommandServiceImpl) HandleDeleteMortgage(
 	return s.repository.Delete(ctx, cmd.MortgageID())
 }
 
+// HandleReprogramMortgage guarda la versión reprogramada como una nueva simulación; la original no cambia
+func (s *MortgageCommandServiceImpl) HandleReprogramMortgage(
+	ctx context.Context,
+	cmd *commands.ReprogramMortgageCommand,
+) (*entities.MortgageReprogramming, error) {
+	original, err := s.repository.FindByID(ctx, cmd.MortgageID)
+	if err != nil {
+		return nil, err
+	}
+	if original.UserID().String() != cmd.UserID.String() {
+		return nil, ErrUnauthorizedAccess
+	}
+
+	reprogrammed, err := s.reprogrammer.Reprogram(original, cmd.Period, services.ReprogrammingTerms{
+		ExtensionPeriods:  cmd.ExtensionPeriods,
+		InterestRate:      cmd.InterestRate,
+		RateType:          cmd.RateType,
+		OverduePeriods:    cmd.OverduePeriods,
+		CapitalizeOverdue: cmd.CapitalizeOverdue,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.NPVDiscountRate > 0 {
+		npv, err := s.calculator.CalculateNPV(reprogrammed, cmd.NPVDiscountRate)
+		if err != nil {
+			return nil, err
+		}
+		reprogrammed.SetNPV(npv)
+	}
+
+	// TIR y TCEA sobre todo el crédito: cuotas pagadas más el tramo reprogramado
+	irr, err := s.calculator.CalculateIRR(reprogrammed)
+	if err != nil {
+		return nil, err
+	}
+	reprogrammed.SetIRR(irr)
+
+	flowIRR, err := s.calculator.CalculateFlowIRR(reprogrammed)
+	if err != nil {
+		return nil, err
+	}
+	reprogrammed.SetFlowIRR(flowIRR)
+	reprogrammed.SetTCEA(s.calculator.CalculateTCEA(flowIRR, reprogrammed.PeriodsPerYear()))
+
+	if err := s.repository.Save(ctx, reprogrammed); err != nil {
+		return nil, err
+	}
+
+	return &entities.MortgageReprogramming{Original: original, Reprogrammed: reprogrammed}, nil
+}
+
+// applyHouseholdFinances valida el co-prestatario (si hay), fija las personas aseguradas,
+// el ingreso familiar y las deudas vigentes del titular
+func (s *MortgageCommandServiceImpl) applyHouseholdFinances(ctx context.Context, mortgage *entities.Mortgage, coBorrowerID string) error {
+	userID := mortgage.UserID().String()
+
+	insuredParties := 1
+	if coBorrowerID != "" {
+		if err := s.externalProfileService.ValidateCoBorrower(ctx, userID, coBorrowerID); err != nil {
+			return err
+		}
+		insuredParties = 2
+	}
+	mortgage.SetCoBorrower(coBorrowerID, insuredParties)
+
+	// Las cuotas en VAC se pagan en soles: el ingreso y las deudas se comparan en soles
+	settlement := mortgage.Currency().SettlementCurrency().String()
+	householdIncome, err := s.externalProfileService.HouseholdIncome(ctx, userID, coBorrowerID, settlement)
+	if err != nil {
+		return err
+	}
+	mortgage.SetHouseholdIncome(householdIncome)
+
+	existingDebts, err := s.externalProfileService.ExistingDebtPayments(ctx, userID, settlement)
+	if err != nil {
+		return err
+	}
+	mortgage.SetExistingDebtPayments(existingDebts)
+	return nil
+}
+
+// applyIndexation fija en un crédito en Soles VAC el VAC base (el último publicado, si indexBase es 0)
+// y la inflación anual supuesta; en PEN o USD no hace nada
+func (s *MortgageCommandServiceImpl) applyIndexation(
+	ctx context.Context,
+	mortgage *entities.Mortgage,
+	inflationRate float64,
+	indexBase float64,
+	indexDate time.Time,
+) error {
+	if !mortgage.Currency().IsIndexed() {
+		return nil
+	}
+	if indexBase <= 0 {
+		index, err := s.indexRepository.FindLatestOnOrBefore(ctx, time.Now())
+		if err != nil {
+			return err
+		}
+		if index == nil {
+			return errors.New("VAC index is not available")
+		}
+		indexBase, indexDate = index.VAC(), index.Date()
+	}
+	mortgage.SetIndexation(indexBase, indexDate, inflationRate)
+	return nil
+}
+
+// applyLenderProduct completa el comando con las condiciones publicadas del producto: la TEA máxima del tramo
+// que corresponde al plazo y a la cuota inicial (escenario conservador), los seguros y las comisiones.
+// Los valores indicados en la simulación prevalecen sobre los del producto.
+func (s *MortgageCommandServiceImpl) applyLenderProduct(ctx context.Context, cmd *commands.CalculateMortgageCommand) error {
+	productID, err := valueobjects.NewProductID(cmd.ProductID)
+	if err != nil {
+		return err
+	}
+	product, err := s.productRepository.FindByID(ctx, productID)
+	if err != nil {
+		return err
+	}
+	if !product.IsActive() {
+		return errors.New("lender product is not available")
+	}
+
+	if cmd.Currency == "" {
+		cmd.Currency = product.Currency().String()
+	} else if cmd.Currency != product.Currency().String() {
+		return errors.New("currency does not match the lender product")
+	}
+
+	if cmd.InterestRate == 0 {
+		// Los tramos se publican por plazo en meses, aunque la frecuencia de pago no sea mensual
+		termMonths := int(math.Round(float64(cmd.TermMonths) * float64(cmd.PaymentFrequencyDays) / 30))
+		downPaymentPct := cmd.DownPayment / cmd.PropertyPrice * 100
+
+		tier, err := product.RateFor(termMonths, downPaymentPct)
+		if err != nil {
+			return err
+		}
+		// Los tramos se publican en porcentaje
+		rate, err := valueobjects.NewRate(tier.MaxRate(), valueobjects.RateUnitPercent)
+		if err != nil {
+			return err
+		}
+		cmd.InterestRate = rate.Decimal()
+		cmd.RateType = valueobjects.RateTypeEffective.String()
+	}
+	if cmd.RateType == "" {
+		return errors.New("rate type is required when the interest rate is given")
+	}
+
+	if cmd.LifeInsuranceRate == 0 {
+		cmd.LifeInsuranceRate = product.LifeInsuranceRate()
+	}
+	if cmd.PropertyInsurance == 0 {
+		cmd.PropertyInsurance = product.PropertyInsuranceRate()
+	}
+	if cmd.EvaluationFee == 0 {
+		cmd.EvaluationFee = product.EvaluationFee()
+	}
+	if cmd.DisbursementFee == 0 {
+		cmd.DisbursementFee = product.DisbursementFee()
+	}
+	if cmd.Portes == 0 {
+		cmd.Portes = product.Portes()
+	}
+	if cmd.AdministrationFee == 0 {
+		cmd.AdministrationFee = product.AdministrationFee()
+	}
+	return nil
+}
+
 // Helper functions
 func valueOrDefault(ptr *float64, def float64) float64 {
 	if ptr != nil {
@@ -403,3 +698,21 @@ func valueOrDefaultInt(ptr *int, def int) int {
 }
 
 var ErrUnauthorizedAccess = errors.New("unauthorized access to mortgage")
+
+// ErrDisbursedRecalculation indica que el crédito ya tiene desembolso o pagos y su cronograma no puede cambiar
+var ErrDisbursedRecalculation = errors.New("disbursed mortgages cannot be recalculated")
+
+// ensureNotDisbursed rechaza el recálculo de un crédito desembolsado o con pagos registrados
+func (s *MortgageCommandServiceImpl) ensureNotDisbursed(ctx context.Context, mortgage *entities.Mortgage) error {
+	if mortgage.IsDisbursed() {
+		return ErrDisbursedRecalculation
+	}
+	payments, err := s.paymentRepository.FindByMortgageID(ctx, mortgage.ID())
+	if err != nil {
+		return err
+	}
+	if len(payments) > 0 {
+		return ErrDisbursedRecalculation
+	}
+	return nil
+}
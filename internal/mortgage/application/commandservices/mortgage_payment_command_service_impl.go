@@ -0,0 +1,120 @@
+package commandservices
+
+import (
+	"context"
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/commands"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+	"finanzas-backend/internal/mortgage/domain/repositories"
+	"finanzas-backend/internal/mortgage/domain/services"
+)
+
+type MortgagePaymentCommandServiceImpl struct {
+	mortgageRepo repositories.MortgageRepository
+	paymentRepo  repositories.MortgagePaymentRepository
+}
+
+func NewMortgagePaymentCommandService(
+	mortgageRepo repositories.MortgageRepository,
+	paymentRepo repositories.MortgagePaymentRepository,
+) services.MortgagePaymentCommandService {
+	return &MortgagePaymentCommandServiceImpl{
+		mortgageRepo: mortgageRepo,
+		paymentRepo:  paymentRepo,
+	}
+}
+
+// HandleRegisterDisbursement fija la fecha de desembolso desde la que vencen las cuotas. Una vez que hay
+// pagos registrados solo se puede cambiar la tasa moratoria.
+func (s *MortgagePaymentCommandServiceImpl) HandleRegisterDisbursement(
+	ctx context.Context,
+	cmd *commands.RegisterDisbursementCommand,
+) (*entities.Mortgage, error) {
+	mortgage, err := s.findOwnedMortgage(ctx, cmd.MortgageID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if mortgage.PaymentSchedule() == nil || len(mortgage.PaymentSchedule().GetItems()) == 0 {
+		return nil, errors.New("payment schedule not calculated")
+	}
+	// El cronograma de un crédito en VAC está en unidades VAC, no en los soles que se pagan
+	if mortgage.Currency().IsIndexed() {
+		return nil, errors.New("payment tracking is not available for VAC mortgages")
+	}
+
+	if mortgage.IsDisbursed() && !mortgage.DisbursementDate().Equal(cmd.DisbursementDate) {
+		payments, err := s.paymentRepo.FindByMortgageID(ctx, cmd.MortgageID)
+		if err != nil {
+			return nil, err
+		}
+		if len(payments) > 0 {
+			return nil, errors.New("disbursement date cannot change once payments are recorded")
+		}
+	}
+
+	mortgage.SetDisbursement(cmd.DisbursementDate, cmd.LateInterestRate)
+	if err := s.mortgageRepo.UpdateDisbursement(ctx, mortgage); err != nil {
+		return nil, err
+	}
+	return mortgage, nil
+}
+
+func (s *MortgagePaymentCommandServiceImpl) HandleRecordPayment(
+	ctx context.Context,
+	cmd *commands.RecordPaymentCommand,
+) (*entities.MortgagePayment, error) {
+	mortgage, err := s.findOwnedMortgage(ctx, cmd.MortgageID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !mortgage.IsDisbursed() {
+		return nil, errors.New("mortgage has not been disbursed")
+	}
+
+	payment, err := entities.NewMortgagePayment(cmd.MortgageID, cmd.PaymentDate, cmd.Amount, cmd.Reference)
+	if err != nil {
+		return nil, err
+	}
+	if payment.PaymentDate().Before(mortgage.DisbursementDate()) {
+		return nil, errors.New("payment date is before the disbursement date")
+	}
+
+	if err := s.paymentRepo.Save(ctx, payment); err != nil {
+		return nil, err
+	}
+	return payment, nil
+}
+
+func (s *MortgagePaymentCommandServiceImpl) HandleDeletePayment(
+	ctx context.Context,
+	cmd *commands.DeletePaymentCommand,
+) error {
+	if _, err := s.findOwnedMortgage(ctx, cmd.MortgageID, cmd.UserID); err != nil {
+		return err
+	}
+
+	payment, err := s.paymentRepo.FindByID(ctx, cmd.PaymentID)
+	if err != nil {
+		return err
+	}
+	if payment.MortgageID().Value() != cmd.MortgageID.Value() {
+		return errors.New("payment not found")
+	}
+	return s.paymentRepo.Delete(ctx, cmd.PaymentID)
+}
+
+func (s *MortgagePaymentCommandServiceImpl) findOwnedMortgage(
+	ctx context.Context,
+	mortgageID valueobjects.MortgageID,
+	userID valueobjects.UserID,
+) (*entities.Mortgage, error) {
+	mortgage, err := s.mortgageRepo.FindByID(ctx, mortgageID)
+	if err != nil {
+		return nil, err
+	}
+	if mortgage.UserID().String() != userID.String() {
+		return nil, ErrUnauthorizedAccess
+	}
+	return mortgage, nil
+}
@@ -0,0 +1,182 @@
+package commandservices
+
+import (
+	"context"
+	"errors"
+	"finanzas-backend/internal/mortgage/application/acl"
+	"finanzas-backend/internal/mortgage/domain/model/commands"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+	"finanzas-backend/internal/mortgage/domain/repositories"
+	"finanzas-backend/internal/mortgage/domain/services"
+	"math"
+	"runtime"
+	"sync"
+)
+
+type MortgageQuoteServiceImpl struct {
+	offerRepository        repositories.LenderOfferRepository
+	calculator             *services.FrenchMethodCalculator
+	externalProfileService *acl.ExternalProfileService
+}
+
+func NewMortgageQuoteService(
+	offerRepository repositories.LenderOfferRepository,
+	externalProfileService *acl.ExternalProfileService,
+) services.MortgageQuoteService {
+	return &MortgageQuoteServiceImpl{
+		offerRepository:        offerRepository,
+		calculator:             services.NewFrenchMethodCalculator(),
+		externalProfileService: externalProfileService,
+	}
+}
+
+// HandleQuote calcula la solicitud contra cada oferta elegible en paralelo y las ordena por TCEA.
+// Las simulaciones de la cotización no se guardan.
+func (s *MortgageQuoteServiceImpl) HandleQuote(
+	ctx context.Context,
+	cmd *commands.QuoteMortgageCommand,
+) (*entities.MortgageQuote, error) {
+	offers := cmd.Offers
+	if len(offers) == 0 {
+		var err error
+		offers, err = s.offerRepository.FindAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(offers) == 0 {
+		return nil, errors.New("no lender offers to quote")
+	}
+
+	userID, err := valueobjects.NewUserID(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	currency, err := valueobjects.NewCurrency(cmd.Currency)
+	if err != nil {
+		return nil, err
+	}
+	gracePeriodType, err := valueobjects.NewGracePeriodType(cmd.GracePeriodType)
+	if err != nil {
+		return nil, err
+	}
+
+	// La situación del hogar es la misma para todas las ofertas: se consulta una sola vez
+	insuredParties := 1
+	if cmd.CoBorrowerID != "" {
+		if err := s.externalProfileService.ValidateCoBorrower(ctx, cmd.UserID, cmd.CoBorrowerID); err != nil {
+			return nil, err
+		}
+		insuredParties = 2
+	}
+	householdIncome, err := s.externalProfileService.HouseholdIncome(ctx, cmd.UserID, cmd.CoBorrowerID, currency.String())
+	if err != nil {
+		return nil, err
+	}
+	existingDebts, err := s.externalProfileService.ExistingDebtPayments(ctx, cmd.UserID, currency.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// Los límites de plazo se publican en meses, aunque la frecuencia de pago no sea mensual
+	termMonths := int(math.Round(float64(cmd.TermMonths) * float64(cmd.PaymentFrequencyDays) / 30))
+
+	options := make([]*entities.QuoteOption, len(offers))
+	sem := make(chan struct{}, runtime.NumCPU())
+	var wg sync.WaitGroup
+
+	for i, offer := range offers {
+		option := &entities.QuoteOption{Offer: offer}
+		options[i] = option
+
+		if reason := offer.IneligibilityReason(currency, cmd.LoanAmount, termMonths); reason != "" {
+			option.IneligibleReason = reason
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			mortgage, err := s.calculateOffer(cmd, userID, currency, gracePeriodType, offer, insuredParties)
+			if err != nil {
+				option.IneligibleReason = err.Error()
+				return
+			}
+			mortgage.SetHouseholdIncome(householdIncome)
+			mortgage.SetExistingDebtPayments(existingDebts)
+			option.Mortgage = mortgage
+		}()
+	}
+	wg.Wait()
+
+	return entities.NewMortgageQuote(options), nil
+}
+
+// calculateOffer arma la simulación con las condiciones de la oferta y calcula cronograma, TIR y TCEA
+func (s *MortgageQuoteServiceImpl) calculateOffer(
+	cmd *commands.QuoteMortgageCommand,
+	userID valueobjects.UserID,
+	currency valueobjects.Currency,
+	gracePeriodType valueobjects.GracePeriodType,
+	offer *entities.LenderOffer,
+	insuredParties int,
+) (*entities.Mortgage, error) {
+	mortgage, err := entities.NewMortgage(
+		userID,
+		cmd.PropertyPrice,
+		cmd.DownPayment,
+		cmd.LoanAmount,
+		cmd.BonoTechoPropio,
+		offer.InterestRate(),
+		offer.RateType(),
+		cmd.TermMonths,
+		0,
+		cmd.GracePeriodMonths,
+		gracePeriodType,
+		currency,
+		offer.AdministrationFee(),
+		offer.Portes(),
+		cmd.AdditionalCosts,
+		offer.LifeInsuranceRate(),
+		offer.PropertyInsuranceRate(),
+		offer.EvaluationFee(),
+		offer.DisbursementFee(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	mortgage.SetPaymentFrequencyDays(cmd.PaymentFrequencyDays)
+	mortgage.SetDaysInYear(cmd.DaysInYear)
+	mortgage.SetCoBorrower(cmd.CoBorrowerID, insuredParties)
+
+	if err := s.calculator.Calculate(mortgage); err != nil {
+		return nil, err
+	}
+
+	if cmd.NPVDiscountRate > 0 {
+		npv, err := s.calculator.CalculateNPV(mortgage, cmd.NPVDiscountRate)
+		if err != nil {
+			return nil, err
+		}
+		mortgage.SetNPV(npv)
+	}
+
+	irr, err := s.calculator.CalculateIRR(mortgage)
+	if err != nil {
+		return nil, err
+	}
+	mortgage.SetIRR(irr)
+
+	flowIRR, err := s.calculator.CalculateFlowIRR(mortgage)
+	if err != nil {
+		return nil, err
+	}
+	mortgage.SetFlowIRR(flowIRR)
+	mortgage.SetTCEA(s.calculator.CalculateTCEA(flowIRR, mortgage.PeriodsPerYear()))
+
+	return mortgage, nil
+}
@@ -0,0 +1,42 @@
+package commandservices
+
+import (
+	"context"
+	"finanzas-backend/internal/mortgage/domain/model/commands"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/repositories"
+	"finanzas-backend/internal/mortgage/domain/services"
+	"fmt"
+)
+
+type PriceIndexCommandServiceImpl struct {
+	repository repositories.PriceIndexRepository
+}
+
+func NewPriceIndexCommandService(repository repositories.PriceIndexRepository) services.PriceIndexCommandService {
+	return &PriceIndexCommandServiceImpl{
+		repository: repository,
+	}
+}
+
+// HandleImport valida todas las filas antes de guardar, de modo que un archivo con errores no se importe a medias
+func (s *PriceIndexCommandServiceImpl) HandleImport(
+	ctx context.Context,
+	cmd *commands.ImportPriceIndexCommand,
+) (int, error) {
+	values := make([]*entities.PriceIndex, 0, len(cmd.Entries))
+	for _, entry := range cmd.Entries {
+		value, err := entities.NewPriceIndex(entry.Date, entry.VAC, entry.CPI)
+		if err != nil {
+			return 0, fmt.Errorf("index for %s: %w", entry.Date.Format("2006-01-02"), err)
+		}
+		values = append(values, value)
+	}
+
+	for i, value := range values {
+		if err := s.repository.Upsert(ctx, value); err != nil {
+			return i, err
+		}
+	}
+	return len(values), nil
+}
@@ -0,0 +1,33 @@
+package queryservices
+
+import (
+	"context"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/model/queries"
+	"finanzas-backend/internal/mortgage/domain/repositories"
+	"finanzas-backend/internal/mortgage/domain/services"
+)
+
+type LenderProductQueryServiceImpl struct {
+	repository repositories.LenderProductRepository
+}
+
+func NewLenderProductQueryService(repository repositories.LenderProductRepository) services.LenderProductQueryService {
+	return &LenderProductQueryServiceImpl{
+		repository: repository,
+	}
+}
+
+func (s *LenderProductQueryServiceImpl) HandleGetByID(
+	ctx context.Context,
+	query *queries.GetLenderProductByIDQuery,
+) (*entities.LenderProduct, error) {
+	return s.repository.FindByID(ctx, query.ProductID)
+}
+
+func (s *LenderProductQueryServiceImpl) HandleList(
+	ctx context.Context,
+	query *queries.ListLenderProductsQuery,
+) ([]*entities.LenderProduct, error) {
+	return s.repository.FindAll(ctx, query.Currency, query.IncludeInactive)
+}
@@ -0,0 +1,69 @@
+package queryservices
+
+import (
+	"context"
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/model/queries"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+	"finanzas-backend/internal/mortgage/domain/repositories"
+	"finanzas-backend/internal/mortgage/domain/services"
+)
+
+type MortgagePaymentQueryServiceImpl struct {
+	mortgageRepo repositories.MortgageRepository
+	paymentRepo  repositories.MortgagePaymentRepository
+	calculator   *services.StatementCalculator
+}
+
+func NewMortgagePaymentQueryService(
+	mortgageRepo repositories.MortgageRepository,
+	paymentRepo repositories.MortgagePaymentRepository,
+) services.MortgagePaymentQueryService {
+	return &MortgagePaymentQueryServiceImpl{
+		mortgageRepo: mortgageRepo,
+		paymentRepo:  paymentRepo,
+		calculator:   services.NewStatementCalculator(),
+	}
+}
+
+func (s *MortgagePaymentQueryServiceImpl) HandleListPayments(
+	ctx context.Context,
+	query *queries.ListMortgagePaymentsQuery,
+) ([]*entities.MortgagePayment, error) {
+	if _, err := s.findOwnedMortgage(ctx, query.MortgageID, query.UserID); err != nil {
+		return nil, err
+	}
+	return s.paymentRepo.FindByMortgageID(ctx, query.MortgageID)
+}
+
+// HandleGetStatement aplica los pagos registrados al cronograma guardado
+func (s *MortgagePaymentQueryServiceImpl) HandleGetStatement(
+	ctx context.Context,
+	query *queries.GetMortgageStatementQuery,
+) (*entities.MortgageStatement, error) {
+	mortgage, err := s.findOwnedMortgage(ctx, query.MortgageID, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+	payments, err := s.paymentRepo.FindByMortgageID(ctx, query.MortgageID)
+	if err != nil {
+		return nil, err
+	}
+	return s.calculator.Build(mortgage, payments, query.AsOf)
+}
+
+func (s *MortgagePaymentQueryServiceImpl) findOwnedMortgage(
+	ctx context.Context,
+	mortgageID valueobjects.MortgageID,
+	userID valueobjects.UserID,
+) (*entities.Mortgage, error) {
+	mortgage, err := s.mortgageRepo.FindByID(ctx, mortgageID)
+	if err != nil {
+		return nil, err
+	}
+	if mortgage.UserID().String() != userID.String() {
+		return nil, errors.New("unauthorized access to mortgage")
+	}
+	return mortgage, nil
+}
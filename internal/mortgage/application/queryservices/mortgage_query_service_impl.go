@@ -2,19 +2,34 @@ package queryservices
 
 import (
 	"context"
+	"errors"
 	"finanzas-backend/internal/mortgage/domain/model/entities"
 	"finanzas-backend/internal/mortgage/domain/model/queries"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
 	"finanzas-backend/internal/mortgage/domain/repositories"
 	"finanzas-backend/internal/mortgage/domain/services"
 )
 
 type MortgageQueryServiceImpl struct {
 	repository repositories.MortgageRepository
+	payments   repositories.MortgagePaymentRepository
+	analyzer   *services.RefinanceAnalyzer
+	payoff     *services.PayoffCalculator
+	comparator *services.VersionComparator
+	recomputer *services.Recomputer
 }
 
-func NewMortgageQueryService(repository repositories.MortgageRepository) services.MortgageQueryService {
+func NewMortgageQueryService(
+	repository repositories.MortgageRepository,
+	payments repositories.MortgagePaymentRepository,
+) services.MortgageQueryService {
 	return &MortgageQueryServiceImpl{
 		repository: repository,
+		payments:   payments,
+		analyzer:   services.NewRefinanceAnalyzer(services.NewFrenchMethodCalculator()),
+		payoff:     services.NewPayoffCalculator(services.NewStatementCalculator()),
+		comparator: services.NewVersionComparator(),
+		recomputer: services.NewRecomputer(services.NewFrenchMethodCalculator()),
 	}
 }
 
@@ -31,3 +46,155 @@ func (s *MortgageQueryServiceImpl) HandleGetHistory(
 ) ([]*entities.Mortgage, error) {
 	return s.repository.FindByUserID(ctx, query.UserID, query.Limit, query.Offset)
 }
+
+// HandleAnalyzeRefinance evalúa la compra de deuda de una simulación del usuario
+func (s *MortgageQueryServiceImpl) HandleAnalyzeRefinance(
+	ctx context.Context,
+	query *queries.AnalyzeRefinanceQuery,
+) (*entities.RefinanceAnalysis, error) {
+	mortgage, err := s.repository.FindByID(ctx, query.MortgageID)
+	if err != nil {
+		return nil, err
+	}
+	if mortgage.UserID().String() != query.UserID.String() {
+		return nil, errors.New("unauthorized access to mortgage")
+	}
+
+	return s.analyzer.Analyze(mortgage, query.RefinancePeriod, services.RefinanceTerms{
+		InterestRate:      query.InterestRate,
+		RateType:          query.RateType,
+		TermPeriods:       query.TermPeriods,
+		LifeInsuranceRate: query.LifeInsuranceRate,
+		PropertyInsurance: query.PropertyInsurance,
+		EvaluationFee:     query.EvaluationFee,
+		DisbursementFee:   query.DisbursementFee,
+		Portes:            query.Portes,
+		AdministrationFee: query.AdministrationFee,
+		TransferCosts:     query.TransferCosts,
+	}, query.DiscountRate)
+}
+
+// HandleGetReprogramming devuelve una simulación reprogramada junto con la original de la que parte
+func (s *MortgageQueryServiceImpl) HandleGetReprogramming(
+	ctx context.Context,
+	query *queries.GetMortgageReprogrammingQuery,
+) (*entities.MortgageReprogramming, error) {
+	reprogrammed, err := s.repository.FindByID(ctx, query.MortgageID)
+	if err != nil {
+		return nil, err
+	}
+	if reprogrammed.UserID().String() != query.UserID.String() {
+		return nil, errors.New("unauthorized access to mortgage")
+	}
+	if !reprogrammed.IsReprogrammed() {
+		return nil, errors.New("mortgage is not a reprogramming")
+	}
+
+	originalID, err := valueobjects.NewMortgageID(reprogrammed.ReprogrammedFrom())
+	if err != nil {
+		return nil, err
+	}
+	original, err := s.repository.FindByID(ctx, originalID)
+	if err != nil {
+		if err.Error() == "mortgage not found" {
+			return nil, errors.New("original mortgage not found")
+		}
+		return nil, err
+	}
+
+	return &entities.MortgageReprogramming{Original: original, Reprogrammed: reprogrammed}, nil
+}
+
+// HandleGetPayoffQuote cotiza la cancelación total de una simulación del usuario
+func (s *MortgageQueryServiceImpl) HandleGetPayoffQuote(
+	ctx context.Context,
+	query *queries.GetPayoffQuoteQuery,
+) (*entities.PayoffQuote, error) {
+	mortgage, err := s.repository.FindByID(ctx, query.MortgageID)
+	if err != nil {
+		return nil, err
+	}
+	if mortgage.UserID().String() != query.UserID.String() {
+		return nil, errors.New("unauthorized access to mortgage")
+	}
+
+	if !query.Date.IsZero() {
+		payments, err := s.payments.FindByMortgageID(ctx, query.MortgageID)
+		if err != nil {
+			return nil, err
+		}
+		return s.payoff.QuoteAtDate(mortgage, payments, query.Date)
+	}
+	return s.payoff.QuoteAtPeriod(mortgage, query.Period, query.Days)
+}
+
+// HandleListVersions lista las versiones de una simulación del usuario
+func (s *MortgageQueryServiceImpl) HandleListVersions(
+	ctx context.Context,
+	query *queries.ListMortgageVersionsQuery,
+) ([]*entities.MortgageVersion, error) {
+	if err := s.checkOwnership(ctx, query.MortgageID, query.UserID); err != nil {
+		return nil, err
+	}
+	return s.repository.FindVersions(ctx, query.MortgageID)
+}
+
+func (s *MortgageQueryServiceImpl) HandleGetVersion(
+	ctx context.Context,
+	query *queries.GetMortgageVersionQuery,
+) (*entities.MortgageVersion, error) {
+	if err := s.checkOwnership(ctx, query.MortgageID, query.UserID); err != nil {
+		return nil, err
+	}
+	return s.repository.FindVersion(ctx, query.MortgageID, query.Version)
+}
+
+// HandleCompareVersions compara dos versiones de una simulación del usuario
+func (s *MortgageQueryServiceImpl) HandleCompareVersions(
+	ctx context.Context,
+	query *queries.CompareMortgageVersionsQuery,
+) (*entities.MortgageVersionDiff, error) {
+	if err := s.checkOwnership(ctx, query.MortgageID, query.UserID); err != nil {
+		return nil, err
+	}
+	from, err := s.repository.FindVersion(ctx, query.MortgageID, query.FromVersion)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.repository.FindVersion(ctx, query.MortgageID, query.ToVersion)
+	if err != nil {
+		return nil, err
+	}
+	return s.comparator.Compare(from, to)
+}
+
+// HandleRecompute recalcula una simulación del usuario con el motor vigente y la compara con la guardada
+func (s *MortgageQueryServiceImpl) HandleRecompute(
+	ctx context.Context,
+	query *queries.RecomputeMortgageQuery,
+) (*entities.MortgageRecomputation, error) {
+	mortgage, err := s.repository.FindByID(ctx, query.MortgageID)
+	if err != nil {
+		return nil, err
+	}
+	if mortgage.UserID().String() != query.UserID.String() {
+		return nil, errors.New("unauthorized access to mortgage")
+	}
+	return s.recomputer.Recompute(mortgage)
+}
+
+// checkOwnership verifica que la simulación exista y sea del usuario
+func (s *MortgageQueryServiceImpl) checkOwnership(
+	ctx context.Context,
+	id valueobjects.MortgageID,
+	userID valueobjects.UserID,
+) error {
+	mortgage, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if mortgage.UserID().String() != userID.String() {
+		return errors.New("unauthorized access to mortgage")
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package queryservices
+
+import (
+	"context"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/model/queries"
+	"finanzas-backend/internal/mortgage/domain/repositories"
+	"finanzas-backend/internal/mortgage/domain/services"
+)
+
+type PriceIndexQueryServiceImpl struct {
+	repository repositories.PriceIndexRepository
+}
+
+func NewPriceIndexQueryService(repository repositories.PriceIndexRepository) services.PriceIndexQueryService {
+	return &PriceIndexQueryServiceImpl{
+		repository: repository,
+	}
+}
+
+func (s *PriceIndexQueryServiceImpl) HandleList(
+	ctx context.Context,
+	query *queries.ListPriceIndexQuery,
+) ([]*entities.PriceIndex, error) {
+	return s.repository.FindBetween(ctx, query.From, query.To)
+}
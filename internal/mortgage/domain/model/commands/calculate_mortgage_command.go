@@ -4,8 +4,11 @@ import (
 	"errors"
 	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
 	"math"
+
+	"github.com/google/uuid"
 )
 
+// CalculateMortgageCommand lleva las tasas en decimal, ya convertidas según la unidad de la solicitud
 type CalculateMortgageCommand struct {
 	UserID               string
 	PropertyPrice        float64
@@ -29,6 +32,18 @@ type CalculateMortgageCommand struct {
 	PropertyInsurance    float64
 	EvaluationFee        float64
 	DisbursementFee      float64
+	CoBorrowerID         string  // Co-prestatario del perfil para crédito mancomunado (opcional)
+	ProductID            uint64  // Producto del catálogo que prellena tasa, seguros y comisiones (opcional)
+	InflationRate        float64 // Inflación anual supuesta para proyectar en soles un crédito en VAC (opcional)
+	StepUpRate           float64 // Cuotas escalonadas: crecimiento de la cuota (opcional)
+	StepUpEveryPeriods   int     // Cuotas escalonadas: cada cuántos periodos crece la cuota
+	BalloonRate          float64 // Fracción del principal diferida a la cuota balón (opcional)
+
+	// Periodos de gracia negociados a mitad del crédito (opcional)
+	GraceWindows []valueobjects.GraceWindow
+
+	// Penalidad por prepago: fracción del capital cancelado anticipadamente (opcional)
+	PrepaymentPenaltyRate float64
 }
 
 func NewCalculateMortgageCommand(
@@ -54,6 +69,15 @@ func NewCalculateMortgageCommand(
 	propertyInsurance float64,
 	evaluationFee float64,
 	disbursementFee float64,
+	coBorrowerID string,
+	productID uint64,
+	inflationRate float64,
+	stepUpRate float64,
+	stepUpEveryPeriods int,
+	balloonRate float64,
+	graceWindows []valueobjects.GraceWindow,
+	prepaymentPenaltyRate float64,
+	rateUnit string,
 ) (*CalculateMortgageCommand, error) {
 	// Validaciones básicas
 	if userID == "" {
@@ -68,6 +92,9 @@ func NewCalculateMortgageCommand(
 	if interestRate < 0 {
 		return nil, errors.New("interest rate cannot be negative")
 	}
+	if interestRate == 0 && productID == 0 {
+		return nil, errors.New("interest rate is required when no lender product is given")
+	}
 	if administrationFee < 0 || portes < 0 || additionalCosts < 0 {
 		return nil, errors.New("fees and additional costs cannot be negative")
 	}
@@ -78,6 +105,33 @@ func NewCalculateMortgageCommand(
 		return nil, errors.New("commissions cannot be negative")
 	}
 
+	// Las tasas se reciben en la unidad indicada y se guardan en decimal
+	unit, err := valueobjects.NewRateUnit(rateUnit)
+	if err != nil {
+		return nil, err
+	}
+	rates := []struct {
+		field string
+		value *float64
+	}{
+		{"interest rate", &interestRate},
+		{"discount rate", &npvDiscountRate},
+		{"life insurance rate", &lifeInsuranceRate},
+		{"property insurance rate", &propertyInsurance},
+		{"inflation rate", &inflationRate},
+		{"step-up rate", &stepUpRate},
+		{"balloon rate", &balloonRate},
+		{"prepayment penalty rate", &prepaymentPenaltyRate},
+	}
+	for _, rate := range rates {
+		if *rate.value < 0 {
+			continue // Las validaciones de signo de cada tasa dan el error
+		}
+		if *rate.value, err = unit.ToDecimal(rate.field, *rate.value); err != nil {
+			return nil, err
+		}
+	}
+
 	effectiveTerm := termMonths
 	if effectiveTerm <= 0 && termYears > 0 && paymentFrequencyDays > 0 && daysInYear > 0 {
 		periodsPerYear := float64(daysInYear) / float64(paymentFrequencyDays)
@@ -99,16 +153,50 @@ func NewCalculateMortgageCommand(
 		return nil, errors.New("days in year must be greater than zero")
 	}
 
-	// Validar tipos de enumeraciones
-	if _, err := valueobjects.NewRateType(rateType); err != nil {
-		return nil, err
+	// Validar tipos de enumeraciones (con producto, el tipo de tasa y la moneda pueden venir de él)
+	if rateType != "" || productID == 0 {
+		if _, err := valueobjects.NewRateType(rateType); err != nil {
+			return nil, err
+		}
 	}
 	if _, err := valueobjects.NewGracePeriodType(gracePeriodType); err != nil {
 		return nil, err
 	}
-	if _, err := valueobjects.NewCurrency(currency); err != nil {
+	if currency != "" || productID == 0 {
+		if _, err := valueobjects.NewCurrency(currency); err != nil {
+			return nil, err
+		}
+	}
+	if inflationRate < 0 {
+		return nil, errors.New("inflation rate cannot be negative")
+	}
+	if inflationRate > 0 && currency != valueobjects.CurrencyVAC.String() {
+		return nil, errors.New("inflation rate only applies to VAC mortgages")
+	}
+	if stepUpRate < 0 {
+		return nil, errors.New("step-up rate cannot be negative")
+	}
+	if stepUpRate > 0 && stepUpEveryPeriods <= 0 {
+		return nil, errors.New("step-up periods must be greater than zero")
+	}
+	if balloonRate < 0 || balloonRate >= 1 {
+		return nil, errors.New("balloon rate must be at least 0 and less than 100% (1 as a decimal)")
+	}
+	if prepaymentPenaltyRate < 0 || prepaymentPenaltyRate >= 1 {
+		return nil, errors.New("prepayment penalty rate must be at least 0 and less than 100% (1 as a decimal)")
+	}
+	initialGrace := gracePeriodMonths
+	if gracePeriodType == valueobjects.GracePeriodNone.String() {
+		initialGrace = 0
+	}
+	if err := valueobjects.ValidateGraceWindows(graceWindows, initialGrace, effectiveTerm); err != nil {
 		return nil, err
 	}
+	if coBorrowerID != "" {
+		if _, err := uuid.Parse(coBorrowerID); err != nil {
+			return nil, errors.New("invalid co-borrower ID format")
+		}
+	}
 
 	return &CalculateMortgageCommand{
 		UserID:               userID,
@@ -133,5 +221,14 @@ func NewCalculateMortgageCommand(
 		PropertyInsurance:    propertyInsurance,
 		EvaluationFee:        evaluationFee,
 		DisbursementFee:      disbursementFee,
+		CoBorrowerID:         coBorrowerID,
+		ProductID:            productID,
+		InflationRate:        inflationRate,
+		StepUpRate:           stepUpRate,
+		StepUpEveryPeriods:   stepUpEveryPeriods,
+		BalloonRate:          balloonRate,
+		GraceWindows:         graceWindows,
+
+		PrepaymentPenaltyRate: prepaymentPenaltyRate,
 	}, nil
 }
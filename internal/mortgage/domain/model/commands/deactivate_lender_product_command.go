@@ -0,0 +1,16 @@
+package commands
+
+import "finanzas-backend/internal/mortgage/domain/model/valueobjects"
+
+// DeactivateLenderProductCommand retira un producto del catálogo; las simulaciones que lo referencian se conservan
+type DeactivateLenderProductCommand struct {
+	ProductID valueobjects.ProductID
+}
+
+func NewDeactivateLenderProductCommand(productID uint64) (*DeactivateLenderProductCommand, error) {
+	id, err := valueobjects.NewProductID(productID)
+	if err != nil {
+		return nil, err
+	}
+	return &DeactivateLenderProductCommand{ProductID: id}, nil
+}
@@ -0,0 +1,28 @@
+package commands
+
+import (
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+)
+
+// DeletePaymentCommand anula un pago registrado por error
+type DeletePaymentCommand struct {
+	MortgageID valueobjects.MortgageID
+	UserID     valueobjects.UserID
+	PaymentID  valueobjects.PaymentID
+}
+
+func NewDeletePaymentCommand(mortgageID uint64, userID string, paymentID uint64) (*DeletePaymentCommand, error) {
+	id, err := valueobjects.NewMortgageID(mortgageID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := valueobjects.NewUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	pid, err := valueobjects.NewPaymentID(paymentID)
+	if err != nil {
+		return nil, err
+	}
+	return &DeletePaymentCommand{MortgageID: id, UserID: uid, PaymentID: pid}, nil
+}
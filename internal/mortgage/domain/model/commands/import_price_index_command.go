@@ -0,0 +1,25 @@
+package commands
+
+import (
+	"errors"
+	"time"
+)
+
+// PriceIndexEntry es una fila de la tabla de índices (fecha, VAC e IPC opcional)
+type PriceIndexEntry struct {
+	Date time.Time
+	VAC  float64
+	CPI  float64
+}
+
+// ImportPriceIndexCommand registra valores del VAC/IPC; un día ya registrado se sobrescribe
+type ImportPriceIndexCommand struct {
+	Entries []PriceIndexEntry
+}
+
+func NewImportPriceIndexCommand(entries []PriceIndexEntry) (*ImportPriceIndexCommand, error) {
+	if len(entries) == 0 {
+		return nil, errors.New("at least one index value is required")
+	}
+	return &ImportPriceIndexCommand{Entries: entries}, nil
+}
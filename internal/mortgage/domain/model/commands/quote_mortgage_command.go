@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+	"math"
+
+	"github.com/google/uuid"
+)
+
+// MaxQuoteOffers limita las ofertas que se cotizan en una sola solicitud
+const MaxQuoteOffers = 50
+
+// QuoteMortgageCommand cotiza una misma solicitud contra varias ofertas de entidades.
+// Si Offers está vacío se usan las ofertas mantenidas por los administradores.
+type QuoteMortgageCommand struct {
+	UserID               string
+	PropertyPrice        float64
+	DownPayment          float64
+	LoanAmount           float64
+	BonoTechoPropio      float64
+	PaymentFrequencyDays int
+	DaysInYear           int
+	TermMonths           int
+	GracePeriodMonths    int
+	GracePeriodType      string
+	Currency             string
+	NPVDiscountRate      float64 // En decimal
+	AdditionalCosts      float64
+	CoBorrowerID         string
+	Offers               []*entities.LenderOffer
+}
+
+func NewQuoteMortgageCommand(
+	userID string,
+	propertyPrice float64,
+	downPayment float64,
+	loanAmount float64,
+	bonoTechoPropio float64,
+	paymentFrequencyDays int,
+	daysInYear int,
+	termMonths int,
+	termYears int,
+	gracePeriodMonths int,
+	gracePeriodType string,
+	currency string,
+	npvDiscountRate float64,
+	additionalCosts float64,
+	coBorrowerID string,
+	offers []*entities.LenderOffer,
+	rateUnit string,
+) (*QuoteMortgageCommand, error) {
+	if userID == "" {
+		return nil, errors.New("user ID is required")
+	}
+	if propertyPrice <= 0 {
+		return nil, errors.New("property price must be greater than zero")
+	}
+	if loanAmount <= 0 {
+		return nil, errors.New("loan amount must be greater than zero")
+	}
+	if additionalCosts < 0 {
+		return nil, errors.New("fees and additional costs cannot be negative")
+	}
+	if paymentFrequencyDays <= 0 {
+		return nil, errors.New("payment frequency days must be greater than zero")
+	}
+	if daysInYear <= 0 {
+		return nil, errors.New("days in year must be greater than zero")
+	}
+
+	effectiveTerm := termMonths
+	if effectiveTerm <= 0 && termYears > 0 {
+		periodsPerYear := float64(daysInYear) / float64(paymentFrequencyDays)
+		effectiveTerm = int(math.Round(periodsPerYear * float64(termYears)))
+	}
+	if effectiveTerm <= 0 {
+		return nil, errors.New("term months must be greater than zero")
+	}
+	if gracePeriodMonths < 0 {
+		return nil, errors.New("grace period months cannot be negative")
+	}
+	if gracePeriodMonths >= effectiveTerm {
+		return nil, errors.New("grace period months must be less than term months")
+	}
+	if _, err := valueobjects.NewGracePeriodType(gracePeriodType); err != nil {
+		return nil, err
+	}
+	if _, err := valueobjects.NewCurrency(currency); err != nil {
+		return nil, err
+	}
+	if coBorrowerID != "" {
+		if _, err := uuid.Parse(coBorrowerID); err != nil {
+			return nil, errors.New("invalid co-borrower ID format")
+		}
+	}
+	if len(offers) > MaxQuoteOffers {
+		return nil, errors.New("too many offers in a single quote")
+	}
+	unit, err := valueobjects.NewRateUnit(rateUnit)
+	if err != nil {
+		return nil, err
+	}
+	if npvDiscountRate, err = unit.ToDecimal("discount rate", npvDiscountRate); err != nil {
+		return nil, err
+	}
+
+	return &QuoteMortgageCommand{
+		UserID:               userID,
+		PropertyPrice:        propertyPrice,
+		DownPayment:          downPayment,
+		LoanAmount:           loanAmount,
+		BonoTechoPropio:      bonoTechoPropio,
+		PaymentFrequencyDays: paymentFrequencyDays,
+		DaysInYear:           daysInYear,
+		TermMonths:           effectiveTerm,
+		GracePeriodMonths:    gracePeriodMonths,
+		GracePeriodType:      gracePeriodType,
+		Currency:             currency,
+		NPVDiscountRate:      npvDiscountRate,
+		AdditionalCosts:      additionalCosts,
+		CoBorrowerID:         coBorrowerID,
+		Offers:               offers,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+	"time"
+)
+
+// RecordPaymentCommand registra un pago real sobre un crédito desembolsado
+type RecordPaymentCommand struct {
+	MortgageID  valueobjects.MortgageID
+	UserID      valueobjects.UserID
+	PaymentDate time.Time
+	Amount      float64
+	Reference   string
+}
+
+func NewRecordPaymentCommand(
+	mortgageID uint64,
+	userID string,
+	paymentDate time.Time,
+	amount float64,
+	reference string,
+) (*RecordPaymentCommand, error) {
+	id, err := valueobjects.NewMortgageID(mortgageID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := valueobjects.NewUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if paymentDate.IsZero() {
+		return nil, errors.New("payment date is required")
+	}
+	if paymentDate.After(time.Now()) {
+		return nil, errors.New("payment date cannot be in the future")
+	}
+	if amount <= 0 {
+		return nil, errors.New("payment amount must be greater than zero")
+	}
+
+	return &RecordPaymentCommand{
+		MortgageID:  id,
+		UserID:      uid,
+		PaymentDate: paymentDate,
+		Amount:      amount,
+		Reference:   reference,
+	}, nil
+}
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+	"time"
+)
+
+// RegisterDisbursementCommand registra el desembolso real de una simulación para seguir sus pagos
+type RegisterDisbursementCommand struct {
+	MortgageID       valueobjects.MortgageID
+	UserID           valueobjects.UserID
+	DisbursementDate time.Time
+	LateInterestRate float64 // TEA moratoria en decimal, 0 si no se cobra
+}
+
+func NewRegisterDisbursementCommand(
+	mortgageID uint64,
+	userID string,
+	disbursementDate time.Time,
+	lateInterestRate float64,
+	rateUnit string,
+) (*RegisterDisbursementCommand, error) {
+	id, err := valueobjects.NewMortgageID(mortgageID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := valueobjects.NewUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if disbursementDate.IsZero() {
+		return nil, errors.New("disbursement date is required")
+	}
+	disbursementDate = time.Date(disbursementDate.Year(), disbursementDate.Month(), disbursementDate.Day(), 0, 0, 0, 0, time.UTC)
+	if disbursementDate.After(time.Now()) {
+		return nil, errors.New("disbursement date cannot be in the future")
+	}
+	if lateInterestRate < 0 {
+		return nil, errors.New("late interest rate cannot be negative")
+	}
+	unit, err := valueobjects.NewRateUnit(rateUnit)
+	if err != nil {
+		return nil, err
+	}
+	if lateInterestRate, err = unit.ToDecimal("late interest rate", lateInterestRate); err != nil {
+		return nil, err
+	}
+
+	return &RegisterDisbursementCommand{
+		MortgageID:       id,
+		UserID:           uid,
+		DisbursementDate: disbursementDate,
+		LateInterestRate: lateInterestRate,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+)
+
+// ReprogramMortgageCommand reprograma una simulación guardada a partir de Period: conserva las cuotas
+// hasta ese periodo y recalcula el resto con el nuevo plazo y/o tasa
+type ReprogramMortgageCommand struct {
+	MortgageID        valueobjects.MortgageID
+	UserID            valueobjects.UserID
+	Period            int
+	ExtensionPeriods  int
+	InterestRate      float64 // En decimal; 0 mantiene la tasa del crédito
+	RateType          valueobjects.RateType
+	OverduePeriods    int
+	CapitalizeOverdue bool
+	NPVDiscountRate   float64 // COK para el VAN de la versión reprogramada (opcional)
+}
+
+func NewReprogramMortgageCommand(
+	mortgageID uint64,
+	userID string,
+	period int,
+	extensionPeriods int,
+	interestRate float64,
+	rateType string,
+	overduePeriods int,
+	capitalizeOverdue bool,
+	npvDiscountRate float64,
+	rateUnit string,
+) (*ReprogramMortgageCommand, error) {
+	id, err := valueobjects.NewMortgageID(mortgageID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := valueobjects.NewUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if period <= 0 {
+		return nil, errors.New("reprogramming period must be greater than zero")
+	}
+	if extensionPeriods < 0 {
+		return nil, errors.New("term extension cannot be negative")
+	}
+	if interestRate < 0 {
+		return nil, errors.New("interest rate cannot be negative")
+	}
+	if overduePeriods < 0 {
+		return nil, errors.New("overdue installments cannot be negative")
+	}
+	if overduePeriods > period {
+		return nil, errors.New("overdue installments cannot exceed the reprogramming period")
+	}
+	if npvDiscountRate < 0 {
+		return nil, errors.New("discount rate cannot be negative")
+	}
+	unit, err := valueobjects.NewRateUnit(rateUnit)
+	if err != nil {
+		return nil, err
+	}
+	if interestRate, err = unit.ToDecimal("interest rate", interestRate); err != nil {
+		return nil, err
+	}
+	if npvDiscountRate, err = unit.ToDecimal("discount rate", npvDiscountRate); err != nil {
+		return nil, err
+	}
+	if extensionPeriods == 0 && interestRate == 0 && overduePeriods == 0 {
+		return nil, errors.New("reprogramming must extend the term, change the rate or include overdue installments")
+	}
+
+	var rt valueobjects.RateType
+	if interestRate > 0 {
+		if rt, err = valueobjects.NewRateType(rateType); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ReprogramMortgageCommand{
+		MortgageID:        id,
+		UserID:            uid,
+		Period:            period,
+		ExtensionPeriods:  extensionPeriods,
+		InterestRate:      interestRate,
+		RateType:          rt,
+		OverduePeriods:    overduePeriods,
+		CapitalizeOverdue: capitalizeOverdue,
+		NPVDiscountRate:   npvDiscountRate,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+)
+
+// SaveLenderProductCommand crea un producto del catálogo (ProductID vacío) o reemplaza sus condiciones;
+// los seguros van en decimal
+type SaveLenderProductCommand struct {
+	ProductID         *valueobjects.ProductID
+	Bank              string
+	Name              string
+	Currency          valueobjects.Currency
+	RateTiers         []valueobjects.RateTier
+	LifeInsuranceRate float64
+	PropertyInsurance float64
+	EvaluationFee     float64
+	DisbursementFee   float64
+	Portes            float64
+	AdministrationFee float64
+	Active            bool
+}
+
+func NewSaveLenderProductCommand(
+	productID *valueobjects.ProductID,
+	bank string,
+	name string,
+	currency string,
+	rateTiers []valueobjects.RateTier,
+	lifeInsuranceRate float64,
+	propertyInsurance float64,
+	evaluationFee float64,
+	disbursementFee float64,
+	portes float64,
+	administrationFee float64,
+	active bool,
+	rateUnit string,
+) (*SaveLenderProductCommand, error) {
+	curr, err := valueobjects.NewCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+	if len(rateTiers) == 0 {
+		return nil, errors.New("at least one rate tier is required")
+	}
+	if lifeInsuranceRate < 0 || propertyInsurance < 0 {
+		return nil, errors.New("insurance rates cannot be negative")
+	}
+
+	// Los tramos de TEA siempre van en %; los seguros se reciben en la unidad indicada
+	unit, err := valueobjects.NewRateUnit(rateUnit)
+	if err != nil {
+		return nil, err
+	}
+	if lifeInsuranceRate, err = unit.ToDecimal("life insurance rate", lifeInsuranceRate); err != nil {
+		return nil, err
+	}
+	if propertyInsurance, err = unit.ToDecimal("property insurance rate", propertyInsurance); err != nil {
+		return nil, err
+	}
+
+	return &SaveLenderProductCommand{
+		ProductID:         productID,
+		Bank:              bank,
+		Name:              name,
+		Currency:          curr,
+		RateTiers:         rateTiers,
+		LifeInsuranceRate: lifeInsuranceRate,
+		PropertyInsurance: propertyInsurance,
+		EvaluationFee:     evaluationFee,
+		DisbursementFee:   disbursementFee,
+		Portes:            portes,
+		AdministrationFee: administrationFee,
+		Active:            active,
+	}, nil
+}
@@ -3,10 +3,14 @@ package commands
 import (
 	"errors"
 	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+
+	"github.com/google/uuid"
 )
 
+// UpdateMortgageCommand lleva las tasas en decimal, ya convertidas según la unidad de la solicitud
 type UpdateMortgageCommand struct {
 	mortgageID           valueobjects.MortgageID
+	userID               valueobjects.UserID
 	propertyPrice        *float64
 	downPayment          *float64
 	loanAmount           *float64
@@ -28,10 +32,22 @@ type UpdateMortgageCommand struct {
 	propertyInsurance    *float64
 	evaluationFee        *float64
 	disbursementFee      *float64
+	coBorrowerID         *string // Vacío quita el co-prestatario
+	inflationRate        *float64
+	stepUpRate           *float64 // 0 vuelve a cuotas fijas
+	stepUpEveryPeriods   *int
+	balloonRate          *float64 // 0 quita la cuota balón
+
+	// Lista vacía quita las ventanas de gracia
+	graceWindows *[]valueobjects.GraceWindow
+
+	// Cambiar la penalidad por prepago no recalcula el cronograma
+	prepaymentPenaltyRate *float64
 }
 
 func NewUpdateMortgageCommand(
 	mortgageID valueobjects.MortgageID,
+	userID valueobjects.UserID,
 	propertyPrice *float64,
 	downPayment *float64,
 	loanAmount *float64,
@@ -53,10 +69,21 @@ func NewUpdateMortgageCommand(
 	propertyInsurance *float64,
 	evaluationFee *float64,
 	disbursementFee *float64,
+	coBorrowerID *string,
+	inflationRate *float64,
+	stepUpRate *float64,
+	stepUpEveryPeriods *int,
+	balloonRate *float64,
+	graceWindows *[]valueobjects.GraceWindow,
+	prepaymentPenaltyRate *float64,
+	rateUnit string,
 ) (*UpdateMortgageCommand, error) {
 	if mortgageID.Value() == 0 {
 		return nil, errors.New("mortgage ID is required")
 	}
+	if userID.String() == "" {
+		return nil, errors.New("user ID is required")
+	}
 
 	// Validate if any value is provided
 	hasUpdates := propertyPrice != nil || downPayment != nil || loanAmount != nil ||
@@ -65,12 +92,43 @@ func NewUpdateMortgageCommand(
 		termMonths != nil || termYears != nil || gracePeriodMonths != nil || gracePeriodType != nil ||
 		currency != nil || npvDiscountRate != nil || administrationFee != nil || portes != nil ||
 		additionalCosts != nil || lifeInsuranceRate != nil || propertyInsurance != nil ||
-		evaluationFee != nil || disbursementFee != nil
+		evaluationFee != nil || disbursementFee != nil || coBorrowerID != nil || inflationRate != nil ||
+		stepUpRate != nil || stepUpEveryPeriods != nil || balloonRate != nil || graceWindows != nil ||
+		prepaymentPenaltyRate != nil
 
 	if !hasUpdates {
 		return nil, errors.New("at least one field must be provided for update")
 	}
 
+	// Las tasas se reciben en la unidad indicada y se guardan en decimal
+	unit, err := valueobjects.NewRateUnit(rateUnit)
+	if err != nil {
+		return nil, err
+	}
+	rates := []struct {
+		field string
+		value **float64
+	}{
+		{"interest rate", &interestRate},
+		{"discount rate", &npvDiscountRate},
+		{"life insurance rate", &lifeInsuranceRate},
+		{"property insurance rate", &propertyInsurance},
+		{"inflation rate", &inflationRate},
+		{"step-up rate", &stepUpRate},
+		{"balloon rate", &balloonRate},
+		{"prepayment penalty rate", &prepaymentPenaltyRate},
+	}
+	for _, rate := range rates {
+		if *rate.value == nil || **rate.value < 0 {
+			continue // Las validaciones de signo de cada tasa dan el error
+		}
+		decimal, err := unit.ToDecimal(rate.field, **rate.value)
+		if err != nil {
+			return nil, err
+		}
+		*rate.value = &decimal
+	}
+
 	// Validate values if provided
 	if propertyPrice != nil && *propertyPrice <= 0 {
 		return nil, errors.New("property price must be greater than zero")
@@ -120,6 +178,24 @@ func NewUpdateMortgageCommand(
 	if disbursementFee != nil && *disbursementFee < 0 {
 		return nil, errors.New("disbursement fee cannot be negative")
 	}
+	if inflationRate != nil && *inflationRate < 0 {
+		return nil, errors.New("inflation rate cannot be negative")
+	}
+	if stepUpRate != nil && *stepUpRate < 0 {
+		return nil, errors.New("step-up rate cannot be negative")
+	}
+	if stepUpEveryPeriods != nil && *stepUpEveryPeriods <= 0 {
+		return nil, errors.New("step-up periods must be greater than zero")
+	}
+	if balloonRate != nil && (*balloonRate < 0 || *balloonRate >= 1) {
+		return nil, errors.New("balloon rate must be at least 0 and less than 100% (1 as a decimal)")
+	}
+	if prepaymentPenaltyRate != nil && (*prepaymentPenaltyRate < 0 || *prepaymentPenaltyRate >= 1) {
+		return nil, errors.New("prepayment penalty rate must be at least 0 and less than 100% (1 as a decimal)")
+	}
+	if graceWindows != nil && len(*graceWindows) > valueobjects.MaxGraceWindows {
+		return nil, errors.New("too many grace windows")
+	}
 
 	// Validate enumerations if provided
 	if rateType != nil {
@@ -138,6 +214,12 @@ func NewUpdateMortgageCommand(
 		}
 	}
 
+	if coBorrowerID != nil && *coBorrowerID != "" {
+		if _, err := uuid.Parse(*coBorrowerID); err != nil {
+			return nil, errors.New("invalid co-borrower ID format")
+		}
+	}
+
 	if paymentFrequencyDays != nil && *paymentFrequencyDays <= 0 {
 		return nil, errors.New("payment frequency days must be greater than zero")
 	}
@@ -147,6 +229,7 @@ func NewUpdateMortgageCommand(
 
 	return &UpdateMortgageCommand{
 		mortgageID:           mortgageID,
+		userID:               userID,
 		propertyPrice:        propertyPrice,
 		downPayment:          downPayment,
 		loanAmount:           loanAmount,
@@ -168,11 +251,20 @@ func NewUpdateMortgageCommand(
 		propertyInsurance:    propertyInsurance,
 		evaluationFee:        evaluationFee,
 		disbursementFee:      disbursementFee,
+		coBorrowerID:         coBorrowerID,
+		inflationRate:        inflationRate,
+		stepUpRate:           stepUpRate,
+		stepUpEveryPeriods:   stepUpEveryPeriods,
+		balloonRate:          balloonRate,
+		graceWindows:         graceWindows,
+
+		prepaymentPenaltyRate: prepaymentPenaltyRate,
 	}, nil
 }
 
 // Getters
 func (c *UpdateMortgageCommand) MortgageID() valueobjects.MortgageID { return c.mortgageID }
+func (c *UpdateMortgageCommand) UserID() valueobjects.UserID         { return c.userID }
 func (c *UpdateMortgageCommand) PropertyPrice() *float64             { return c.propertyPrice }
 func (c *UpdateMortgageCommand) DownPayment() *float64               { return c.downPayment }
 func (c *UpdateMortgageCommand) LoanAmount() *float64                { return c.loanAmount }
@@ -194,3 +286,16 @@ func (c *UpdateMortgageCommand) LifeInsuranceRate() *float64         { return c.
 func (c *UpdateMortgageCommand) PropertyInsurance() *float64         { return c.propertyInsurance }
 func (c *UpdateMortgageCommand) EvaluationFee() *float64             { return c.evaluationFee }
 func (c *UpdateMortgageCommand) DisbursementFee() *float64           { return c.disbursementFee }
+func (c *UpdateMortgageCommand) CoBorrowerID() *string               { return c.coBorrowerID }
+func (c *UpdateMortgageCommand) InflationRate() *float64             { return c.inflationRate }
+func (c *UpdateMortgageCommand) StepUpRate() *float64                { return c.stepUpRate }
+func (c *UpdateMortgageCommand) StepUpEveryPeriods() *int            { return c.stepUpEveryPeriods }
+func (c *UpdateMortgageCommand) BalloonRate() *float64               { return c.balloonRate }
+
+func (c *UpdateMortgageCommand) GraceWindows() *[]valueobjects.GraceWindow {
+	return c.graceWindows
+}
+
+func (c *UpdateMortgageCommand) PrepaymentPenaltyRate() *float64 {
+	return c.prepaymentPenaltyRate
+}
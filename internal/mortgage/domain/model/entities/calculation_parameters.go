@@ -0,0 +1,19 @@
+package entities
+
+// CalculationParameters son los parámetros efectivos con los que el motor calculó la simulación:
+// los derivados de la frecuencia y los porcentajes ya normalizados a decimal
+type CalculationParameters struct {
+	PeriodsPerYear             float64 `json:"periods_per_year"`
+	TotalPeriods               int     `json:"total_periods"`
+	GracePeriods               int     `json:"grace_periods"` // Gracia inicial más ventanas de gracia
+	AnnualRate                 float64 `json:"annual_rate"`   // Tasa ingresada, en decimal
+	EffectiveAnnualRate        float64 `json:"effective_annual_rate"`
+	PeriodicRate               float64 `json:"periodic_rate"`
+	LifeInsuranceRate          float64 `json:"life_insurance_rate"` // Por periodo, por todos los asegurados
+	PropertyInsuranceRate      float64 `json:"property_insurance_rate"`
+	PropertyInsurancePerPeriod float64 `json:"property_insurance_per_period"`
+	BalloonRate                float64 `json:"balloon_rate"`
+	StepUpRate                 float64 `json:"step_up_rate"`
+	InflationRate              float64 `json:"inflation_rate"`
+	DiscountRate               float64 `json:"discount_rate"` // COK usado para el VAN, 0 si no se calculó
+}
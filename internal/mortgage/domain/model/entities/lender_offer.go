@@ -0,0 +1,131 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+)
+
+// LenderOffer es una oferta de crédito de una entidad con la que se cotiza una simulación:
+// tasa y seguros (en decimal), comisiones y los límites de monto y plazo para ser elegible
+type LenderOffer struct {
+	lender            string
+	name              string
+	currency          valueobjects.Currency
+	interestRate      float64
+	rateType          valueobjects.RateType
+	lifeInsuranceRate float64
+	propertyInsurance float64
+	evaluationFee     float64
+	disbursementFee   float64
+	portes            float64
+	adminFee          float64
+	minAmount         float64 // 0 sin mínimo
+	maxAmount         float64 // 0 sin máximo
+	minTermMonths     int     // 0 sin mínimo
+	maxTermMonths     int     // 0 sin máximo
+}
+
+// NewLenderOffer recibe las tasas en rateUnit y las guarda en decimal
+func NewLenderOffer(
+	lender string,
+	name string,
+	currency valueobjects.Currency,
+	interestRate float64,
+	rateType valueobjects.RateType,
+	lifeInsuranceRate float64,
+	propertyInsurance float64,
+	evaluationFee float64,
+	disbursementFee float64,
+	portes float64,
+	adminFee float64,
+	minAmount float64,
+	maxAmount float64,
+	minTermMonths int,
+	maxTermMonths int,
+	rateUnit valueobjects.RateUnit,
+) (*LenderOffer, error) {
+	lender = strings.TrimSpace(lender)
+	if lender == "" {
+		return nil, errors.New("offer lender is required")
+	}
+	if interestRate <= 0 {
+		return nil, errors.New("offer interest rate must be greater than zero")
+	}
+	if lifeInsuranceRate < 0 || propertyInsurance < 0 {
+		return nil, errors.New("insurance rates cannot be negative")
+	}
+	if evaluationFee < 0 || disbursementFee < 0 || portes < 0 || adminFee < 0 {
+		return nil, errors.New("fees cannot be negative")
+	}
+	if minAmount < 0 || maxAmount < 0 || (maxAmount > 0 && maxAmount < minAmount) {
+		return nil, errors.New("offer amount limits are invalid")
+	}
+	if minTermMonths < 0 || maxTermMonths < 0 || (maxTermMonths > 0 && maxTermMonths < minTermMonths) {
+		return nil, errors.New("offer term limits are invalid")
+	}
+	var err error
+	if interestRate, err = rateUnit.ToDecimal("offer interest rate", interestRate); err != nil {
+		return nil, err
+	}
+	if lifeInsuranceRate, err = rateUnit.ToDecimal("offer life insurance rate", lifeInsuranceRate); err != nil {
+		return nil, err
+	}
+	if propertyInsurance, err = rateUnit.ToDecimal("offer property insurance rate", propertyInsurance); err != nil {
+		return nil, err
+	}
+
+	return &LenderOffer{
+		lender:            lender,
+		name:              strings.TrimSpace(name),
+		currency:          currency,
+		interestRate:      interestRate,
+		rateType:          rateType,
+		lifeInsuranceRate: lifeInsuranceRate,
+		propertyInsurance: propertyInsurance,
+		evaluationFee:     evaluationFee,
+		disbursementFee:   disbursementFee,
+		portes:            portes,
+		adminFee:          adminFee,
+		minAmount:         minAmount,
+		maxAmount:         maxAmount,
+		minTermMonths:     minTermMonths,
+		maxTermMonths:     maxTermMonths,
+	}, nil
+}
+
+// IneligibilityReason explica por qué la oferta no aplica al crédito; vacío si es elegible
+func (o *LenderOffer) IneligibilityReason(currency valueobjects.Currency, loanAmount float64, termMonths int) string {
+	switch {
+	case o.currency != currency:
+		return fmt.Sprintf("offer is in %s", o.currency)
+	case o.minAmount > 0 && loanAmount < o.minAmount:
+		return fmt.Sprintf("loan amount is below the minimum of %.2f", o.minAmount)
+	case o.maxAmount > 0 && loanAmount > o.maxAmount:
+		return fmt.Sprintf("loan amount exceeds the maximum of %.2f", o.maxAmount)
+	case o.minTermMonths > 0 && termMonths < o.minTermMonths:
+		return fmt.Sprintf("term is below the minimum of %d months", o.minTermMonths)
+	case o.maxTermMonths > 0 && termMonths > o.maxTermMonths:
+		return fmt.Sprintf("term exceeds the maximum of %d months", o.maxTermMonths)
+	default:
+		return ""
+	}
+}
+
+func (o *LenderOffer) Lender() string                  { return o.lender }
+func (o *LenderOffer) Name() string                    { return o.name }
+func (o *LenderOffer) Currency() valueobjects.Currency { return o.currency }
+func (o *LenderOffer) InterestRate() float64           { return o.interestRate }
+func (o *LenderOffer) RateType() valueobjects.RateType { return o.rateType }
+func (o *LenderOffer) LifeInsuranceRate() float64      { return o.lifeInsuranceRate }
+func (o *LenderOffer) PropertyInsuranceRate() float64  { return o.propertyInsurance }
+func (o *LenderOffer) EvaluationFee() float64          { return o.evaluationFee }
+func (o *LenderOffer) DisbursementFee() float64        { return o.disbursementFee }
+func (o *LenderOffer) Portes() float64                 { return o.portes }
+func (o *LenderOffer) AdministrationFee() float64      { return o.adminFee }
+func (o *LenderOffer) MinAmount() float64              { return o.minAmount }
+func (o *LenderOffer) MaxAmount() float64              { return o.maxAmount }
+func (o *LenderOffer) MinTermMonths() int              { return o.minTermMonths }
+func (o *LenderOffer) MaxTermMonths() int              { return o.maxTermMonths }
@@ -0,0 +1,157 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+)
+
+// LenderProduct es un producto hipotecario publicado por una entidad financiera:
+// tramos de TEA, seguros y comisiones con los que se prellena una simulación
+type LenderProduct struct {
+	id                valueobjects.ProductID
+	bank              string
+	name              string
+	currency          valueobjects.Currency
+	rateTiers         []valueobjects.RateTier
+	lifeInsuranceRate float64 // Tasa mensual de desgravamen (decimal)
+	propertyInsurance float64 // Tasa anual del seguro del inmueble (decimal)
+	evaluationFee     float64
+	disbursementFee   float64
+	portes            float64
+	adminFee          float64
+	active            bool
+	createdAt         time.Time
+	updatedAt         time.Time
+}
+
+func NewLenderProduct(
+	bank string,
+	name string,
+	currency valueobjects.Currency,
+	rateTiers []valueobjects.RateTier,
+	lifeInsuranceRate float64,
+	propertyInsurance float64,
+	evaluationFee float64,
+	disbursementFee float64,
+	portes float64,
+	adminFee float64,
+) (*LenderProduct, error) {
+	product := &LenderProduct{
+		active:    true,
+		createdAt: time.Now(),
+	}
+	if err := product.Update(bank, name, currency, rateTiers, lifeInsuranceRate, propertyInsurance, evaluationFee, disbursementFee, portes, adminFee); err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+func ReconstructLenderProduct(
+	id valueobjects.ProductID,
+	bank string,
+	name string,
+	currency valueobjects.Currency,
+	rateTiers []valueobjects.RateTier,
+	lifeInsuranceRate float64,
+	propertyInsurance float64,
+	evaluationFee float64,
+	disbursementFee float64,
+	portes float64,
+	adminFee float64,
+	active bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *LenderProduct {
+	return &LenderProduct{
+		id:                id,
+		bank:              bank,
+		name:              name,
+		currency:          currency,
+		rateTiers:         rateTiers,
+		lifeInsuranceRate: lifeInsuranceRate,
+		propertyInsurance: propertyInsurance,
+		evaluationFee:     evaluationFee,
+		disbursementFee:   disbursementFee,
+		portes:            portes,
+		adminFee:          adminFee,
+		active:            active,
+		createdAt:         createdAt,
+		updatedAt:         updatedAt,
+	}
+}
+
+// Update reemplaza las condiciones publicadas del producto
+func (p *LenderProduct) Update(
+	bank string,
+	name string,
+	currency valueobjects.Currency,
+	rateTiers []valueobjects.RateTier,
+	lifeInsuranceRate float64,
+	propertyInsurance float64,
+	evaluationFee float64,
+	disbursementFee float64,
+	portes float64,
+	adminFee float64,
+) error {
+	bank = strings.TrimSpace(bank)
+	name = strings.TrimSpace(name)
+	if bank == "" || name == "" {
+		return errors.New("bank and product name are required")
+	}
+	if len(rateTiers) == 0 {
+		return errors.New("at least one rate tier is required")
+	}
+	if lifeInsuranceRate < 0 || propertyInsurance < 0 {
+		return errors.New("insurance rates cannot be negative")
+	}
+	if evaluationFee < 0 || disbursementFee < 0 || portes < 0 || adminFee < 0 {
+		return errors.New("fees cannot be negative")
+	}
+
+	p.bank = bank
+	p.name = name
+	p.currency = currency
+	p.rateTiers = rateTiers
+	p.lifeInsuranceRate = lifeInsuranceRate
+	p.propertyInsurance = propertyInsurance
+	p.evaluationFee = evaluationFee
+	p.disbursementFee = disbursementFee
+	p.portes = portes
+	p.adminFee = adminFee
+	p.updatedAt = time.Now()
+	return nil
+}
+
+func (p *LenderProduct) SetActive(active bool) {
+	p.active = active
+	p.updatedAt = time.Now()
+}
+
+// RateFor retorna el tramo de TEA que aplica al plazo (en meses) y a la cuota inicial (en % del precio)
+func (p *LenderProduct) RateFor(termMonths int, downPaymentPct float64) (valueobjects.RateTier, error) {
+	for _, tier := range p.rateTiers {
+		if tier.Covers(termMonths, downPaymentPct) {
+			return tier, nil
+		}
+	}
+	return valueobjects.RateTier{}, errors.New("product has no published rate for this term and down payment")
+}
+
+func (p *LenderProduct) ID() valueobjects.ProductID         { return p.id }
+func (p *LenderProduct) Bank() string                       { return p.bank }
+func (p *LenderProduct) Name() string                       { return p.name }
+func (p *LenderProduct) Currency() valueobjects.Currency    { return p.currency }
+func (p *LenderProduct) RateTiers() []valueobjects.RateTier { return p.rateTiers }
+func (p *LenderProduct) LifeInsuranceRate() float64         { return p.lifeInsuranceRate }
+func (p *LenderProduct) PropertyInsuranceRate() float64     { return p.propertyInsurance }
+func (p *LenderProduct) EvaluationFee() float64             { return p.evaluationFee }
+func (p *LenderProduct) DisbursementFee() float64           { return p.disbursementFee }
+func (p *LenderProduct) Portes() float64                    { return p.portes }
+func (p *LenderProduct) AdministrationFee() float64         { return p.adminFee }
+func (p *LenderProduct) IsActive() bool                     { return p.active }
+func (p *LenderProduct) CreatedAt() time.Time               { return p.createdAt }
+func (p *LenderProduct) UpdatedAt() time.Time               { return p.updatedAt }
+func (p *LenderProduct) SetID(id valueobjects.ProductID)    { p.id = id }
@@ -13,7 +13,7 @@ type Mortgage struct {
 	downPayment          float64 // Cuota inicial
 	loanAmount           float64 // Monto del préstamo solicitado
 	bonoTechoPropio      float64 // Bono Techo Propio (subsidio)
-	interestRate         float64 // Tasa de interés (TNA o TEA según rateType)
+	interestRate         float64 // Tasa de interés en decimal (TNA o TEA según rateType)
 	rateType             valueobjects.RateType
 	termMonths           int // Plazo en meses o número de periodos
 	termYears            int // Plazo en años (se usa para derivar número de cuotas)
@@ -29,6 +29,43 @@ type Mortgage struct {
 	propertyInsurance    float64 // Tasa anual de seguro de inmueble (decimal)
 	evaluationFee        float64 // Comisión de evaluación (única)
 	disbursementFee      float64 // Comisión de desembolso (única)
+	coBorrowerID         string  // Co-prestatario del perfil (crédito mancomunado), vacío si no hay
+	insuredParties       int     // Personas cubiertas por el seguro de desgravamen
+	householdIncome      float64 // Ingreso mensual del titular más el del co-prestatario, en la moneda del crédito
+	existingDebtPayments float64 // Cuotas mensuales de otras deudas del titular, en la moneda del crédito
+	productID            uint64  // Producto del catálogo con el que se prellenó la simulación, 0 si no hay
+
+	// Créditos en Soles VAC: los montos están en unidades VAC y las cuotas se proyectan en soles
+	indexBase     float64   // Valor del VAC (S/ por unidad) a la fecha de la simulación
+	indexDate     time.Time // Fecha del valor del VAC usado
+	inflationRate float64   // Inflación anual supuesta para proyectar el VAC (decimal)
+
+	// Cuotas escalonadas: la cuota crece stepUpRate (decimal) cada stepUpEvery periodos
+	stepUpRate  float64
+	stepUpEvery int
+
+	// Cuota balón: fracción (decimal) del principal que se difiere a la última cuota
+	balloonRate float64
+
+	// Periodos de gracia negociados a mitad del crédito, ordenados por periodo de inicio
+	graceWindows []valueobjects.GraceWindow
+
+	// Reprogramación: versión de otra simulación que conserva sus cuotas hasta reprogrammingPeriod
+	reprogrammedFrom    uint64  // Simulación original, 0 si no es una reprogramación
+	reprogrammingPeriod int     // Última cuota del cronograma original que se conserva
+	overdueInterest     float64 // Intereses de cuotas vencidas incluidos en la reprogramación
+	overdueCapitalized  bool    // Los intereses vencidos se sumaron al saldo (si no, se cobran en la primera cuota)
+
+	// Penalidad por prepago: fracción (decimal) del capital que se cancela anticipadamente
+	prepaymentPenaltyRate float64
+
+	// Seguimiento de pagos reales: fecha de desembolso y tasa moratoria (TEA)
+	disbursementDate time.Time
+	lateInterestRate float64
+
+	// Versión del motor de cálculo y parámetros efectivos con los que se calculó
+	engineVersion         string
+	calculationParameters CalculationParameters
 
 	// Resultados calculados
 	principalFinanced float64          // Principal financiado = loanAmount - bonoTechoPropio
@@ -92,6 +129,7 @@ func NewMortgage(
 		propertyInsurance:    propertyInsurance,
 		evaluationFee:        evaluationFee,
 		disbursementFee:      disbursementFee,
+		insuredParties:       1,
 		createdAt:            time.Now(),
 	}, nil
 }
@@ -157,6 +195,7 @@ func ReconstructMortgage(
 		propertyInsurance:    propertyInsurance,
 		evaluationFee:        evaluationFee,
 		disbursementFee:      disbursementFee,
+		insuredParties:       1,
 		principalFinanced:    principalFinanced,
 		periodicRate:         periodicRate,
 		fixedInstallment:     fixedInstallment,
@@ -197,6 +236,31 @@ func (m *Mortgage) LifeInsuranceRate() float64                    { return m.lif
 func (m *Mortgage) PropertyInsuranceRate() float64                { return m.propertyInsurance }
 func (m *Mortgage) EvaluationFee() float64                        { return m.evaluationFee }
 func (m *Mortgage) DisbursementFee() float64                      { return m.disbursementFee }
+func (m *Mortgage) CoBorrowerID() string                          { return m.coBorrowerID }
+func (m *Mortgage) InsuredParties() int                           { return m.insuredParties }
+func (m *Mortgage) HouseholdIncome() float64                      { return m.householdIncome }
+func (m *Mortgage) ExistingDebtPayments() float64                 { return m.existingDebtPayments }
+func (m *Mortgage) IndexBase() float64                            { return m.indexBase }
+func (m *Mortgage) IndexDate() time.Time                          { return m.indexDate }
+func (m *Mortgage) StepUpRate() float64                           { return m.stepUpRate }
+func (m *Mortgage) StepUpEveryPeriods() int                       { return m.stepUpEvery }
+func (m *Mortgage) IsGraduated() bool                             { return m.stepUpRate > 0 && m.stepUpEvery > 0 }
+func (m *Mortgage) BalloonRate() float64                          { return m.balloonRate }
+func (m *Mortgage) HasBalloon() bool                              { return m.balloonRate > 0 }
+func (m *Mortgage) GraceWindows() []valueobjects.GraceWindow      { return m.graceWindows }
+func (m *Mortgage) ReprogrammedFrom() uint64                      { return m.reprogrammedFrom }
+func (m *Mortgage) ReprogrammingPeriod() int                      { return m.reprogrammingPeriod }
+func (m *Mortgage) OverdueInterest() float64                      { return m.overdueInterest }
+func (m *Mortgage) OverdueCapitalized() bool                      { return m.overdueCapitalized }
+func (m *Mortgage) IsReprogrammed() bool                          { return m.reprogrammedFrom != 0 }
+func (m *Mortgage) DisbursementDate() time.Time                   { return m.disbursementDate }
+func (m *Mortgage) LateInterestRate() float64                     { return m.lateInterestRate }
+func (m *Mortgage) IsDisbursed() bool                             { return !m.disbursementDate.IsZero() }
+func (m *Mortgage) PrepaymentPenaltyRate() float64                { return m.prepaymentPenaltyRate }
+func (m *Mortgage) EngineVersion() string                         { return m.engineVersion }
+func (m *Mortgage) CalculationParameters() CalculationParameters  { return m.calculationParameters }
+func (m *Mortgage) InflationRate() float64                        { return m.inflationRate }
+func (m *Mortgage) ProductID() uint64                             { return m.productID }
 func (m *Mortgage) PeriodsPerYear() float64 {
 	if m.paymentFrequencyDays > 0 && m.daysInYear > 0 {
 		return float64(m.daysInYear) / float64(m.paymentFrequencyDays)
@@ -286,3 +350,141 @@ func (m *Mortgage) SetDisbursementFee(value float64) {
 		m.disbursementFee = value
 	}
 }
+
+// SetCoBorrower asocia el co-prestatario; el desgravamen cubre a insuredParties personas
+func (m *Mortgage) SetCoBorrower(coBorrowerID string, insuredParties int) {
+	m.coBorrowerID = coBorrowerID
+	if insuredParties > 0 {
+		m.insuredParties = insuredParties
+	}
+}
+func (m *Mortgage) SetProductID(productID uint64) { m.productID = productID }
+
+// SetGraduatedPayment configura cuotas escalonadas que crecen rate (decimal) cada every periodos;
+// con rate 0 el cronograma vuelve a cuotas fijas
+func (m *Mortgage) SetGraduatedPayment(rate float64, every int) {
+	if rate <= 0 || every <= 0 {
+		m.stepUpRate, m.stepUpEvery = 0, 0
+		return
+	}
+	m.stepUpRate = rate
+	m.stepUpEvery = every
+}
+
+// SetBalloonRate fija la fracción (decimal) del principal diferido a la cuota balón; 0 la quita
+func (m *Mortgage) SetBalloonRate(rate float64) {
+	if rate < 0 {
+		rate = 0
+	}
+	m.balloonRate = rate
+}
+
+// SetGraceWindows reemplaza las ventanas de gracia negociadas a mitad del crédito
+func (m *Mortgage) SetGraceWindows(windows []valueobjects.GraceWindow) {
+	m.graceWindows = valueobjects.SortGraceWindows(windows)
+}
+
+// SetReprogramming marca la simulación como reprogramación de originalID a partir de period
+func (m *Mortgage) SetReprogramming(originalID uint64, period int, overdueInterest float64, capitalized bool) {
+	m.reprogrammedFrom = originalID
+	m.reprogrammingPeriod = period
+	m.overdueInterest = overdueInterest
+	m.overdueCapitalized = capitalized
+}
+
+// SetCalculation registra la versión del motor y los parámetros efectivos del cálculo
+func (m *Mortgage) SetCalculation(engineVersion string, params CalculationParameters) {
+	m.engineVersion = engineVersion
+	m.calculationParameters = params
+}
+
+// SetPrepaymentPenaltyRate fija la penalidad por prepago (decimal del capital prepagado)
+func (m *Mortgage) SetPrepaymentPenaltyRate(rate float64) {
+	if rate < 0 {
+		rate = 0
+	}
+	m.prepaymentPenaltyRate = rate
+}
+
+// SetDisbursement registra el desembolso real del crédito y la tasa moratoria (TEA, decimal) pactada
+func (m *Mortgage) SetDisbursement(date time.Time, lateInterestRate float64) {
+	m.disbursementDate = date
+	if lateInterestRate >= 0 {
+		m.lateInterestRate = lateInterestRate
+	}
+}
+
+// DueDate es el vencimiento de la cuota period contado desde el desembolso: en meses calendario si la
+// frecuencia es de 30 días o múltiplo (un desembolso del 31 vence el último día de los meses más cortos),
+// en días si no
+func (m *Mortgage) DueDate(period int) time.Time {
+	if m.paymentFrequencyDays <= 0 || m.paymentFrequencyDays%30 != 0 {
+		return m.disbursementDate.AddDate(0, 0, period*m.paymentFrequencyDays)
+	}
+	d := m.disbursementDate
+	firstOfMonth := time.Date(d.Year(), d.Month(), 1, 0, 0, 0, 0, d.Location()).AddDate(0, period*m.paymentFrequencyDays/30, 0)
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	return firstOfMonth.AddDate(0, 0, min(d.Day(), lastDay)-1)
+}
+
+// SetIndexation fija el VAC base y la inflación anual supuesta de un crédito en Soles VAC
+func (m *Mortgage) SetIndexation(indexBase float64, indexDate time.Time, inflationRate float64) {
+	m.indexBase = indexBase
+	m.indexDate = indexDate
+	if inflationRate >= 0 {
+		m.inflationRate = inflationRate
+	}
+}
+func (m *Mortgage) SetHouseholdIncome(value float64) {
+	if value >= 0 {
+		m.householdIncome = value
+	}
+}
+func (m *Mortgage) SetExistingDebtPayments(value float64) {
+	if value >= 0 {
+		m.existingDebtPayments = value
+	}
+}
+
+const (
+	// MaxPaymentToIncomeRatio es la proporción máxima de la cuota sobre el ingreso familiar
+	// que se considera asequible
+	MaxPaymentToIncomeRatio = 0.30
+	// MaxDebtServiceRatio es la proporción máxima del ingreso familiar comprometida en deudas,
+	// incluida la nueva cuota
+	MaxDebtServiceRatio = 0.40
+)
+
+// PaymentToIncomeRatio es la primera cuota total sobre el ingreso familiar; 0 si no se conoce el ingreso
+func (m *Mortgage) PaymentToIncomeRatio() float64 {
+	if m.householdIncome <= 0 {
+		return 0
+	}
+	return m.firstTotalInstallment() / m.householdIncome
+}
+
+// DebtServiceRatio es la nueva cuota más las deudas vigentes sobre el ingreso familiar
+func (m *Mortgage) DebtServiceRatio() float64 {
+	if m.householdIncome <= 0 {
+		return 0
+	}
+	return (m.firstTotalInstallment() + m.existingDebtPayments) / m.householdIncome
+}
+
+// IsAffordable indica si la cuota no supera MaxPaymentToIncomeRatio del ingreso familiar
+// y el endeudamiento total no supera MaxDebtServiceRatio
+func (m *Mortgage) IsAffordable() bool {
+	ratio := m.PaymentToIncomeRatio()
+	return ratio > 0 && ratio <= MaxPaymentToIncomeRatio && m.DebtServiceRatio() <= MaxDebtServiceRatio
+}
+
+// firstTotalInstallment es la primera cuota total en la moneda de pago (en soles proyectados si es VAC)
+func (m *Mortgage) firstTotalInstallment() float64 {
+	if m.paymentSchedule == nil || len(m.paymentSchedule.GetItems()) == 0 {
+		return 0
+	}
+	if m.currency.IsIndexed() {
+		return m.paymentSchedule.GetItems()[0].NominalTotalInstallment
+	}
+	return m.paymentSchedule.GetItems()[0].TotalInstallment
+}
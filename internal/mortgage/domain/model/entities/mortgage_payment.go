@@ -0,0 +1,80 @@
+package entities
+
+import (
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+	"strings"
+	"time"
+)
+
+// MaxPaymentReferenceLength limita la referencia (número de operación, voucher) de un pago
+const MaxPaymentReferenceLength = 100
+
+// MortgagePayment es un pago real registrado sobre un crédito desembolsado
+type MortgagePayment struct {
+	id          valueobjects.PaymentID
+	mortgageID  valueobjects.MortgageID
+	paymentDate time.Time
+	amount      float64
+	reference   string // Número de operación o voucher
+	createdAt   time.Time
+}
+
+func NewMortgagePayment(
+	mortgageID valueobjects.MortgageID,
+	paymentDate time.Time,
+	amount float64,
+	reference string,
+) (*MortgagePayment, error) {
+	if mortgageID.Value() == 0 {
+		return nil, errors.New("mortgage ID is required")
+	}
+	if paymentDate.IsZero() {
+		return nil, errors.New("payment date is required")
+	}
+	if amount <= 0 {
+		return nil, errors.New("payment amount must be greater than zero")
+	}
+	reference = strings.TrimSpace(reference)
+	if len(reference) > MaxPaymentReferenceLength {
+		return nil, errors.New("payment reference is too long")
+	}
+
+	return &MortgagePayment{
+		mortgageID:  mortgageID,
+		paymentDate: time.Date(paymentDate.Year(), paymentDate.Month(), paymentDate.Day(), 0, 0, 0, 0, time.UTC),
+		amount:      amount,
+		reference:   reference,
+		createdAt:   time.Now(),
+	}, nil
+}
+
+func ReconstructMortgagePayment(
+	id valueobjects.PaymentID,
+	mortgageID valueobjects.MortgageID,
+	paymentDate time.Time,
+	amount float64,
+	reference string,
+	createdAt time.Time,
+) *MortgagePayment {
+	return &MortgagePayment{
+		id:          id,
+		mortgageID:  mortgageID,
+		paymentDate: paymentDate,
+		amount:      amount,
+		reference:   reference,
+		createdAt:   createdAt,
+	}
+}
+
+// Getters
+func (p *MortgagePayment) ID() valueobjects.PaymentID          { return p.id }
+func (p *MortgagePayment) MortgageID() valueobjects.MortgageID { return p.mortgageID }
+func (p *MortgagePayment) PaymentDate() time.Time              { return p.paymentDate }
+func (p *MortgagePayment) Amount() float64                     { return p.amount }
+func (p *MortgagePayment) Reference() string                   { return p.reference }
+func (p *MortgagePayment) CreatedAt() time.Time                { return p.createdAt }
+
+func (p *MortgagePayment) SetID(id valueobjects.PaymentID) {
+	p.id = id
+}
@@ -0,0 +1,62 @@
+package entities
+
+import "sort"
+
+// QuoteOption es el resultado de una oferta en una cotización: la simulación calculada
+// (no guardada) o el motivo por el que la oferta no es elegible
+type QuoteOption struct {
+	Offer            *LenderOffer
+	Mortgage         *Mortgage
+	IneligibleReason string
+	ExtraCostVsBest  float64 // Total pagado con cargos por encima de la mejor oferta
+}
+
+func (o *QuoteOption) IsEligible() bool {
+	return o.IneligibleReason == "" && o.Mortgage != nil
+}
+
+// MortgageQuote agrupa las opciones de una cotización multi-entidad ordenadas por TCEA
+type MortgageQuote struct {
+	eligible   []*QuoteOption
+	ineligible []*QuoteOption
+}
+
+// NewMortgageQuote separa las opciones elegibles, las ordena por TCEA (y total pagado en empate)
+// y calcula el sobrecosto de cada una frente a la más barata
+func NewMortgageQuote(options []*QuoteOption) *MortgageQuote {
+	quote := &MortgageQuote{}
+	for _, option := range options {
+		if option.IsEligible() {
+			quote.eligible = append(quote.eligible, option)
+		} else {
+			quote.ineligible = append(quote.ineligible, option)
+		}
+	}
+
+	sort.SliceStable(quote.eligible, func(i, j int) bool {
+		a, b := quote.eligible[i].Mortgage, quote.eligible[j].Mortgage
+		if a.TCEA() != b.TCEA() {
+			return a.TCEA() < b.TCEA()
+		}
+		return a.TotalPaidWithFees() < b.TotalPaidWithFees()
+	})
+
+	if best := quote.Best(); best != nil {
+		for _, option := range quote.eligible {
+			option.ExtraCostVsBest = option.Mortgage.TotalPaidWithFees() - best.Mortgage.TotalPaidWithFees()
+		}
+	}
+	return quote
+}
+
+// Eligible retorna las opciones elegibles, de la más barata a la más cara
+func (q *MortgageQuote) Eligible() []*QuoteOption   { return q.eligible }
+func (q *MortgageQuote) Ineligible() []*QuoteOption { return q.ineligible }
+
+// Best retorna la opción con menor TCEA, o nil si ninguna oferta es elegible
+func (q *MortgageQuote) Best() *QuoteOption {
+	if len(q.eligible) == 0 {
+		return nil
+	}
+	return q.eligible[0]
+}
@@ -0,0 +1,40 @@
+package entities
+
+// MortgageReprogramming compara una simulación con su versión reprogramada; ambas quedan guardadas
+type MortgageReprogramming struct {
+	Original     *Mortgage
+	Reprogrammed *Mortgage
+}
+
+// Period es la última cuota del cronograma original que se conserva sin cambios
+func (r *MortgageReprogramming) Period() int {
+	return r.Reprogrammed.ReprogrammingPeriod()
+}
+
+// ExtraPeriods son las cuotas que la reprogramación agrega al plazo
+func (r *MortgageReprogramming) ExtraPeriods() int {
+	return len(r.Reprogrammed.PaymentSchedule().GetItems()) - len(r.Original.PaymentSchedule().GetItems())
+}
+
+// InstallmentBefore es la cuota total que correspondía pagar después de la reprogramación
+func (r *MortgageReprogramming) InstallmentBefore() float64 {
+	return installmentAt(r.Original, r.Period())
+}
+
+// InstallmentAfter es la primera cuota total del cronograma reprogramado
+func (r *MortgageReprogramming) InstallmentAfter() float64 {
+	return installmentAt(r.Reprogrammed, r.Period())
+}
+
+// ExtraCost es lo que se paga de más (o de menos, si es negativo) con la reprogramación, con cargos
+func (r *MortgageReprogramming) ExtraCost() float64 {
+	return r.Reprogrammed.TotalPaidWithFees() - r.Original.TotalPaidWithFees()
+}
+
+func installmentAt(mortgage *Mortgage, index int) float64 {
+	items := mortgage.PaymentSchedule().GetItems()
+	if index < 0 || index >= len(items) {
+		return 0
+	}
+	return items[index].TotalInstallment
+}
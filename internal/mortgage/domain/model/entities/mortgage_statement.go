@@ -0,0 +1,46 @@
+package entities
+
+import "time"
+
+// InstallmentStatus es la situación de una cuota del cronograma a la fecha del estado de cuenta
+type InstallmentStatus string
+
+const (
+	InstallmentPaid    InstallmentStatus = "PAID"    // Cancelada
+	InstallmentOverdue InstallmentStatus = "OVERDUE" // Vencida con saldo pendiente
+	InstallmentPending InstallmentStatus = "PENDING" // Aún no vence (o vence hoy)
+)
+
+// InstallmentStatement es una cuota del cronograma con los pagos aplicados a ella
+type InstallmentStatement struct {
+	Period        int
+	DueDate       time.Time
+	AmountDue     float64 // Cuota total del cronograma
+	ChargesPaid   float64 // Seguros, comisiones y gastos
+	InterestPaid  float64
+	PrincipalPaid float64
+	LateInterest  float64 // Interés moratorio devengado
+	LatePaid      float64 // Interés moratorio pagado
+	Outstanding   float64 // Pendiente, incluido el interés moratorio
+	DaysPastDue   int
+	Status        InstallmentStatus
+}
+
+// MortgageStatement es el estado de cuenta de un crédito desembolsado a una fecha
+type MortgageStatement struct {
+	MortgageID          uint64
+	AsOf                time.Time
+	PaidToDate          float64 // Pagos registrados hasta la fecha
+	OverdueInstallments int
+	OverdueAmount       float64 // Cuotas vencidas impagas, con interés moratorio
+	LateInterest        float64 // Interés moratorio pendiente
+	NextDuePeriod       int     // 0 si el cronograma está cancelado
+	NextDueDate         time.Time
+	NextDueAmount       float64 // Próxima cuota, descontado el saldo a favor
+	PrincipalBalance    float64 // Capital pendiente
+	AccruedInterest     float64 // Interés corrido del periodo en curso
+	PayoffBalance       float64 // Monto para cancelar el crédito a la fecha
+	CreditBalance       float64 // Saldo a favor que se aplicará a las próximas cuotas
+	DueUnpaid           float64 // Interés y cargos impagos de las cuotas vencidas, sin el moratorio
+	Installments        []InstallmentStatement
+}
@@ -0,0 +1,56 @@
+package entities
+
+import "time"
+
+// MortgageVersion es una versión inmutable de una simulación: los datos, resultados y cronograma tal
+// como quedaron al guardarla. La versión 1 es el cálculo original y cada actualización agrega una.
+type MortgageVersion struct {
+	MortgageID uint64
+	Version    int
+	CreatedAt  time.Time
+	Mortgage   *Mortgage
+}
+
+// VersionChange es un dato o resultado que cambió entre dos versiones
+type VersionChange struct {
+	Field  string
+	Before interface{}
+	After  interface{}
+	Delta  float64 // Solo en valores numéricos
+}
+
+// ScheduleChange es una cuota cuyo monto o saldo cambió entre dos versiones (cero si no existe en una de ellas)
+type ScheduleChange struct {
+	Period            int
+	InstallmentBefore float64
+	InstallmentAfter  float64
+	BalanceBefore     float64
+	BalanceAfter      float64
+}
+
+// MortgageVersionDiff compara dos versiones de la misma simulación (From y To son nil si alguno de los
+// cálculos no está guardado)
+type MortgageVersionDiff struct {
+	MortgageID    uint64
+	From          *MortgageVersion
+	To            *MortgageVersion
+	Inputs        []VersionChange
+	Parameters    []VersionChange // Versión del motor y parámetros efectivos
+	Outputs       []VersionChange
+	PeriodsBefore int
+	PeriodsAfter  int
+	Schedule      []ScheduleChange
+}
+
+// MortgageRecomputation compara el resultado guardado de una simulación con el que da hoy el motor
+// vigente a partir de los mismos datos
+type MortgageRecomputation struct {
+	Saved      *Mortgage
+	Recomputed *Mortgage
+	Changes    *MortgageVersionDiff
+}
+
+// ResultsChanged indica si el motor vigente cambia algún resultado o cuota
+func (r *MortgageRecomputation) ResultsChanged() bool {
+	return len(r.Changes.Outputs) > 0 || len(r.Changes.Schedule) > 0
+}
@@ -2,21 +2,29 @@ package entities
 
 // PaymentScheduleItem representa una fila del cronograma de pagos
 type PaymentScheduleItem struct {
-	Period              int     `json:"period"`                // Número de periodo (mes, bimestre, trimestre, etc.)
-	YearNumber          int     `json:"year_number"`           // Año al que pertenece el periodo (1-n)
-	PeriodicRateApplied float64 `json:"periodic_rate_applied"` // Tasa efectiva del periodo (por ejemplo, TET)
-	Installment         float64 `json:"installment"`           // Cuota base (sin seguros ni gastos)
-	TotalInstallment    float64 `json:"total_installment"`     // Cuota total (incluye seguros y gastos)
-	Interest            float64 `json:"interest"`              // Interés del periodo (I_k)
-	Amortization        float64 `json:"amortization"`          // Amortización del capital (C_k)
-	AdministrationFee   float64 `json:"administration_fee"`    // Gastos administrativos del periodo
-	Portes              float64 `json:"portes"`                // Portes u otros costos fijos del periodo
-	LifeInsurance       float64 `json:"life_insurance"`        // Seguro de desgravamen del periodo
-	PropertyInsurance   float64 `json:"property_insurance"`    // Seguro de inmueble del periodo
-	AdditionalCosts     float64 `json:"additional_costs"`      // Otros costos mensuales adicionales
-	RemainingBalance    float64 `json:"remaining_balance"`     // Saldo restante después del pago
-	IsGracePeriod       bool    `json:"is_grace_period"`       // Indica si es periodo de gracia
-	GraceType           string  `json:"grace_type,omitempty"`  // Tipo de gracia aplicada en el periodo
+	Period               int     `json:"period"`                          // Número de periodo (mes, bimestre, trimestre, etc.)
+	YearNumber           int     `json:"year_number"`                     // Año al que pertenece el periodo (1-n)
+	PeriodicRateApplied  float64 `json:"periodic_rate_applied"`           // Tasa efectiva del periodo (por ejemplo, TET)
+	Installment          float64 `json:"installment"`                     // Cuota base (sin seguros ni gastos)
+	TotalInstallment     float64 `json:"total_installment"`               // Cuota total (incluye seguros y gastos)
+	Interest             float64 `json:"interest"`                        // Interés del periodo (I_k)
+	Amortization         float64 `json:"amortization"`                    // Amortización del capital (C_k)
+	AdministrationFee    float64 `json:"administration_fee"`              // Gastos administrativos del periodo
+	Portes               float64 `json:"portes"`                          // Portes u otros costos fijos del periodo
+	LifeInsurance        float64 `json:"life_insurance"`                  // Seguro de desgravamen del periodo
+	PropertyInsurance    float64 `json:"property_insurance"`              // Seguro de inmueble del periodo
+	AdditionalCosts      float64 `json:"additional_costs"`                // Otros costos mensuales adicionales
+	RemainingBalance     float64 `json:"remaining_balance"`               // Saldo restante después del pago
+	IsGracePeriod        bool    `json:"is_grace_period"`                 // Indica si es periodo de gracia
+	GraceType            string  `json:"grace_type,omitempty"`            // Tipo de gracia aplicada en el periodo
+	NegativeAmortization bool    `json:"negative_amortization,omitempty"` // La cuota escalonada no cubre el interés y el saldo crece
+	BalloonPayment       float64 `json:"balloon_payment,omitempty"`       // Cuota balón incluida en la cuota del último periodo
+	Overdue              bool    `json:"overdue,omitempty"`               // Cuota impaga que se trasladó a una reprogramación
+
+	// Solo en créditos en Soles VAC: proyección en soles con la inflación supuesta
+	ProjectedIndex          float64 `json:"projected_index,omitempty"`           // VAC proyectado al periodo
+	NominalTotalInstallment float64 `json:"nominal_total_installment,omitempty"` // Cuota total en soles
+	NominalBalance          float64 `json:"nominal_balance,omitempty"`           // Saldo en soles
 }
 
 // PaymentSchedule representa el cronograma completo de pagos
@@ -38,10 +46,13 @@ func (ps *PaymentSchedule) GetItems() []PaymentScheduleItem {
 	return ps.Items
 }
 
-// TotalInterestPaid calcula el total de intereses pagados
+// TotalInterestPaid calcula el total de intereses pagados (las cuotas vencidas no se cuentan)
 func (ps *PaymentSchedule) TotalInterestPaid() float64 {
 	total := 0.0
 	for _, item := range ps.Items {
+		if item.Overdue {
+			continue
+		}
 		total += item.Interest
 	}
 	return total
@@ -51,6 +62,9 @@ func (ps *PaymentSchedule) TotalInterestPaid() float64 {
 func (ps *PaymentSchedule) TotalPaid() float64 {
 	total := 0.0
 	for _, item := range ps.Items {
+		if item.Overdue {
+			continue
+		}
 		total += item.Installment
 	}
 	return total
@@ -60,6 +74,9 @@ func (ps *PaymentSchedule) TotalPaid() float64 {
 func (ps *PaymentSchedule) TotalPaidWithCharges() float64 {
 	total := 0.0
 	for _, item := range ps.Items {
+		if item.Overdue {
+			continue
+		}
 		total += item.TotalInstallment
 	}
 	return total
@@ -69,6 +86,9 @@ func (ps *PaymentSchedule) TotalPaidWithCharges() float64 {
 func (ps *PaymentSchedule) TotalCharges() float64 {
 	total := 0.0
 	for _, item := range ps.Items {
+		if item.Overdue {
+			continue
+		}
 		total += item.TotalInstallment - item.Installment
 	}
 	return total
@@ -78,6 +98,9 @@ func (ps *PaymentSchedule) TotalCharges() float64 {
 func (ps *PaymentSchedule) TotalInsurance() float64 {
 	total := 0.0
 	for _, item := range ps.Items {
+		if item.Overdue {
+			continue
+		}
 		total += item.LifeInsurance + item.PropertyInsurance
 	}
 	return total
@@ -87,7 +110,19 @@ func (ps *PaymentSchedule) TotalInsurance() float64 {
 func (ps *PaymentSchedule) TotalAdminFees() float64 {
 	total := 0.0
 	for _, item := range ps.Items {
+		if item.Overdue {
+			continue
+		}
 		total += item.AdministrationFee + item.Portes + item.AdditionalCosts
 	}
 	return total
 }
+
+// BalloonPayment devuelve la cuota balón pagada con la última cuota (0 si no hay)
+func (ps *PaymentSchedule) BalloonPayment() float64 {
+	total := 0.0
+	for _, item := range ps.Items {
+		total += item.BalloonPayment
+	}
+	return total
+}
@@ -0,0 +1,30 @@
+package entities
+
+import (
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+	"time"
+)
+
+// PayoffQuote es el desglose para cancelar el crédito por completo en una fecha (carta de cancelación).
+// A una fecha parte de los pagos registrados; a un periodo supone pagadas las cuotas anteriores.
+type PayoffQuote struct {
+	MortgageID        uint64
+	Currency          valueobjects.Currency
+	QuoteDate         time.Time // Cero si el crédito no tiene fecha de desembolso
+	InstallmentsPaid  int       // Cuotas del cronograma ya pagadas
+	LastDueDate       time.Time // Último vencimiento antes de la fecha (o desembolso)
+	DaysElapsed       int       // Días desde el último vencimiento
+	PrincipalBalance  float64   // Capital pendiente
+	AccruedInterest   float64   // Interés corrido desde el último vencimiento
+	LifeInsurance     float64   // Seguro de desgravamen del periodo en curso, prorrateado
+	PropertyInsurance float64   // Seguro del inmueble del periodo en curso, prorrateado
+	PenaltyRate       float64   // Penalidad por prepago configurada
+	PrepaymentPenalty float64
+	Total             float64
+
+	// Según los pagos registrados (solo en la cotización a una fecha)
+	OverdueInstallments int
+	OverdueInterest     float64 // Interés y cargos impagos de las cuotas vencidas
+	LateInterest        float64 // Interés moratorio pendiente
+	CreditBalance       float64 // Saldo a favor, se descuenta del total
+}
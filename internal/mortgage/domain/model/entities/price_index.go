@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// PriceIndex es el valor diario del VAC (Valor de Actualización Constante) y, si se publica, del IPC
+type PriceIndex struct {
+	date      time.Time
+	vac       float64
+	cpi       float64 // 0 si no se publicó para el día
+	updatedAt time.Time
+}
+
+func NewPriceIndex(date time.Time, vac float64, cpi float64) (*PriceIndex, error) {
+	if date.IsZero() {
+		return nil, errors.New("index date is required")
+	}
+	if vac <= 0 {
+		return nil, errors.New("VAC value must be greater than zero")
+	}
+	if cpi < 0 {
+		return nil, errors.New("CPI value cannot be negative")
+	}
+	return &PriceIndex{
+		date:      time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC),
+		vac:       vac,
+		cpi:       cpi,
+		updatedAt: time.Now(),
+	}, nil
+}
+
+func ReconstructPriceIndex(date time.Time, vac float64, cpi float64, updatedAt time.Time) *PriceIndex {
+	return &PriceIndex{date: date, vac: vac, cpi: cpi, updatedAt: updatedAt}
+}
+
+func (p *PriceIndex) Date() time.Time      { return p.date }
+func (p *PriceIndex) VAC() float64         { return p.vac }
+func (p *PriceIndex) CPI() float64         { return p.cpi }
+func (p *PriceIndex) UpdatedAt() time.Time { return p.updatedAt }
@@ -0,0 +1,19 @@
+package entities
+
+// RefinanceAnalysis compara seguir con el crédito actual contra trasladar el saldo a un nuevo crédito
+type RefinanceAnalysis struct {
+	Current          *Mortgage
+	Refinanced       *Mortgage // Nuevo crédito por el saldo pendiente (no se guarda)
+	RefinancePeriod  int       // Cuotas pagadas del crédito actual antes del traslado
+	RemainingBalance float64   // Saldo del crédito actual al trasladarlo
+	RemainingPaid    float64   // Lo que falta pagar en el crédito actual, con cargos
+	SwitchingCosts   float64   // Comisiones del nuevo crédito más gastos del traslado
+	TotalSavings     float64   // RemainingPaid - (total del nuevo crédito + SwitchingCosts)
+	BreakEvenPeriod  int       // Periodo (desde el traslado) en que el ahorro acumulado cubre el costo; 0 si nunca
+	NPV              float64   // VAN de trasladarse descontado al COK del usuario
+}
+
+// IsWorthIt indica si trasladarse crea valor al COK del usuario
+func (a *RefinanceAnalysis) IsWorthIt() bool {
+	return a.NPV > 0
+}
@@ -0,0 +1,109 @@
+package queries
+
+import (
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+)
+
+// AnalyzeRefinanceQuery evalúa trasladar una simulación guardada a otra entidad (compra de deuda)
+// después de pagar RefinancePeriod cuotas, con las nuevas condiciones del crédito (tasas en decimal)
+type AnalyzeRefinanceQuery struct {
+	MortgageID        valueobjects.MortgageID
+	UserID            valueobjects.UserID
+	RefinancePeriod   int
+	InterestRate      float64
+	RateType          valueobjects.RateType
+	TermPeriods       int // 0 mantiene las cuotas que faltan del crédito actual
+	LifeInsuranceRate float64
+	PropertyInsurance float64
+	EvaluationFee     float64
+	DisbursementFee   float64
+	Portes            float64
+	AdministrationFee float64
+	TransferCosts     float64 // Gastos únicos del traslado (notaría, registros, tasación)
+	DiscountRate      float64 // COK del usuario (TEA) para descontar los ahorros
+}
+
+func NewAnalyzeRefinanceQuery(
+	mortgageID uint64,
+	userID string,
+	refinancePeriod int,
+	interestRate float64,
+	rateType string,
+	termPeriods int,
+	lifeInsuranceRate float64,
+	propertyInsurance float64,
+	evaluationFee float64,
+	disbursementFee float64,
+	portes float64,
+	administrationFee float64,
+	transferCosts float64,
+	discountRate float64,
+	rateUnit string,
+) (*AnalyzeRefinanceQuery, error) {
+	id, err := valueobjects.NewMortgageID(mortgageID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := valueobjects.NewUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	rt, err := valueobjects.NewRateType(rateType)
+	if err != nil {
+		return nil, err
+	}
+	if refinancePeriod <= 0 {
+		return nil, errors.New("refinance period must be greater than zero")
+	}
+	if interestRate <= 0 {
+		return nil, errors.New("interest rate must be greater than zero")
+	}
+	if termPeriods < 0 {
+		return nil, errors.New("term cannot be negative")
+	}
+	if lifeInsuranceRate < 0 || propertyInsurance < 0 {
+		return nil, errors.New("insurance rates cannot be negative")
+	}
+	if evaluationFee < 0 || disbursementFee < 0 || portes < 0 || administrationFee < 0 || transferCosts < 0 {
+		return nil, errors.New("fees cannot be negative")
+	}
+	if discountRate <= 0 {
+		return nil, errors.New("discount rate (COK) must be greater than zero")
+	}
+
+	// Las tasas se reciben en la unidad indicada y se guardan en decimal
+	unit, err := valueobjects.NewRateUnit(rateUnit)
+	if err != nil {
+		return nil, err
+	}
+	if interestRate, err = unit.ToDecimal("interest rate", interestRate); err != nil {
+		return nil, err
+	}
+	if lifeInsuranceRate, err = unit.ToDecimal("life insurance rate", lifeInsuranceRate); err != nil {
+		return nil, err
+	}
+	if propertyInsurance, err = unit.ToDecimal("property insurance rate", propertyInsurance); err != nil {
+		return nil, err
+	}
+	if discountRate, err = unit.ToDecimal("discount rate", discountRate); err != nil {
+		return nil, err
+	}
+
+	return &AnalyzeRefinanceQuery{
+		MortgageID:        id,
+		UserID:            uid,
+		RefinancePeriod:   refinancePeriod,
+		InterestRate:      interestRate,
+		RateType:          rt,
+		TermPeriods:       termPeriods,
+		LifeInsuranceRate: lifeInsuranceRate,
+		PropertyInsurance: propertyInsurance,
+		EvaluationFee:     evaluationFee,
+		DisbursementFee:   disbursementFee,
+		Portes:            portes,
+		AdministrationFee: administrationFee,
+		TransferCosts:     transferCosts,
+		DiscountRate:      discountRate,
+	}, nil
+}
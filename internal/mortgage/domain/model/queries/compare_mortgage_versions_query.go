@@ -0,0 +1,29 @@
+package queries
+
+import (
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+)
+
+// CompareMortgageVersionsQuery compara dos versiones de la misma simulación
+type CompareMortgageVersionsQuery struct {
+	MortgageID  valueobjects.MortgageID
+	UserID      valueobjects.UserID
+	FromVersion int
+	ToVersion   int
+}
+
+func NewCompareMortgageVersionsQuery(mortgageID uint64, userID string, fromVersion, toVersion int) (*CompareMortgageVersionsQuery, error) {
+	id, err := valueobjects.NewMortgageID(mortgageID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := valueobjects.NewUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if fromVersion <= 0 || toVersion <= 0 {
+		return nil, errors.New("version must be greater than zero")
+	}
+	return &CompareMortgageVersionsQuery{MortgageID: id, UserID: uid, FromVersion: fromVersion, ToVersion: toVersion}, nil
+}
@@ -0,0 +1,17 @@
+package queries
+
+import (
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+)
+
+type GetLenderProductByIDQuery struct {
+	ProductID valueobjects.ProductID
+}
+
+func NewGetLenderProductByIDQuery(productID uint64) (*GetLenderProductByIDQuery, error) {
+	id, err := valueobjects.NewProductID(productID)
+	if err != nil {
+		return nil, err
+	}
+	return &GetLenderProductByIDQuery{ProductID: id}, nil
+}
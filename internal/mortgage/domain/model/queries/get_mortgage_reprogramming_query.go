@@ -0,0 +1,23 @@
+package queries
+
+import (
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+)
+
+// GetMortgageReprogrammingQuery compara una simulación reprogramada con su versión original
+type GetMortgageReprogrammingQuery struct {
+	MortgageID valueobjects.MortgageID
+	UserID     valueobjects.UserID
+}
+
+func NewGetMortgageReprogrammingQuery(mortgageID uint64, userID string) (*GetMortgageReprogrammingQuery, error) {
+	id, err := valueobjects.NewMortgageID(mortgageID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := valueobjects.NewUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	return &GetMortgageReprogrammingQuery{MortgageID: id, UserID: uid}, nil
+}
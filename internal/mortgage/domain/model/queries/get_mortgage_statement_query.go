@@ -0,0 +1,28 @@
+package queries
+
+import (
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+	"time"
+)
+
+// GetMortgageStatementQuery pide el estado de cuenta de un crédito desembolsado a la fecha AsOf
+type GetMortgageStatementQuery struct {
+	MortgageID valueobjects.MortgageID
+	UserID     valueobjects.UserID
+	AsOf       time.Time
+}
+
+func NewGetMortgageStatementQuery(mortgageID uint64, userID string, asOf time.Time) (*GetMortgageStatementQuery, error) {
+	id, err := valueobjects.NewMortgageID(mortgageID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := valueobjects.NewUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if asOf.IsZero() {
+		asOf = time.Now()
+	}
+	return &GetMortgageStatementQuery{MortgageID: id, UserID: uid, AsOf: asOf}, nil
+}
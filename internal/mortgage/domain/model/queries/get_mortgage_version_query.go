@@ -0,0 +1,28 @@
+package queries
+
+import (
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+)
+
+// GetMortgageVersionQuery pide una versión guardada de una simulación
+type GetMortgageVersionQuery struct {
+	MortgageID valueobjects.MortgageID
+	UserID     valueobjects.UserID
+	Version    int
+}
+
+func NewGetMortgageVersionQuery(mortgageID uint64, userID string, version int) (*GetMortgageVersionQuery, error) {
+	id, err := valueobjects.NewMortgageID(mortgageID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := valueobjects.NewUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if version <= 0 {
+		return nil, errors.New("version must be greater than zero")
+	}
+	return &GetMortgageVersionQuery{MortgageID: id, UserID: uid, Version: version}, nil
+}
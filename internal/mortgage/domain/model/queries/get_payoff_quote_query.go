@@ -0,0 +1,35 @@
+package queries
+
+import (
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+	"time"
+)
+
+// GetPayoffQuoteQuery pide la cotización de cancelación total a una fecha o, si Date es cero, después
+// de pagar la cuota Period y Days días dentro del periodo siguiente
+type GetPayoffQuoteQuery struct {
+	MortgageID valueobjects.MortgageID
+	UserID     valueobjects.UserID
+	Date       time.Time
+	Period     int
+	Days       int
+}
+
+func NewGetPayoffQuoteQuery(mortgageID uint64, userID string, date time.Time, period, days int) (*GetPayoffQuoteQuery, error) {
+	id, err := valueobjects.NewMortgageID(mortgageID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := valueobjects.NewUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if period < 0 {
+		return nil, errors.New("period cannot be negative")
+	}
+	if days < 0 {
+		return nil, errors.New("days cannot be negative")
+	}
+	return &GetPayoffQuoteQuery{MortgageID: id, UserID: uid, Date: date, Period: period, Days: days}, nil
+}
@@ -0,0 +1,23 @@
+package queries
+
+import (
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+)
+
+// ListLenderProductsQuery lista el catálogo, opcionalmente filtrado por moneda
+type ListLenderProductsQuery struct {
+	Currency        *valueobjects.Currency
+	IncludeInactive bool
+}
+
+func NewListLenderProductsQuery(currency string, includeInactive bool) (*ListLenderProductsQuery, error) {
+	query := &ListLenderProductsQuery{IncludeInactive: includeInactive}
+	if currency != "" {
+		curr, err := valueobjects.NewCurrency(currency)
+		if err != nil {
+			return nil, err
+		}
+		query.Currency = &curr
+	}
+	return query, nil
+}
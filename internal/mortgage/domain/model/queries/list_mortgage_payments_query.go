@@ -0,0 +1,23 @@
+package queries
+
+import (
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+)
+
+// ListMortgagePaymentsQuery lista los pagos registrados de un crédito
+type ListMortgagePaymentsQuery struct {
+	MortgageID valueobjects.MortgageID
+	UserID     valueobjects.UserID
+}
+
+func NewListMortgagePaymentsQuery(mortgageID uint64, userID string) (*ListMortgagePaymentsQuery, error) {
+	id, err := valueobjects.NewMortgageID(mortgageID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := valueobjects.NewUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	return &ListMortgagePaymentsQuery{MortgageID: id, UserID: uid}, nil
+}
@@ -0,0 +1,23 @@
+package queries
+
+import (
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+)
+
+// ListMortgageVersionsQuery lista las versiones guardadas de una simulación
+type ListMortgageVersionsQuery struct {
+	MortgageID valueobjects.MortgageID
+	UserID     valueobjects.UserID
+}
+
+func NewListMortgageVersionsQuery(mortgageID uint64, userID string) (*ListMortgageVersionsQuery, error) {
+	id, err := valueobjects.NewMortgageID(mortgageID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := valueobjects.NewUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	return &ListMortgageVersionsQuery{MortgageID: id, UserID: uid}, nil
+}
@@ -0,0 +1,24 @@
+package queries
+
+import (
+	"errors"
+	"time"
+)
+
+// maxIndexListDays acota el rango de fechas de una consulta de índices
+const maxIndexListDays = 366
+
+type ListPriceIndexQuery struct {
+	From time.Time
+	To   time.Time
+}
+
+func NewListPriceIndexQuery(from, to time.Time) (*ListPriceIndexQuery, error) {
+	if from.After(to) {
+		return nil, errors.New("from date cannot be after to date")
+	}
+	if to.Sub(from) > maxIndexListDays*24*time.Hour {
+		return nil, errors.New("date range cannot exceed 366 days")
+	}
+	return &ListPriceIndexQuery{From: from, To: to}, nil
+}
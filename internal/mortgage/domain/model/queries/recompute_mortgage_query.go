@@ -0,0 +1,23 @@
+package queries
+
+import (
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+)
+
+// RecomputeMortgageQuery recalcula una simulación guardada con el motor vigente sin guardar el resultado
+type RecomputeMortgageQuery struct {
+	MortgageID valueobjects.MortgageID
+	UserID     valueobjects.UserID
+}
+
+func NewRecomputeMortgageQuery(mortgageID uint64, userID string) (*RecomputeMortgageQuery, error) {
+	id, err := valueobjects.NewMortgageID(mortgageID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := valueobjects.NewUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	return &RecomputeMortgageQuery{MortgageID: id, UserID: uid}, nil
+}
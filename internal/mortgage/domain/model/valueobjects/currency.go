@@ -7,18 +7,40 @@ type Currency string
 const (
 	CurrencyPEN Currency = "PEN" // Soles
 	CurrencyUSD Currency = "USD" // Dólares
+	CurrencyVAC Currency = "VAC" // Soles VAC: unidades indexadas a la inflación (IPC)
 )
 
 func NewCurrency(value string) (Currency, error) {
 	curr := Currency(value)
 	switch curr {
-	case CurrencyPEN, CurrencyUSD:
+	case CurrencyPEN, CurrencyUSD, CurrencyVAC:
 		return curr, nil
 	default:
-		return "", errors.New("invalid currency, must be PEN or USD")
+		return "", errors.New("invalid currency, must be PEN, USD or VAC")
 	}
 }
 
 func (c Currency) String() string {
 	return string(c)
 }
+
+// IsIndexed indica si los montos están en unidades indexadas (VAC) y no en dinero nominal
+func (c Currency) IsIndexed() bool {
+	return c == CurrencyVAC
+}
+
+// SettlementCurrency es la moneda en la que se pagan las cuotas: los Soles VAC se pagan en soles
+func (c Currency) SettlementCurrency() Currency {
+	if c == CurrencyVAC {
+		return CurrencyPEN
+	}
+	return c
+}
+
+// OtherCurrency retorna la otra moneda del par PEN/USD
+func (c Currency) OtherCurrency() Currency {
+	if c.SettlementCurrency() == CurrencyUSD {
+		return CurrencyPEN
+	}
+	return CurrencyUSD
+}
@@ -0,0 +1,84 @@
+package valueobjects
+
+import (
+	"errors"
+	"sort"
+)
+
+// MaxGraceWindows limita las ventanas de gracia que se pueden negociar en un mismo crédito
+const MaxGraceWindows = 12
+
+// GraceWindow es un periodo de gracia negociado a mitad del crédito (p. ej. por pérdida de empleo),
+// desde startPeriod durante periods periodos, total o parcial
+type GraceWindow struct {
+	startPeriod int
+	periods     int
+	graceType   GracePeriodType
+}
+
+func NewGraceWindow(startPeriod, periods int, graceType GracePeriodType) (GraceWindow, error) {
+	if startPeriod <= 0 {
+		return GraceWindow{}, errors.New("grace window start period must be greater than zero")
+	}
+	if periods <= 0 {
+		return GraceWindow{}, errors.New("grace window length must be greater than zero")
+	}
+	if graceType != GracePeriodTotal && graceType != GracePeriodPartial {
+		return GraceWindow{}, errors.New("grace window type must be TOTAL or PARTIAL")
+	}
+
+	return GraceWindow{
+		startPeriod: startPeriod,
+		periods:     periods,
+		graceType:   graceType,
+	}, nil
+}
+
+func (w GraceWindow) StartPeriod() int      { return w.startPeriod }
+func (w GraceWindow) Periods() int          { return w.periods }
+func (w GraceWindow) Type() GracePeriodType { return w.graceType }
+func (w GraceWindow) EndPeriod() int        { return w.startPeriod + w.periods - 1 }
+func (w GraceWindow) Covers(period int) bool {
+	return period >= w.startPeriod && period <= w.EndPeriod()
+}
+func (w GraceWindow) Overlaps(o GraceWindow) bool {
+	return w.startPeriod <= o.EndPeriod() && o.startPeriod <= w.EndPeriod()
+}
+
+// SortGraceWindows devuelve una copia de las ventanas ordenada por periodo de inicio
+func SortGraceWindows(windows []GraceWindow) []GraceWindow {
+	sorted := make([]GraceWindow, len(windows))
+	copy(sorted, windows)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].startPeriod < sorted[j].startPeriod })
+	return sorted
+}
+
+// ValidateGraceWindows verifica que las ventanas no se superpongan, empiecen después de la gracia
+// inicial y terminen antes del último periodo, que siempre amortiza el saldo
+func ValidateGraceWindows(windows []GraceWindow, initialGracePeriods, totalPeriods int) error {
+	if len(windows) > MaxGraceWindows {
+		return errors.New("too many grace windows")
+	}
+	sorted := SortGraceWindows(windows)
+	for i, w := range sorted {
+		if w.startPeriod <= initialGracePeriods {
+			return errors.New("grace windows must start after the initial grace period")
+		}
+		if w.EndPeriod() >= totalPeriods {
+			return errors.New("grace windows must end before the last period")
+		}
+		if i > 0 && sorted[i-1].Overlaps(w) {
+			return errors.New("grace windows must not overlap")
+		}
+	}
+	return nil
+}
+
+// TotalGracePeriods suma los periodos cubiertos por las ventanas
+func TotalGracePeriods(windows []GraceWindow) int {
+	total := 0
+	for _, w := range windows {
+		total += w.periods
+	}
+	return total
+}
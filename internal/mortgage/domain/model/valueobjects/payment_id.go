@@ -0,0 +1,18 @@
+package valueobjects
+
+import "errors"
+
+type PaymentID struct {
+	value uint64
+}
+
+func NewPaymentID(value uint64) (PaymentID, error) {
+	if value == 0 {
+		return PaymentID{}, errors.New("payment ID cannot be zero")
+	}
+	return PaymentID{value: value}, nil
+}
+
+func (p PaymentID) Value() uint64 {
+	return p.value
+}
@@ -0,0 +1,19 @@
+package valueobjects
+
+import "errors"
+
+// ProductID identifica un producto hipotecario del catálogo de entidades financieras
+type ProductID struct {
+	value uint64
+}
+
+func NewProductID(value uint64) (ProductID, error) {
+	if value == 0 {
+		return ProductID{}, errors.New("product ID cannot be zero")
+	}
+	return ProductID{value: value}, nil
+}
+
+func (p ProductID) Value() uint64 {
+	return p.value
+}
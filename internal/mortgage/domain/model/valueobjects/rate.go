@@ -0,0 +1,74 @@
+package valueobjects
+
+import (
+	"errors"
+	"fmt"
+)
+
+// RateUnit indica cómo se expresa una tasa en la solicitud: 8.5 (PERCENT) o 0.085 (DECIMAL)
+type RateUnit string
+
+const (
+	RateUnitPercent RateUnit = "PERCENT"
+	RateUnitDecimal RateUnit = "DECIMAL"
+)
+
+// NewRateUnit acepta vacío como unidad no indicada; cualquier otro valor debe ser PERCENT o DECIMAL
+func NewRateUnit(value string) (RateUnit, error) {
+	unit := RateUnit(value)
+	switch unit {
+	case "", RateUnitPercent, RateUnitDecimal:
+		return unit, nil
+	default:
+		return "", errors.New("invalid rate unit, must be PERCENT or DECIMAL")
+	}
+}
+
+func (u RateUnit) String() string {
+	return string(u)
+}
+
+// Rate es una tasa recibida en una unidad y expresada internamente como decimal
+type Rate struct {
+	decimal float64
+}
+
+// NewRate interpreta la tasa según su unidad. Sin unidad solo se acepta 0: cualquier otro
+// valor admite dos lecturas (0.8 puede ser 0.8% u 80%) y se rechaza en lugar de adivinar.
+// Las tasas guardadas antes de registrar la unidad se migran a decimal al iniciar la aplicación.
+func NewRate(value float64, unit RateUnit) (Rate, error) {
+	if value < 0 {
+		return Rate{}, errors.New("rate cannot be negative")
+	}
+
+	switch unit {
+	case RateUnitPercent:
+		if value > 100 {
+			return Rate{}, fmt.Errorf("rate %g%% cannot exceed 100%%", value)
+		}
+		return Rate{decimal: value / 100}, nil
+	case RateUnitDecimal:
+		if value > 1 {
+			return Rate{}, fmt.Errorf("rate %g cannot exceed 1 in DECIMAL unit", value)
+		}
+		return Rate{decimal: value}, nil
+	case "":
+		if value == 0 {
+			return Rate{}, nil
+		}
+		return Rate{}, fmt.Errorf("rate %g has no unit: set the rate unit to PERCENT or DECIMAL", value)
+	default:
+		return Rate{}, errors.New("invalid rate unit, must be PERCENT or DECIMAL")
+	}
+}
+
+// ToDecimal convierte a decimal una tasa recibida en esta unidad; field nombra la tasa en el error
+func (u RateUnit) ToDecimal(field string, value float64) (float64, error) {
+	rate, err := NewRate(value, u)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", field, err)
+	}
+	return rate.Decimal(), nil
+}
+
+func (r Rate) Decimal() float64 { return r.decimal }
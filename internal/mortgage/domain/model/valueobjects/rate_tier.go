@@ -0,0 +1,48 @@
+package valueobjects
+
+import "errors"
+
+// RateTier es un tramo de TEA publicado por la entidad para un rango de plazo (en meses)
+// y de cuota inicial (en % del precio de la vivienda). Las tasas se expresan en porcentaje.
+type RateTier struct {
+	minTermMonths  int
+	maxTermMonths  int
+	minDownPayment float64
+	maxDownPayment float64
+	minRate        float64
+	maxRate        float64
+}
+
+func NewRateTier(minTermMonths, maxTermMonths int, minDownPayment, maxDownPayment, minRate, maxRate float64) (RateTier, error) {
+	if minTermMonths <= 0 || maxTermMonths < minTermMonths {
+		return RateTier{}, errors.New("rate tier term range is invalid")
+	}
+	if minDownPayment < 0 || maxDownPayment > 100 || maxDownPayment < minDownPayment {
+		return RateTier{}, errors.New("rate tier down payment range must be between 0 and 100")
+	}
+	if minRate <= 0 || maxRate < minRate {
+		return RateTier{}, errors.New("rate tier TEA range is invalid")
+	}
+
+	return RateTier{
+		minTermMonths:  minTermMonths,
+		maxTermMonths:  maxTermMonths,
+		minDownPayment: minDownPayment,
+		maxDownPayment: maxDownPayment,
+		minRate:        minRate,
+		maxRate:        maxRate,
+	}, nil
+}
+
+func (t RateTier) MinTermMonths() int      { return t.minTermMonths }
+func (t RateTier) MaxTermMonths() int      { return t.maxTermMonths }
+func (t RateTier) MinDownPayment() float64 { return t.minDownPayment }
+func (t RateTier) MaxDownPayment() float64 { return t.maxDownPayment }
+func (t RateTier) MinRate() float64        { return t.minRate }
+func (t RateTier) MaxRate() float64        { return t.maxRate }
+
+// Covers indica si el tramo aplica al plazo y porcentaje de cuota inicial dados
+func (t RateTier) Covers(termMonths int, downPaymentPct float64) bool {
+	return termMonths >= t.minTermMonths && termMonths <= t.maxTermMonths &&
+		downPaymentPct >= t.minDownPayment && downPaymentPct <= t.maxDownPayment
+}
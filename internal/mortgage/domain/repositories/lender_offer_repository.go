@@ -0,0 +1,11 @@
+package repositories
+
+import (
+	"context"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+)
+
+// LenderOfferRepository provee las ofertas mantenidas por los administradores para cotizar
+type LenderOfferRepository interface {
+	FindAll(ctx context.Context) ([]*entities.LenderOffer, error)
+}
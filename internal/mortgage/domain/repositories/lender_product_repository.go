@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+)
+
+type LenderProductRepository interface {
+	Save(ctx context.Context, product *entities.LenderProduct) error
+	Update(ctx context.Context, product *entities.LenderProduct) error
+	FindByID(ctx context.Context, id valueobjects.ProductID) (*entities.LenderProduct, error)
+	// FindAll lista los productos ordenados por entidad y nombre; currency nil no filtra por moneda
+	FindAll(ctx context.Context, currency *valueobjects.Currency, includeInactive bool) ([]*entities.LenderProduct, error)
+}
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+)
+
+type MortgagePaymentRepository interface {
+	Save(ctx context.Context, payment *entities.MortgagePayment) error
+	Delete(ctx context.Context, id valueobjects.PaymentID) error
+	FindByID(ctx context.Context, id valueobjects.PaymentID) (*entities.MortgagePayment, error)
+	// FindByMortgageID retorna los pagos del crédito ordenados por fecha
+	FindByMortgageID(ctx context.Context, mortgageID valueobjects.MortgageID) ([]*entities.MortgagePayment, error)
+}
@@ -9,7 +9,15 @@ import (
 type MortgageRepository interface {
 	Save(ctx context.Context, mortgage *entities.Mortgage) error
 	Update(ctx context.Context, mortgage *entities.Mortgage) error
+	// UpdateDisbursement guarda la fecha de desembolso y la tasa moratoria sin tocar el cronograma
+	UpdateDisbursement(ctx context.Context, mortgage *entities.Mortgage) error
 	Delete(ctx context.Context, id valueobjects.MortgageID) error
 	FindByID(ctx context.Context, id valueobjects.MortgageID) (*entities.Mortgage, error)
 	FindByUserID(ctx context.Context, userID valueobjects.UserID, limit, offset int) ([]*entities.Mortgage, error)
+	FindAllByUserID(ctx context.Context, userID valueobjects.UserID) ([]*entities.Mortgage, error)
+	DeleteByUserID(ctx context.Context, userID valueobjects.UserID) error
+
+	// Save y Update guardan cada cálculo como una versión inmutable
+	FindVersions(ctx context.Context, id valueobjects.MortgageID) ([]*entities.MortgageVersion, error)
+	FindVersion(ctx context.Context, id valueobjects.MortgageID, version int) (*entities.MortgageVersion, error)
 }
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"time"
+)
+
+type PriceIndexRepository interface {
+	Upsert(ctx context.Context, index *entities.PriceIndex) error
+	// FindLatestOnOrBefore retorna nil si no hay valores hasta esa fecha
+	FindLatestOnOrBefore(ctx context.Context, date time.Time) (*entities.PriceIndex, error)
+	FindBetween(ctx context.Context, from, to time.Time) ([]*entities.PriceIndex, error)
+}
@@ -7,6 +7,10 @@ import (
 	"math"
 )
 
+// EngineVersion identifica las fórmulas del motor de cálculo; se guarda con cada simulación y debe
+// incrementarse con cada cambio que altere los resultados
+const EngineVersion = "1.0.0"
+
 // FrenchMethodCalculator implementa el método francés vencido ordinario
 type FrenchMethodCalculator struct{}
 
@@ -59,19 +63,44 @@ func (fmc *FrenchMethodCalculator) Calculate(mortgage *entities.Mortgage) error
 		}
 	}
 
+	// Las ventanas de gracia negociadas a mitad del crédito tampoco amortizan
+	windows := mortgage.GraceWindows()
+	if err := valueobjects.ValidateGraceWindows(windows, gracePeriods, totalPeriods); err != nil {
+		return err
+	}
+
 	// 4. Calcular cuota fija para periodos posteriores a la gracia
 	// A = P * [i(1+i)^n] / [(1+i)^n - 1]
-	normalPeriods := totalPeriods - gracePeriods
+	normalPeriods := totalPeriods - gracePeriods - valueobjects.TotalGracePeriods(windows)
 	if normalPeriods <= 0 {
 		return errors.New("term months must be greater than grace period months")
 	}
 
-	fixedInstallment := fmc.calculateFixedInstallment(adjustedPrincipal, periodicRate, normalPeriods)
+	// Con cuota balón se difiere un porcentaje del principal (ya capitalizado) al último periodo:
+	// solo se anualiza el resto, pero el interés se sigue cobrando sobre el saldo completo
+	balloonPayment := adjustedPrincipal * mortgage.BalloonRate()
+	if balloonPayment >= adjustedPrincipal {
+		return errors.New("balloon rate must be less than 100%")
+	}
+
+	// En la modalidad escalonada es la primera cuota, que luego crece cada N periodos
+	fixedInstallment := fmc.calculateFixedInstallment(adjustedPrincipal, balloonPayment, periodicRate, normalPeriods)
+	if mortgage.IsGraduated() {
+		fixedInstallment = fmc.calculateGraduatedInstallment(
+			adjustedPrincipal-balloonPayment/math.Pow(1+periodicRate, float64(normalPeriods)),
+			periodicRate,
+			normalPeriods,
+			mortgage.StepUpRate(),
+			mortgage.StepUpEveryPeriods(),
+			0,
+		)
+	}
 	mortgage.SetFixedInstallment(fixedInstallment)
 
 	// 5. Generar cronograma de pagos con cargos adicionales
-	lifeRate := normalizeRate(mortgage.LifeInsuranceRate())
-	propertyRate := normalizeRate(mortgage.PropertyInsuranceRate())
+	// El desgravamen se cobra por cada persona asegurada (titular y co-prestatario)
+	lifeRate := mortgage.LifeInsuranceRate() * float64(mortgage.InsuredParties())
+	propertyRate := mortgage.PropertyInsuranceRate()
 	propertyInsurancePerPeriod := 0.0
 	if propertyRate > 0 {
 		propertyInsurancePerPeriod = mortgage.PropertyPrice() * propertyRate / periodsPerYear
@@ -87,10 +116,17 @@ func (fmc *FrenchMethodCalculator) Calculate(mortgage *entities.Mortgage) error
 		mortgage.Portes(),
 		mortgage.AdditionalCosts(),
 		periodsPerYear,
+		balloonPayment,
 	)
 	if err != nil {
 		return err
 	}
+	// Soles VAC: el cronograma queda en unidades VAC y cada fila se proyecta en soles
+	if mortgage.Currency().IsIndexed() {
+		if err := fmc.projectIndexedSchedule(mortgage, schedule, periodsPerYear); err != nil {
+			return err
+		}
+	}
 	mortgage.SetPaymentSchedule(schedule)
 
 	// 6. Calcular totales
@@ -101,6 +137,42 @@ func (fmc *FrenchMethodCalculator) Calculate(mortgage *entities.Mortgage) error
 	mortgage.SetTotalInsurance(schedule.TotalInsurance())
 	mortgage.SetTotalAdmin(schedule.TotalAdminFees())
 
+	mortgage.SetCalculation(EngineVersion, entities.CalculationParameters{
+		PeriodsPerYear:             periodsPerYear,
+		TotalPeriods:               totalPeriods,
+		GracePeriods:               gracePeriods + valueobjects.TotalGracePeriods(windows),
+		AnnualRate:                 mortgage.InterestRate(),
+		EffectiveAnnualRate:        math.Pow(1+periodicRate, periodsPerYear) - 1,
+		PeriodicRate:               periodicRate,
+		LifeInsuranceRate:          lifeRate,
+		PropertyInsuranceRate:      propertyRate,
+		PropertyInsurancePerPeriod: propertyInsurancePerPeriod,
+		BalloonRate:                mortgage.BalloonRate(),
+		StepUpRate:                 mortgage.StepUpRate(),
+		InflationRate:              mortgage.InflationRate(),
+	})
+
+	return nil
+}
+
+// projectIndexedSchedule proyecta el VAC de cada periodo con la inflación anual supuesta,
+// VAC_k = VAC_0 * (1 + inflación)^(k/m), y expresa la cuota total y el saldo en soles
+func (fmc *FrenchMethodCalculator) projectIndexedSchedule(
+	mortgage *entities.Mortgage,
+	schedule *entities.PaymentSchedule,
+	periodsPerYear float64,
+) error {
+	if mortgage.IndexBase() <= 0 {
+		return errors.New("VAC index is required for VAC mortgages")
+	}
+	inflation := mortgage.InflationRate()
+
+	for i := range schedule.Items {
+		item := &schedule.Items[i]
+		item.ProjectedIndex = mortgage.IndexBase() * math.Pow(1+inflation, float64(item.Period)/periodsPerYear)
+		item.NominalTotalInstallment = item.TotalInstallment * item.ProjectedIndex
+		item.NominalBalance = item.RemainingBalance * item.ProjectedIndex
+	}
 	return nil
 }
 
@@ -117,11 +189,8 @@ func (fmc *FrenchMethodCalculator) convertToPeriodicRate(
 		return 0, errors.New("periods per year must be greater than zero")
 	}
 
-	// Convertir porcentaje a decimal si es necesario (ej: 12% -> 0.12)
+	// La tasa llega en decimal (0.12 para 12%): la unidad se resuelve al recibir la solicitud
 	rate := annualRate
-	if rate > 1 {
-		rate = rate / 100.0
-	}
 
 	switch rateType {
 	case valueobjects.RateTypeNominal:
@@ -135,19 +204,70 @@ func (fmc *FrenchMethodCalculator) convertToPeriodicRate(
 	}
 }
 
-// calculateFixedInstallment calcula la cuota fija usando la fórmula del método francés
-func (fmc *FrenchMethodCalculator) calculateFixedInstallment(principal, periodicRate float64, periods int) float64 {
+// calculateFixedInstallment calcula la cuota fija usando la fórmula del método francés;
+// con cuota balón B solo se anualiza la parte no diferida, P - B/(1+i)^n
+func (fmc *FrenchMethodCalculator) calculateFixedInstallment(principal, balloon, periodicRate float64, periods int) float64 {
 	if periodicRate == 0 {
-		// Si la tasa es 0%, la cuota es simplemente el principal dividido entre periodos
-		return principal / float64(periods)
+		// Si la tasa es 0%, la cuota es simplemente el principal no diferido dividido entre periodos
+		return (principal - balloon) / float64(periods)
 	}
 
-	// A = P * [i(1+i)^n] / [(1+i)^n - 1]
+	// A = [P - B/(1+i)^n] * [i(1+i)^n] / [(1+i)^n - 1]
 	factor := math.Pow(1+periodicRate, float64(periods))
-	installment := principal * (periodicRate * factor) / (factor - 1)
+	installment := (principal - balloon/factor) * (periodicRate * factor) / (factor - 1)
 	return installment
 }
 
+// calculateGraduatedInstallment calcula la primera cuota de un cronograma escalonado en el que la cuota
+// crece un porcentaje g cada N periodos, de modo que el valor actual de las cuotas iguale al principal:
+// A = P / suma_j [(1+g)^floor(j/N) / (1+i)^(j+1)]
+// offset es el número de cuotas ya pagadas cuando se recalcula después de una ventana de gracia
+func (fmc *FrenchMethodCalculator) calculateGraduatedInstallment(principal, periodicRate float64, periods int, stepUp float64, every int, offset int) float64 {
+	factor := 0.0
+	for j := 0; j < periods; j++ {
+		factor += graduatedStep(offset+j, stepUp, every) / math.Pow(1+periodicRate, float64(j+1))
+	}
+	return principal / factor
+}
+
+// reannuitize recalcula la cuota base al terminar una ventana de gracia para amortizar el saldo
+// (incluidos los intereses capitalizados) en los periodos que quedan
+func (fmc *FrenchMethodCalculator) reannuitize(
+	mortgage *entities.Mortgage,
+	balance, balloonPayment, periodicRate float64,
+	remaining, paid int,
+) float64 {
+	if mortgage.IsGraduated() {
+		return fmc.calculateGraduatedInstallment(
+			balance-balloonPayment/math.Pow(1+periodicRate, float64(remaining)),
+			periodicRate,
+			remaining,
+			mortgage.StepUpRate(),
+			mortgage.StepUpEveryPeriods(),
+			paid,
+		)
+	}
+	return fmc.calculateFixedInstallment(balance, balloonPayment, periodicRate, remaining)
+}
+
+// graceWindowAt devuelve la ventana de gracia que cubre el periodo, si la hay
+func graceWindowAt(windows []valueobjects.GraceWindow, period int) (valueobjects.GraceWindow, bool) {
+	for _, w := range windows {
+		if w.Covers(period) {
+			return w, true
+		}
+	}
+	return valueobjects.GraceWindow{}, false
+}
+
+// graduatedStep es el multiplicador de la cuota en el periodo j (desde 0) después de la gracia
+func graduatedStep(j int, stepUp float64, every int) float64 {
+	if stepUp <= 0 || every <= 0 {
+		return 1
+	}
+	return math.Pow(1+stepUp, float64(j/every))
+}
+
 // generatePaymentSchedule genera el cronograma completo de pagos
 func (fmc *FrenchMethodCalculator) generatePaymentSchedule(
 	mortgage *entities.Mortgage,
@@ -159,6 +279,7 @@ func (fmc *FrenchMethodCalculator) generatePaymentSchedule(
 	portes float64,
 	additionalCosts float64,
 	periodsPerYear float64,
+	balloonPayment float64,
 ) (*entities.PaymentSchedule, error) {
 	schedule := entities.NewPaymentSchedule()
 	balance := mortgage.PrincipalFinanced() // Saldo inicial (antes de gracia)
@@ -168,6 +289,15 @@ func (fmc *FrenchMethodCalculator) generatePaymentSchedule(
 		gracePeriods = mortgage.GracePeriodMonths()
 	}
 
+	stepUp := mortgage.StepUpRate()
+	windows := mortgage.GraceWindows()
+
+	// Periodos que amortizan: fuera de la gracia inicial y de las ventanas de gracia
+	amortizingPeriods := totalPeriods - gracePeriods - valueobjects.TotalGracePeriods(windows)
+	baseInstallment := mortgage.FixedInstallment()
+	paid := 0 // Cuotas que amortizan ya pagadas
+	afterWindow := false
+
 	for period := 1; period <= totalPeriods; period++ {
 		var item entities.PaymentScheduleItem
 		item.Period = period
@@ -175,8 +305,14 @@ func (fmc *FrenchMethodCalculator) generatePaymentSchedule(
 		item.PeriodicRateApplied = periodicRate
 		item.GraceType = mortgage.GracePeriodType().String()
 
-		// Determinar si es periodo de gracia
+		// Determinar si es periodo de gracia (inicial o negociada a mitad del crédito)
+		graceType := mortgage.GracePeriodType()
 		isGracePeriod := gracePeriods > 0 && period <= gracePeriods
+		if window, ok := graceWindowAt(windows, period); ok {
+			isGracePeriod = true
+			graceType = window.Type()
+			item.GraceType = graceType.String()
+		}
 		item.IsGracePeriod = isGracePeriod
 
 		// Calcular interés del periodo: I_k = saldo * i
@@ -185,7 +321,7 @@ func (fmc *FrenchMethodCalculator) generatePaymentSchedule(
 
 		if isGracePeriod {
 			// Periodo de gracia
-			switch mortgage.GracePeriodType() {
+			switch graceType {
 			case valueobjects.GracePeriodTotal:
 				// Gracia total: no se paga ni interés ni capital
 				item.Installment = 0
@@ -203,11 +339,28 @@ func (fmc *FrenchMethodCalculator) generatePaymentSchedule(
 				item.Amortization = item.Installment - interest
 				balance -= item.Amortization
 			}
+			afterWindow = period > gracePeriods
 		} else {
-			// Periodo normal (después de gracia)
-			item.Installment = mortgage.FixedInstallment()
-			// Amortización: C_k = A - I_k
+			// Al terminar una ventana de gracia la cuota se recalcula sobre el saldo y los periodos restantes
+			if afterWindow {
+				baseInstallment = fmc.reannuitize(mortgage, balance, balloonPayment, periodicRate, amortizingPeriods-paid, paid)
+				afterWindow = false
+			}
+
+			// Periodo normal (después de gracia); en la modalidad escalonada la cuota crece cada N periodos
+			item.Installment = baseInstallment
+			if mortgage.IsGraduated() {
+				item.Installment *= graduatedStep(paid, stepUp, mortgage.StepUpEveryPeriods())
+			}
+			paid++
+			// La última cuota incluye la cuota balón, que cancela el saldo diferido
+			if period == totalPeriods && balloonPayment > 0 {
+				item.BalloonPayment = balloonPayment
+				item.Installment += balloonPayment
+			}
+			// Amortización: C_k = A - I_k (negativa si la cuota no cubre el interés)
 			item.Amortization = item.Installment - interest
+			item.NegativeAmortization = item.Amortization < 0
 			// Nuevo saldo: Saldo_k = Saldo_{k-1} - C_k
 			balance -= item.Amortization
 		}
@@ -237,7 +390,8 @@ func (fmc *FrenchMethodCalculator) generatePaymentSchedule(
 	return schedule, nil
 }
 
-// CalculateNPV calcula el Valor Actual Neto (VAN) considerando todos los cargos
+// CalculateNPV calcula el Valor Actual Neto (VAN) considerando todos los cargos y registra la tasa de
+// descuento en los parámetros del cálculo
 // VAN = suma de [CF_k / (1 + j)^k] donde j es la tasa de descuento
 func (fmc *FrenchMethodCalculator) CalculateNPV(mortgage *entities.Mortgage, discountRate float64) (float64, error) {
 	if mortgage.PaymentSchedule() == nil {
@@ -263,6 +417,10 @@ func (fmc *FrenchMethodCalculator) CalculateNPV(mortgage *entities.Mortgage, dis
 		npv += cf / math.Pow(1+periodicDiscountRate, float64(idx))
 	}
 
+	params := mortgage.CalculationParameters()
+	params.DiscountRate = discountRate
+	mortgage.SetCalculation(mortgage.EngineVersion(), params)
+
 	return npv, nil
 }
 
@@ -304,6 +462,10 @@ func (fmc *FrenchMethodCalculator) buildCashFlows(mortgage *entities.Mortgage, i
 		if includeCharges {
 			payment = item.TotalInstallment
 		}
+		// Una cuota vencida no se pagó: su saldo pasó al tramo reprogramado
+		if item.Overdue {
+			payment = 0
+		}
 		flows = append(flows, -payment)
 	}
 
@@ -352,13 +514,6 @@ func (fmc *FrenchMethodCalculator) irrFromFlows(flows []float64, guess float64)
 	return irr, errors.New("IRR did not converge")
 }
 
-func normalizeRate(rate float64) float64 {
-	if rate > 1 {
-		return rate / 100
-	}
-	return rate
-}
-
 // CalculateTCEA calcula la Tasa de Costo Efectivo Anual ajustada a la frecuencia configurada.
 func (fmc *FrenchMethodCalculator) CalculateTCEA(irr float64, periodsPerYear float64) float64 {
 	if periodsPerYear <= 0 {
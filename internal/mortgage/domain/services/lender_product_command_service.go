@@ -0,0 +1,12 @@
+package services
+
+import (
+	"context"
+	"finanzas-backend/internal/mortgage/domain/model/commands"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+)
+
+type LenderProductCommandService interface {
+	HandleSaveProduct(ctx context.Context, cmd *commands.SaveLenderProductCommand) (*entities.LenderProduct, error)
+	HandleDeactivateProduct(ctx context.Context, cmd *commands.DeactivateLenderProductCommand) error
+}
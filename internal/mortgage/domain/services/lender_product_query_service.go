@@ -0,0 +1,12 @@
+package services
+
+import (
+	"context"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/model/queries"
+)
+
+type LenderProductQueryService interface {
+	HandleGetByID(ctx context.Context, query *queries.GetLenderProductByIDQuery) (*entities.LenderProduct, error)
+	HandleList(ctx context.Context, query *queries.ListLenderProductsQuery) ([]*entities.LenderProduct, error)
+}
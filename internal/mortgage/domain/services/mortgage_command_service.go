@@ -10,4 +10,5 @@ type MortgageCommandService interface {
 	HandleCalculateMortgage(ctx context.Context, cmd *commands.CalculateMortgageCommand) (*entities.Mortgage, error)
 	HandleUpdateMortgage(ctx context.Context, cmd *commands.UpdateMortgageCommand) (*entities.Mortgage, error)
 	HandleDeleteMortgage(ctx context.Context, cmd *commands.DeleteMortgageCommand) error
+	HandleReprogramMortgage(ctx context.Context, cmd *commands.ReprogramMortgageCommand) (*entities.MortgageReprogramming, error)
 }
@@ -0,0 +1,13 @@
+package services
+
+import (
+	"context"
+	"finanzas-backend/internal/mortgage/domain/model/commands"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+)
+
+type MortgagePaymentCommandService interface {
+	HandleRegisterDisbursement(ctx context.Context, cmd *commands.RegisterDisbursementCommand) (*entities.Mortgage, error)
+	HandleRecordPayment(ctx context.Context, cmd *commands.RecordPaymentCommand) (*entities.MortgagePayment, error)
+	HandleDeletePayment(ctx context.Context, cmd *commands.DeletePaymentCommand) error
+}
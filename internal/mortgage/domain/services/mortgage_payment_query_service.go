@@ -0,0 +1,12 @@
+package services
+
+import (
+	"context"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/model/queries"
+)
+
+type MortgagePaymentQueryService interface {
+	HandleListPayments(ctx context.Context, query *queries.ListMortgagePaymentsQuery) ([]*entities.MortgagePayment, error)
+	HandleGetStatement(ctx context.Context, query *queries.GetMortgageStatementQuery) (*entities.MortgageStatement, error)
+}
@@ -9,4 +9,11 @@ import (
 type MortgageQueryService interface {
 	HandleGetByID(ctx context.Context, query *queries.GetMortgageByIDQuery) (*entities.Mortgage, error)
 	HandleGetHistory(ctx context.Context, query *queries.GetMortgageHistoryQuery) ([]*entities.Mortgage, error)
+	HandleAnalyzeRefinance(ctx context.Context, query *queries.AnalyzeRefinanceQuery) (*entities.RefinanceAnalysis, error)
+	HandleGetReprogramming(ctx context.Context, query *queries.GetMortgageReprogrammingQuery) (*entities.MortgageReprogramming, error)
+	HandleGetPayoffQuote(ctx context.Context, query *queries.GetPayoffQuoteQuery) (*entities.PayoffQuote, error)
+	HandleListVersions(ctx context.Context, query *queries.ListMortgageVersionsQuery) ([]*entities.MortgageVersion, error)
+	HandleGetVersion(ctx context.Context, query *queries.GetMortgageVersionQuery) (*entities.MortgageVersion, error)
+	HandleCompareVersions(ctx context.Context, query *queries.CompareMortgageVersionsQuery) (*entities.MortgageVersionDiff, error)
+	HandleRecompute(ctx context.Context, query *queries.RecomputeMortgageQuery) (*entities.MortgageRecomputation, error)
 }
@@ -0,0 +1,11 @@
+package services
+
+import (
+	"context"
+	"finanzas-backend/internal/mortgage/domain/model/commands"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+)
+
+type MortgageQuoteService interface {
+	HandleQuote(ctx context.Context, cmd *commands.QuoteMortgageCommand) (*entities.MortgageQuote, error)
+}
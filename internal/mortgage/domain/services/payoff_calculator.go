@@ -0,0 +1,178 @@
+package services
+
+import (
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"math"
+	"time"
+)
+
+// PayoffCalculator cotiza la cancelación total de un crédito guardado a una fecha o a un periodo del
+// cronograma: capital pendiente, interés corrido, seguros del periodo en curso y penalidad por prepago
+type PayoffCalculator struct {
+	statements *StatementCalculator
+}
+
+func NewPayoffCalculator(statements *StatementCalculator) *PayoffCalculator {
+	return &PayoffCalculator{statements: statements}
+}
+
+// QuoteAtDate cotiza a una fecha sobre el estado de cuenta: aplica los pagos registrados, de modo que
+// suma las cuotas vencidas impagas con su interés moratorio y descuenta el saldo a favor
+func (pc *PayoffCalculator) QuoteAtDate(
+	mortgage *entities.Mortgage,
+	payments []*entities.MortgagePayment,
+	date time.Time,
+) (*entities.PayoffQuote, error) {
+	if !mortgage.IsDisbursed() {
+		return nil, errors.New("mortgage has not been disbursed")
+	}
+	items, err := payableItems(mortgage)
+	if err != nil {
+		return nil, err
+	}
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	if date.Before(mortgage.DisbursementDate()) {
+		return nil, errors.New("payoff date is before the disbursement date")
+	}
+
+	statement, err := pc.statements.Build(mortgage, payments, date)
+	if err != nil {
+		return nil, err
+	}
+	if statement.PayoffBalance < paymentTolerance {
+		return nil, errors.New("mortgage is fully paid at the payoff date")
+	}
+
+	q := &entities.PayoffQuote{
+		MortgageID:       mortgage.ID().Value(),
+		Currency:         mortgage.Currency(),
+		QuoteDate:        date,
+		PrincipalBalance: statement.PrincipalBalance,
+		AccruedInterest:  statement.AccruedInterest,
+		PenaltyRate:      mortgage.PrepaymentPenaltyRate(),
+
+		OverdueInstallments: statement.OverdueInstallments,
+		OverdueInterest:     statement.DueUnpaid,
+		LateInterest:        statement.LateInterest,
+		CreditBalance:       statement.CreditBalance,
+	}
+	for _, installment := range statement.Installments {
+		if installment.Status == entities.InstallmentPaid {
+			q.InstallmentsPaid++
+		}
+	}
+
+	// Periodo en curso: el de la primera cuota que vence después de la fecha
+	current := 0
+	for current < len(items) && !mortgage.DueDate(items[current].Period).After(date) {
+		current++
+	}
+	if current < len(items) {
+		item := items[current]
+		q.LastDueDate = mortgage.DueDate(item.Period - 1)
+		if length := daysBetween(q.LastDueDate, mortgage.DueDate(item.Period)); length > 0 {
+			fraction := math.Min(daysBetween(q.LastDueDate, date)/length, 1)
+			q.LifeInsurance = item.LifeInsurance * fraction
+			q.PropertyInsurance = item.PropertyInsurance * fraction
+		}
+	} else {
+		q.LastDueDate = mortgage.DueDate(items[len(items)-1].Period)
+	}
+	q.DaysElapsed = int(math.Round(daysBetween(q.LastDueDate, date)))
+
+	q.PrepaymentPenalty = q.PrincipalBalance * mortgage.PrepaymentPenaltyRate()
+	q.Total = math.Max(q.PrincipalBalance+q.OverdueInterest+q.LateInterest+q.AccruedInterest+
+		q.LifeInsurance+q.PropertyInsurance+q.PrepaymentPenalty-q.CreditBalance, 0)
+
+	return q, nil
+}
+
+// QuoteAtPeriod cotiza después de pagar la cuota period, days días dentro del periodo siguiente
+func (pc *PayoffCalculator) QuoteAtPeriod(mortgage *entities.Mortgage, period, days int) (*entities.PayoffQuote, error) {
+	items, err := payableItems(mortgage)
+	if err != nil {
+		return nil, err
+	}
+	if days < 0 || days >= mortgage.PaymentFrequencyDays() {
+		return nil, errors.New("days must be within the payment period")
+	}
+
+	idx := 0
+	for idx < len(items) && items[idx].Period <= period {
+		idx++
+	}
+	if idx == len(items) {
+		return nil, errors.New("mortgage is fully paid at the payoff date")
+	}
+
+	var date, lastDue time.Time
+	if mortgage.IsDisbursed() {
+		lastDue = mortgage.DueDate(items[idx].Period - 1)
+		date = lastDue.AddDate(0, 0, days)
+	}
+	return pc.quote(mortgage, items[idx], date, lastDue, days)
+}
+
+// quote arma el desglose con current como la cuota en curso, suponiendo pagadas las anteriores
+func (pc *PayoffCalculator) quote(
+	mortgage *entities.Mortgage,
+	current entities.PaymentScheduleItem,
+	date, lastDue time.Time,
+	days int,
+) (*entities.PayoffQuote, error) {
+	frequencyDays := float64(mortgage.PaymentFrequencyDays())
+	if frequencyDays <= 0 {
+		frequencyDays = 30
+	}
+	fraction := math.Min(float64(days)/frequencyDays, 1)
+
+	principal := current.RemainingBalance + current.Installment - current.Interest
+	q := &entities.PayoffQuote{
+		MortgageID:        mortgage.ID().Value(),
+		Currency:          mortgage.Currency(),
+		QuoteDate:         date,
+		InstallmentsPaid:  current.Period - 1,
+		LastDueDate:       lastDue,
+		DaysElapsed:       days,
+		PrincipalBalance:  principal,
+		AccruedInterest:   accruedInterest(principal, current.PeriodicRateApplied, float64(days), frequencyDays, float64(mortgage.DaysInYear())),
+		LifeInsurance:     current.LifeInsurance * fraction,
+		PropertyInsurance: current.PropertyInsurance * fraction,
+		PenaltyRate:       mortgage.PrepaymentPenaltyRate(),
+	}
+	q.PrepaymentPenalty = principal * mortgage.PrepaymentPenaltyRate()
+	q.Total = q.PrincipalBalance + q.AccruedInterest + q.LifeInsurance + q.PropertyInsurance + q.PrepaymentPenalty
+
+	return q, nil
+}
+
+// payableItems son las cuotas del cronograma sin las vencidas que se trasladaron a una reprogramación
+func payableItems(mortgage *entities.Mortgage) ([]entities.PaymentScheduleItem, error) {
+	if mortgage.PaymentSchedule() == nil || len(mortgage.PaymentSchedule().GetItems()) == 0 {
+		return nil, errors.New("payment schedule not calculated")
+	}
+	items := make([]entities.PaymentScheduleItem, 0, len(mortgage.PaymentSchedule().GetItems()))
+	for _, item := range mortgage.PaymentSchedule().GetItems() {
+		if !item.Overdue {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// accruedInterest es el interés corrido de balance durante days días: la tasa del periodo se lleva a su
+// TEA equivalente y se prorratea por días sobre los días del año del crédito
+func accruedInterest(balance, periodicRate, days, frequencyDays, daysInYear float64) float64 {
+	if balance <= 0 || days <= 0 {
+		return 0
+	}
+	if frequencyDays <= 0 {
+		frequencyDays = 30
+	}
+	if daysInYear <= 0 {
+		daysInYear = 360
+	}
+	annualRate := math.Pow(1+periodicRate, daysInYear/frequencyDays) - 1
+	return balance * (math.Pow(1+annualRate, days/daysInYear) - 1)
+}
@@ -0,0 +1,11 @@
+package services
+
+import (
+	"context"
+	"finanzas-backend/internal/mortgage/domain/model/commands"
+)
+
+type PriceIndexCommandService interface {
+	// HandleImport retorna cuántos valores se registraron
+	HandleImport(ctx context.Context, cmd *commands.ImportPriceIndexCommand) (int, error)
+}
@@ -0,0 +1,11 @@
+package services
+
+import (
+	"context"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/model/queries"
+)
+
+type PriceIndexQueryService interface {
+	HandleList(ctx context.Context, query *queries.ListPriceIndexQuery) ([]*entities.PriceIndex, error)
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+)
+
+// Recomputer recalcula una simulación guardada con el motor vigente a partir de sus mismos datos de
+// entrada (incluidos el VAC base, el co-prestatario y el ingreso familiar guardados) y la compara con el
+// resultado guardado, para auditar el impacto de un cambio de fórmulas
+type Recomputer struct {
+	calculator *FrenchMethodCalculator
+	comparator *VersionComparator
+}
+
+func NewRecomputer(calculator *FrenchMethodCalculator) *Recomputer {
+	return &Recomputer{calculator: calculator, comparator: NewVersionComparator()}
+}
+
+func (r *Recomputer) Recompute(saved *entities.Mortgage) (*entities.MortgageRecomputation, error) {
+	// Recalcular desde cero perdería las cuotas históricas que conserva la reprogramación
+	if saved.IsReprogrammed() {
+		return nil, errors.New("reprogrammed mortgages cannot be recalculated")
+	}
+
+	recomputed, err := entities.NewMortgage(
+		saved.UserID(),
+		saved.PropertyPrice(),
+		saved.DownPayment(),
+		saved.LoanAmount(),
+		saved.BonoTechoPropio(),
+		saved.InterestRate(),
+		saved.RateType(),
+		saved.TermMonths(),
+		saved.TermYears(),
+		saved.GracePeriodMonths(),
+		saved.GracePeriodType(),
+		saved.Currency(),
+		saved.AdministrationFee(),
+		saved.Portes(),
+		saved.AdditionalCosts(),
+		saved.LifeInsuranceRate(),
+		saved.PropertyInsuranceRate(),
+		saved.EvaluationFee(),
+		saved.DisbursementFee(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	recomputed.SetID(saved.ID())
+	recomputed.SetPaymentFrequencyDays(saved.PaymentFrequencyDays())
+	recomputed.SetDaysInYear(saved.DaysInYear())
+	recomputed.SetProductID(saved.ProductID())
+	recomputed.SetGraduatedPayment(saved.StepUpRate(), saved.StepUpEveryPeriods())
+	recomputed.SetBalloonRate(saved.BalloonRate())
+	recomputed.SetGraceWindows(saved.GraceWindows())
+	recomputed.SetPrepaymentPenaltyRate(saved.PrepaymentPenaltyRate())
+	recomputed.SetIndexation(saved.IndexBase(), saved.IndexDate(), saved.InflationRate())
+	recomputed.SetCoBorrower(saved.CoBorrowerID(), saved.InsuredParties())
+	recomputed.SetHouseholdIncome(saved.HouseholdIncome())
+	recomputed.SetExistingDebtPayments(saved.ExistingDebtPayments())
+	recomputed.SetDisbursement(saved.DisbursementDate(), saved.LateInterestRate())
+
+	if err := r.calculator.Calculate(recomputed); err != nil {
+		return nil, err
+	}
+
+	// Sin COK registrado (simulaciones anteriores al registro del motor) se conserva el VAN guardado
+	if discountRate := saved.CalculationParameters().DiscountRate; discountRate > 0 {
+		npv, err := r.calculator.CalculateNPV(recomputed, discountRate)
+		if err != nil {
+			return nil, err
+		}
+		recomputed.SetNPV(npv)
+	} else {
+		recomputed.SetNPV(saved.NPV())
+	}
+
+	irr, err := r.calculator.CalculateIRR(recomputed)
+	if err != nil {
+		return nil, err
+	}
+	recomputed.SetIRR(irr)
+
+	flowIRR, err := r.calculator.CalculateFlowIRR(recomputed)
+	if err != nil {
+		return nil, err
+	}
+	recomputed.SetFlowIRR(flowIRR)
+	recomputed.SetTCEA(r.calculator.CalculateTCEA(flowIRR, recomputed.PeriodsPerYear()))
+
+	return &entities.MortgageRecomputation{
+		Saved:      saved,
+		Recomputed: recomputed,
+		Changes:    r.comparator.CompareMortgages(saved, recomputed),
+	}, nil
+}
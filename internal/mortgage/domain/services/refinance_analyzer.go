@@ -0,0 +1,141 @@
+package services
+
+import (
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+	"math"
+)
+
+// RefinanceAnalyzer evalúa la compra de deuda de un crédito calculado con el método francés
+type RefinanceAnalyzer struct {
+	calculator *FrenchMethodCalculator
+}
+
+func NewRefinanceAnalyzer(calculator *FrenchMethodCalculator) *RefinanceAnalyzer {
+	return &RefinanceAnalyzer{calculator: calculator}
+}
+
+// RefinanceTerms son las condiciones del nuevo crédito
+type RefinanceTerms struct {
+	InterestRate      float64
+	RateType          valueobjects.RateType
+	TermPeriods       int // 0 mantiene las cuotas que faltan
+	LifeInsuranceRate float64
+	PropertyInsurance float64
+	EvaluationFee     float64
+	DisbursementFee   float64
+	Portes            float64
+	AdministrationFee float64
+	TransferCosts     float64
+}
+
+// Analyze toma el saldo del cronograma del crédito actual después de refinancePeriod cuotas, arma el nuevo
+// cronograma con la misma frecuencia y compara los flujos periodo a periodo
+func (a *RefinanceAnalyzer) Analyze(
+	current *entities.Mortgage,
+	refinancePeriod int,
+	terms RefinanceTerms,
+	discountRate float64,
+) (*entities.RefinanceAnalysis, error) {
+	if current.PaymentSchedule() == nil || len(current.PaymentSchedule().GetItems()) == 0 {
+		return nil, errors.New("payment schedule not calculated")
+	}
+	items := current.PaymentSchedule().GetItems()
+	if refinancePeriod >= len(items) {
+		return nil, errors.New("refinance period must be before the last installment")
+	}
+
+	// Con gracia total el saldo ya incluye los intereses capitalizados hasta ese periodo
+	balance := items[refinancePeriod-1].RemainingBalance
+	if balance <= 0 {
+		return nil, errors.New("mortgage has no outstanding balance at the refinance period")
+	}
+
+	termPeriods := terms.TermPeriods
+	if termPeriods == 0 {
+		termPeriods = len(items) - refinancePeriod
+	}
+
+	refinanced, err := entities.NewMortgage(
+		current.UserID(),
+		current.PropertyPrice(),
+		current.PropertyPrice()-balance,
+		balance,
+		0,
+		terms.InterestRate,
+		terms.RateType,
+		termPeriods,
+		0,
+		0,
+		valueobjects.GracePeriodNone,
+		current.Currency(),
+		terms.AdministrationFee,
+		terms.Portes,
+		current.AdditionalCosts(),
+		terms.LifeInsuranceRate,
+		terms.PropertyInsurance,
+		terms.EvaluationFee,
+		terms.DisbursementFee,
+	)
+	if err != nil {
+		return nil, err
+	}
+	refinanced.SetPaymentFrequencyDays(current.PaymentFrequencyDays())
+	refinanced.SetDaysInYear(current.DaysInYear())
+	refinanced.SetIndexation(current.IndexBase(), current.IndexDate(), current.InflationRate())
+	refinanced.SetCoBorrower(current.CoBorrowerID(), current.InsuredParties())
+	refinanced.SetHouseholdIncome(current.HouseholdIncome())
+	refinanced.SetExistingDebtPayments(current.ExistingDebtPayments())
+
+	if err := a.calculator.Calculate(refinanced); err != nil {
+		return nil, err
+	}
+	flowIRR, err := a.calculator.CalculateFlowIRR(refinanced)
+	if err != nil {
+		return nil, err
+	}
+	refinanced.SetFlowIRR(flowIRR)
+	refinanced.SetTCEA(a.calculator.CalculateTCEA(flowIRR, refinanced.PeriodsPerYear()))
+
+	periodicDiscount, err := a.calculator.convertToPeriodicRate(discountRate, valueobjects.RateTypeEffective, current.PeriodsPerYear())
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := items[refinancePeriod:]
+	newItems := refinanced.PaymentSchedule().GetItems()
+	switchingCosts := terms.EvaluationFee + terms.DisbursementFee + terms.TransferCosts
+
+	analysis := &entities.RefinanceAnalysis{
+		Current:          current,
+		Refinanced:       refinanced,
+		RefinancePeriod:  refinancePeriod,
+		RemainingBalance: balance,
+		SwitchingCosts:   switchingCosts,
+		NPV:              -switchingCosts,
+	}
+
+	// Ahorro del periodo t: cuota que se deja de pagar en el crédito actual menos la cuota del nuevo
+	cumulative := -switchingCosts
+	periods := int(math.Max(float64(len(remaining)), float64(len(newItems))))
+	for t := 1; t <= periods; t++ {
+		saving := 0.0
+		if t <= len(remaining) {
+			saving += remaining[t-1].TotalInstallment
+			analysis.RemainingPaid += remaining[t-1].TotalInstallment
+		}
+		if t <= len(newItems) {
+			saving -= newItems[t-1].TotalInstallment
+		}
+
+		analysis.NPV += saving / math.Pow(1+periodicDiscount, float64(t))
+		cumulative += saving
+		if analysis.BreakEvenPeriod == 0 && cumulative >= 0 && saving > 0 {
+			analysis.BreakEvenPeriod = t
+		}
+	}
+	analysis.TotalSavings = analysis.RemainingPaid - refinanced.TotalPaidWithFees() - switchingCosts
+
+	return analysis, nil
+}
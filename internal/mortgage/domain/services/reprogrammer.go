@@ -0,0 +1,192 @@
+package services
+
+import (
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+	"math"
+)
+
+// Reprogrammer reprograma un crédito calculado con el método francés: conserva las cuotas pagadas
+// y arma un nuevo tramo con el saldo pendiente y las nuevas condiciones
+type Reprogrammer struct {
+	calculator *FrenchMethodCalculator
+}
+
+func NewReprogrammer(calculator *FrenchMethodCalculator) *Reprogrammer {
+	return &Reprogrammer{calculator: calculator}
+}
+
+// ReprogrammingTerms son las nuevas condiciones pactadas con la entidad
+type ReprogrammingTerms struct {
+	ExtensionPeriods  int                   // Cuotas que se agregan al plazo
+	InterestRate      float64               // 0 mantiene la tasa del crédito
+	RateType          valueobjects.RateType // Tipo de la nueva tasa
+	OverduePeriods    int                   // Cuotas vencidas (impagas) antes de la reprogramación
+	CapitalizeOverdue bool                  // Sumar los intereses vencidos al saldo
+}
+
+// Reprogram conserva las filas 1..period del cronograma original y genera la nueva cola. Las cuotas
+// vencidas no amortizaron: su capital vuelve al saldo y su interés se capitaliza o se cobra en la primera
+// cuota reprogramada.
+func (r *Reprogrammer) Reprogram(
+	original *entities.Mortgage,
+	period int,
+	terms ReprogrammingTerms,
+) (*entities.Mortgage, error) {
+	if original.IsReprogrammed() {
+		return nil, errors.New("mortgage is already a reprogramming")
+	}
+	if original.PaymentSchedule() == nil || len(original.PaymentSchedule().GetItems()) == 0 {
+		return nil, errors.New("payment schedule not calculated")
+	}
+	items := original.PaymentSchedule().GetItems()
+	if period >= len(items) {
+		return nil, errors.New("reprogramming period must be before the last installment")
+	}
+	if terms.OverduePeriods > period {
+		return nil, errors.New("overdue installments cannot exceed the reprogramming period")
+	}
+
+	balance := items[period-1].RemainingBalance
+	overdueInterest := 0.0
+	for _, item := range items[period-terms.OverduePeriods : period] {
+		balance += item.Amortization
+		overdueInterest += item.Installment - item.Amortization
+	}
+	if balance <= 0 {
+		return nil, errors.New("mortgage has no outstanding balance at the reprogramming period")
+	}
+	if terms.CapitalizeOverdue {
+		balance += overdueInterest
+	}
+
+	interestRate, rateType := original.InterestRate(), original.RateType()
+	if terms.InterestRate > 0 {
+		interestRate, rateType = terms.InterestRate, terms.RateType
+	}
+	tailPeriods := len(items) - period + terms.ExtensionPeriods
+
+	// Nuevo tramo: el saldo pendiente a la nueva tasa y plazo, sin gracia ni comisiones de desembolso
+	tail, err := entities.NewMortgage(
+		original.UserID(),
+		original.PropertyPrice(),
+		original.PropertyPrice()-balance,
+		balance,
+		0,
+		interestRate,
+		rateType,
+		tailPeriods,
+		0,
+		0,
+		valueobjects.GracePeriodNone,
+		original.Currency(),
+		original.AdministrationFee(),
+		original.Portes(),
+		original.AdditionalCosts(),
+		original.LifeInsuranceRate(),
+		original.PropertyInsuranceRate(),
+		0,
+		0,
+	)
+	if err != nil {
+		return nil, err
+	}
+	tail.SetPaymentFrequencyDays(original.PaymentFrequencyDays())
+	tail.SetDaysInYear(original.DaysInYear())
+	tail.SetIndexation(original.IndexBase(), original.IndexDate(), original.InflationRate())
+	tail.SetCoBorrower(original.CoBorrowerID(), original.InsuredParties())
+	if err := r.calculator.Calculate(tail); err != nil {
+		return nil, err
+	}
+
+	// La versión reprogramada conserva los datos del crédito original y las condiciones nuevas
+	reprogrammed, err := entities.NewMortgage(
+		original.UserID(),
+		original.PropertyPrice(),
+		original.DownPayment(),
+		original.LoanAmount(),
+		original.BonoTechoPropio(),
+		interestRate,
+		rateType,
+		period+tailPeriods,
+		0,
+		original.GracePeriodMonths(),
+		original.GracePeriodType(),
+		original.Currency(),
+		original.AdministrationFee(),
+		original.Portes(),
+		original.AdditionalCosts(),
+		original.LifeInsuranceRate(),
+		original.PropertyInsuranceRate(),
+		original.EvaluationFee(),
+		original.DisbursementFee(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	reprogrammed.SetPaymentFrequencyDays(original.PaymentFrequencyDays())
+	reprogrammed.SetDaysInYear(original.DaysInYear())
+	reprogrammed.SetProductID(original.ProductID())
+	reprogrammed.SetIndexation(original.IndexBase(), original.IndexDate(), original.InflationRate())
+	reprogrammed.SetCoBorrower(original.CoBorrowerID(), original.InsuredParties())
+	reprogrammed.SetHouseholdIncome(original.HouseholdIncome())
+	reprogrammed.SetExistingDebtPayments(original.ExistingDebtPayments())
+	reprogrammed.SetPrepaymentPenaltyRate(original.PrepaymentPenaltyRate())
+	reprogrammed.SetReprogramming(original.ID().Value(), period, overdueInterest, terms.CapitalizeOverdue)
+
+	// Las ventanas de gracia que ya transcurrieron siguen siendo parte del historial
+	windows := make([]valueobjects.GraceWindow, 0, len(original.GraceWindows()))
+	for _, w := range original.GraceWindows() {
+		if w.EndPeriod() <= period {
+			windows = append(windows, w)
+		}
+	}
+	reprogrammed.SetGraceWindows(windows)
+
+	reprogrammed.SetPrincipalFinanced(original.PrincipalFinanced())
+	reprogrammed.SetPeriodicRate(tail.PeriodicRate())
+	reprogrammed.SetFixedInstallment(tail.FixedInstallment())
+
+	// Filas históricas sin cambios (las vencidas quedan marcadas), seguidas de la cola renumerada desde period+1
+	periodsPerYear := original.PeriodsPerYear()
+	schedule := entities.NewPaymentSchedule()
+	historicalGrace := 0
+	for idx, item := range items[:period] {
+		item.Overdue = idx >= period-terms.OverduePeriods
+		if item.IsGracePeriod {
+			historicalGrace++
+		}
+		schedule.AddItem(item)
+	}
+	for idx, item := range tail.PaymentSchedule().GetItems() {
+		item.Period += period
+		item.YearNumber = int(math.Ceil(float64(item.Period) / periodsPerYear))
+		if idx == 0 && !terms.CapitalizeOverdue && overdueInterest > 0 {
+			item.AdditionalCosts += overdueInterest
+			item.TotalInstallment += overdueInterest
+		}
+		schedule.AddItem(item)
+	}
+	if reprogrammed.Currency().IsIndexed() {
+		if err := r.calculator.projectIndexedSchedule(reprogrammed, schedule, periodsPerYear); err != nil {
+			return nil, err
+		}
+	}
+	reprogrammed.SetPaymentSchedule(schedule)
+
+	// Los parámetros son los del nuevo tramo, con el plazo y la gracia de todo el crédito
+	params := tail.CalculationParameters()
+	params.TotalPeriods = period + tailPeriods
+	params.GracePeriods += historicalGrace
+	reprogrammed.SetCalculation(tail.EngineVersion(), params)
+
+	reprogrammed.SetTotalInterestPaid(schedule.TotalInterestPaid())
+	reprogrammed.SetTotalPaid(schedule.TotalPaid())
+	reprogrammed.SetTotalPaidWithFees(schedule.TotalPaidWithCharges())
+	reprogrammed.SetTotalCharges(schedule.TotalCharges())
+	reprogrammed.SetTotalInsurance(schedule.TotalInsurance())
+	reprogrammed.SetTotalAdmin(schedule.TotalAdminFees())
+
+	return reprogrammed, nil
+}
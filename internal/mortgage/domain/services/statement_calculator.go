@@ -0,0 +1,208 @@
+package services
+
+import (
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"math"
+	"sort"
+	"time"
+)
+
+// paymentTolerance es el saldo (medio céntimo) por debajo del cual una cuota se considera cancelada
+const paymentTolerance = 0.005
+
+// StatementCalculator aplica los pagos reales al cronograma de un crédito desembolsado. Cada pago se
+// aplica a las cuotas vencidas más antiguas, en orden: interés moratorio, cargos (seguros, comisiones,
+// gastos), interés y capital. Lo que sobra queda como saldo a favor y se aplica a cada cuota al vencer.
+type StatementCalculator struct{}
+
+func NewStatementCalculator() *StatementCalculator {
+	return &StatementCalculator{}
+}
+
+// installmentLedger lleva lo pendiente y lo pagado de una cuota mientras se aplican los pagos
+type installmentLedger struct {
+	item      entities.PaymentScheduleItem
+	dueDate   time.Time
+	accruedTo time.Time // Hasta cuándo se devengó el interés moratorio
+
+	late, charges, interest, principal                 float64 // Pendiente
+	lateTotal                                          float64 // Moratorio devengado
+	latePaid, chargesPaid, interestPaid, principalPaid float64
+}
+
+// apply aplica amount a la cuota y retorna lo que sobra
+func (l *installmentLedger) apply(amount float64) float64 {
+	pay := func(pending, paid *float64) {
+		applied := math.Min(amount, *pending)
+		*pending -= applied
+		*paid += applied
+		amount -= applied
+	}
+	pay(&l.late, &l.latePaid)
+	pay(&l.charges, &l.chargesPaid)
+	pay(&l.interest, &l.interestPaid)
+	pay(&l.principal, &l.principalPaid)
+	return amount
+}
+
+func (l *installmentLedger) outstanding() float64 {
+	return l.late + l.charges + l.interest + l.principal
+}
+
+// Build arma el estado de cuenta a la fecha asOf; los pagos posteriores a esa fecha no se consideran
+func (sc *StatementCalculator) Build(
+	mortgage *entities.Mortgage,
+	payments []*entities.MortgagePayment,
+	asOf time.Time,
+) (*entities.MortgageStatement, error) {
+	if !mortgage.IsDisbursed() {
+		return nil, errors.New("mortgage has not been disbursed")
+	}
+	if mortgage.PaymentSchedule() == nil || len(mortgage.PaymentSchedule().GetItems()) == 0 {
+		return nil, errors.New("payment schedule not calculated")
+	}
+	asOf = time.Date(asOf.Year(), asOf.Month(), asOf.Day(), 0, 0, 0, 0, time.UTC)
+	if asOf.Before(mortgage.DisbursementDate()) {
+		return nil, errors.New("statement date is before the disbursement date")
+	}
+
+	lateRate := mortgage.LateInterestRate()
+	daysInYear := float64(mortgage.DaysInYear())
+	if daysInYear <= 0 {
+		daysInYear = 360
+	}
+
+	// Las cuotas vencidas que se trasladaron a una reprogramación ya no se cobran
+	ledgers := make([]*installmentLedger, 0, len(mortgage.PaymentSchedule().GetItems()))
+	for _, item := range mortgage.PaymentSchedule().GetItems() {
+		if item.Overdue {
+			continue
+		}
+		principal := math.Max(item.Amortization, 0)
+		ledgers = append(ledgers, &installmentLedger{
+			item:      item,
+			dueDate:   mortgage.DueDate(item.Period),
+			charges:   item.TotalInstallment - item.Installment,
+			interest:  math.Max(item.Installment-principal, 0),
+			principal: principal,
+		})
+	}
+
+	sorted := make([]*entities.MortgagePayment, len(payments))
+	copy(sorted, payments)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].PaymentDate().Before(sorted[j].PaymentDate()) })
+
+	credit, paidToDate := 0.0, 0.0
+	due := 0 // Cuotas vencidas (ledgers[:due])
+
+	// accrue devenga el interés moratorio de las cuotas vencidas sobre su cuota base impaga
+	accrue := func(until time.Time) {
+		for _, l := range ledgers[:due] {
+			if base := l.interest + l.principal; base > 0 && lateRate > 0 && until.After(l.accruedTo) {
+				late := base * (math.Pow(1+lateRate, daysBetween(l.accruedTo, until)/daysInYear) - 1)
+				l.late += late
+				l.lateTotal += late
+			}
+			l.accruedTo = until
+		}
+	}
+	applyCredit := func() {
+		for _, l := range ledgers[:due] {
+			if credit <= 0 {
+				return
+			}
+			credit = l.apply(credit)
+		}
+	}
+	// advance hace vencer las cuotas hasta until y les aplica el saldo a favor
+	advance := func(until time.Time) {
+		for due < len(ledgers) && !ledgers[due].dueDate.After(until) {
+			accrue(ledgers[due].dueDate)
+			ledgers[due].accruedTo = ledgers[due].dueDate
+			due++
+			applyCredit()
+		}
+		accrue(until)
+	}
+
+	for _, payment := range sorted {
+		if payment.PaymentDate().After(asOf) {
+			break
+		}
+		advance(payment.PaymentDate())
+		credit += payment.Amount()
+		paidToDate += payment.Amount()
+		applyCredit()
+	}
+	advance(asOf)
+
+	statement := &entities.MortgageStatement{
+		MortgageID:    mortgage.ID().Value(),
+		AsOf:          asOf,
+		PaidToDate:    paidToDate,
+		CreditBalance: credit,
+		Installments:  make([]entities.InstallmentStatement, 0, len(ledgers)),
+	}
+
+	dueUnpaid := 0.0
+	for i, l := range ledgers {
+		installment := entities.InstallmentStatement{
+			Period:        l.item.Period,
+			DueDate:       l.dueDate,
+			AmountDue:     l.item.TotalInstallment,
+			ChargesPaid:   l.chargesPaid,
+			InterestPaid:  l.interestPaid,
+			PrincipalPaid: l.principalPaid,
+			LateInterest:  l.lateTotal,
+			LatePaid:      l.latePaid,
+			Outstanding:   l.outstanding(),
+			Status:        entities.InstallmentPending,
+		}
+		if installment.Outstanding < paymentTolerance {
+			installment.Outstanding = 0
+		}
+
+		switch {
+		case i < due && installment.Outstanding == 0:
+			installment.Status = entities.InstallmentPaid
+		case i < due && l.dueDate.Before(asOf):
+			installment.Status = entities.InstallmentOverdue
+			installment.DaysPastDue = int(daysBetween(l.dueDate, asOf))
+			statement.OverdueInstallments++
+			statement.OverdueAmount += installment.Outstanding
+		}
+		if statement.NextDuePeriod == 0 && installment.Status == entities.InstallmentPending {
+			statement.NextDuePeriod = l.item.Period
+			statement.NextDueDate = l.dueDate
+			statement.NextDueAmount = math.Max(installment.Outstanding-credit, 0)
+		}
+
+		statement.LateInterest += l.late
+		if i < due {
+			statement.PrincipalBalance += l.principal
+			statement.DueUnpaid += l.charges + l.interest
+			dueUnpaid += l.late + l.charges + l.interest
+		}
+		statement.Installments = append(statement.Installments, installment)
+	}
+
+	// Capital de las cuotas que aún no vencen e interés corrido del periodo en curso
+	if due < len(ledgers) {
+		current := ledgers[due]
+		statement.PrincipalBalance += current.item.RemainingBalance + current.item.Installment - current.item.Interest
+
+		periodStart := mortgage.DueDate(current.item.Period - 1)
+		elapsed := math.Max(daysBetween(periodStart, asOf), 0)
+		balance := current.item.RemainingBalance + current.item.Installment - current.item.Interest
+		statement.AccruedInterest = accruedInterest(balance, current.item.PeriodicRateApplied, elapsed,
+			float64(mortgage.PaymentFrequencyDays()), daysInYear)
+	}
+	statement.PayoffBalance = math.Max(statement.PrincipalBalance+dueUnpaid+statement.AccruedInterest-credit, 0)
+
+	return statement, nil
+}
+
+func daysBetween(from, to time.Time) float64 {
+	return to.Sub(from).Hours() / 24
+}
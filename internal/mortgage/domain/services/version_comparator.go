@@ -0,0 +1,147 @@
+package services
+
+import (
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// VersionComparator compara dos versiones guardadas de la misma simulación: datos de entrada, parámetros
+// efectivos del motor, resultados y las cuotas del cronograma que cambiaron. Los campos usan los nombres
+// del recurso REST.
+type VersionComparator struct{}
+
+func NewVersionComparator() *VersionComparator {
+	return &VersionComparator{}
+}
+
+func (vc *VersionComparator) Compare(from, to *entities.MortgageVersion) (*entities.MortgageVersionDiff, error) {
+	if from.MortgageID != to.MortgageID {
+		return nil, errors.New("versions belong to different mortgages")
+	}
+	diff := vc.CompareMortgages(from.Mortgage, to.Mortgage)
+	diff.From, diff.To = from, to
+	return diff, nil
+}
+
+// CompareMortgages compara dos cálculos de la misma simulación, guardados o no
+func (vc *VersionComparator) CompareMortgages(before, after *entities.Mortgage) *entities.MortgageVersionDiff {
+	diff := &entities.MortgageVersionDiff{
+		MortgageID: before.ID().Value(),
+		Inputs:     make([]entities.VersionChange, 0),
+		Parameters: make([]entities.VersionChange, 0),
+		Outputs:    make([]entities.VersionChange, 0),
+		Schedule:   make([]entities.ScheduleChange, 0),
+	}
+
+	number := func(changes *[]entities.VersionChange, field string, b, a float64) {
+		if math.Abs(a-b) >= 1e-9 {
+			*changes = append(*changes, entities.VersionChange{Field: field, Before: b, After: a, Delta: a - b})
+		}
+	}
+	text := func(changes *[]entities.VersionChange, field string, b, a string) {
+		if a != b {
+			*changes = append(*changes, entities.VersionChange{Field: field, Before: b, After: a})
+		}
+	}
+
+	in := &diff.Inputs
+	number(in, "precio_venta", before.PropertyPrice(), after.PropertyPrice())
+	number(in, "cuota_inicial", before.DownPayment(), after.DownPayment())
+	number(in, "monto_prestamo", before.LoanAmount(), after.LoanAmount())
+	number(in, "bono_techo_propio", before.BonoTechoPropio(), after.BonoTechoPropio())
+	number(in, "tasa_anual", before.InterestRate(), after.InterestRate())
+	text(in, "tipo_tasa", before.RateType().String(), after.RateType().String())
+	number(in, "plazo_meses", float64(before.TermMonths()), float64(after.TermMonths()))
+	number(in, "meses_gracia", float64(before.GracePeriodMonths()), float64(after.GracePeriodMonths()))
+	text(in, "tipo_gracia", before.GracePeriodType().String(), after.GracePeriodType().String())
+	text(in, "moneda", before.Currency().String(), after.Currency().String())
+	number(in, "frecuencia_pago", float64(before.PaymentFrequencyDays()), float64(after.PaymentFrequencyDays()))
+	number(in, "dias_anio", float64(before.DaysInYear()), float64(after.DaysInYear()))
+	number(in, "portes", before.Portes(), after.Portes())
+	number(in, "gastos_administrativos", before.AdministrationFee(), after.AdministrationFee())
+	number(in, "seguro_desgravamen", before.LifeInsuranceRate(), after.LifeInsuranceRate())
+	number(in, "seguro_inmueble_anual", before.PropertyInsuranceRate(), after.PropertyInsuranceRate())
+	number(in, "comision_evaluacion", before.EvaluationFee(), after.EvaluationFee())
+	number(in, "comision_desembolso", before.DisbursementFee(), after.DisbursementFee())
+	number(in, "costos_mensuales_adicionales", before.AdditionalCosts(), after.AdditionalCosts())
+	text(in, "co_prestatario_id", before.CoBorrowerID(), after.CoBorrowerID())
+	number(in, "producto_id", float64(before.ProductID()), float64(after.ProductID()))
+	number(in, "inflacion_anual", before.InflationRate(), after.InflationRate())
+	number(in, "incremento_cuota", before.StepUpRate(), after.StepUpRate())
+	number(in, "incremento_cada", float64(before.StepUpEveryPeriods()), float64(after.StepUpEveryPeriods()))
+	number(in, "tasa_cuota_balon", before.BalloonRate(), after.BalloonRate())
+	text(in, "ventanas_gracia", describeGraceWindows(before), describeGraceWindows(after))
+	number(in, "tasa_penalidad_prepago", before.PrepaymentPenaltyRate(), after.PrepaymentPenaltyRate())
+	number(in, "ingreso_familiar", before.HouseholdIncome(), after.HouseholdIncome())
+	number(in, "deudas_mensuales", before.ExistingDebtPayments(), after.ExistingDebtPayments())
+
+	pb, pa := before.CalculationParameters(), after.CalculationParameters()
+	params := &diff.Parameters
+	text(params, "version_motor", before.EngineVersion(), after.EngineVersion())
+	number(params, "cuotas_por_anio", pb.PeriodsPerYear, pa.PeriodsPerYear)
+	number(params, "numero_cuotas", float64(pb.TotalPeriods), float64(pa.TotalPeriods))
+	number(params, "periodos_gracia", float64(pb.GracePeriods), float64(pa.GracePeriods))
+	number(params, "tasa_anual_decimal", pb.AnnualRate, pa.AnnualRate)
+	number(params, "tea", pb.EffectiveAnnualRate, pa.EffectiveAnnualRate)
+	number(params, "tasa_periodo", before.PeriodicRate(), after.PeriodicRate())
+	number(params, "seguro_desgravamen_periodo", pb.LifeInsuranceRate, pa.LifeInsuranceRate)
+	number(params, "seguro_inmueble_anual_decimal", pb.PropertyInsuranceRate, pa.PropertyInsuranceRate)
+	number(params, "seguro_inmueble_periodo", pb.PropertyInsurancePerPeriod, pa.PropertyInsurancePerPeriod)
+	number(params, "cuota_balon_decimal", pb.BalloonRate, pa.BalloonRate)
+	number(params, "incremento_cuota_decimal", pb.StepUpRate, pa.StepUpRate)
+	number(params, "inflacion_anual_decimal", pb.InflationRate, pa.InflationRate)
+	number(params, "tasa_descuento", pb.DiscountRate, pa.DiscountRate)
+
+	out := &diff.Outputs
+	number(out, "saldo_financiar", before.PrincipalFinanced(), after.PrincipalFinanced())
+	number(out, "cuota_fija", before.FixedInstallment(), after.FixedInstallment())
+	number(out, "total_intereses", before.TotalInterestPaid(), after.TotalInterestPaid())
+	number(out, "total_pagado", before.TotalPaid(), after.TotalPaid())
+	number(out, "total_pagado_con_cargos", before.TotalPaidWithFees(), after.TotalPaidWithFees())
+	number(out, "total_cargos", before.TotalCharges(), after.TotalCharges())
+	number(out, "total_seguros", before.TotalInsurance(), after.TotalInsurance())
+	number(out, "total_gastos", before.TotalAdmin(), after.TotalAdmin())
+	number(out, "van", before.NPV(), after.NPV())
+	number(out, "tir", before.IRR(), after.IRR())
+	number(out, "tcea", before.TCEA(), after.TCEA())
+
+	itemsBefore, itemsAfter := scheduleItems(before), scheduleItems(after)
+	diff.PeriodsBefore, diff.PeriodsAfter = len(itemsBefore), len(itemsAfter)
+	for i := 0; i < max(len(itemsBefore), len(itemsAfter)); i++ {
+		change := entities.ScheduleChange{Period: i + 1}
+		if i < len(itemsBefore) {
+			change.InstallmentBefore = itemsBefore[i].TotalInstallment
+			change.BalanceBefore = itemsBefore[i].RemainingBalance
+		}
+		if i < len(itemsAfter) {
+			change.InstallmentAfter = itemsAfter[i].TotalInstallment
+			change.BalanceAfter = itemsAfter[i].RemainingBalance
+		}
+		if i >= len(itemsBefore) || i >= len(itemsAfter) ||
+			math.Abs(change.InstallmentAfter-change.InstallmentBefore) >= paymentTolerance ||
+			math.Abs(change.BalanceAfter-change.BalanceBefore) >= paymentTolerance {
+			diff.Schedule = append(diff.Schedule, change)
+		}
+	}
+
+	return diff
+}
+
+func scheduleItems(mortgage *entities.Mortgage) []entities.PaymentScheduleItem {
+	if mortgage.PaymentSchedule() == nil {
+		return nil
+	}
+	return mortgage.PaymentSchedule().GetItems()
+}
+
+// describeGraceWindows resume las ventanas de gracia como "inicio+periodos TIPO"
+func describeGraceWindows(mortgage *entities.Mortgage) string {
+	parts := make([]string, 0, len(mortgage.GraceWindows()))
+	for _, w := range mortgage.GraceWindows() {
+		parts = append(parts, fmt.Sprintf("%d+%d %s", w.StartPeriod(), w.Periods(), w.Type().String()))
+	}
+	return strings.Join(parts, ", ")
+}
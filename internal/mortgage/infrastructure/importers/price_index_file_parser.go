@@ -0,0 +1,103 @@
+package importers
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"finanzas-backend/internal/mortgage/domain/model/commands"
+)
+
+// ParsePriceIndexFile lee un CSV de índices con columnas fecha, VAC e IPC (opcional):
+// "2024-01-31,9.1234,112.45" o, con separador punto y coma y coma decimal, "31/01/2024;9,1234;112,45".
+// Se omiten los encabezados y los días sin dato (n.d.).
+func ParsePriceIndexFile(r io.Reader) ([]commands.PriceIndexEntry, error) {
+	scanner := bufio.NewScanner(r)
+	entries := make([]commands.PriceIndexEntry, 0)
+	lineNumber := 0
+
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(strings.TrimPrefix(scanner.Text(), "\ufeff"))
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := splitFields(line)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %d: expected date and VAC columns", lineNumber)
+		}
+
+		date, err := parseDate(fields[0])
+		if err != nil {
+			if len(entries) == 0 {
+				continue // Encabezado
+			}
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+
+		if isMissing(fields[1]) {
+			continue
+		}
+		vac, err := parseNumber(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid VAC value: %w", lineNumber, err)
+		}
+
+		cpi := 0.0
+		if len(fields) > 2 && !isMissing(fields[2]) {
+			cpi, err = parseNumber(fields[2])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid CPI value: %w", lineNumber, err)
+			}
+		}
+
+		entries = append(entries, commands.PriceIndexEntry{Date: date, VAC: vac, CPI: cpi})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, errors.New("file has no index values")
+	}
+	return entries, nil
+}
+
+// splitFields separa por punto y coma, tabulador o coma (en ese orden de preferencia)
+func splitFields(line string) []string {
+	separator := ","
+	if strings.Contains(line, ";") {
+		separator = ";"
+	} else if strings.Contains(line, "\t") {
+		separator = "\t"
+	}
+
+	fields := strings.Split(line, separator)
+	for i, field := range fields {
+		fields[i] = strings.Trim(strings.TrimSpace(field), `"`)
+	}
+	return fields
+}
+
+func parseDate(value string) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02", "02/01/2006"} {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
+
+// parseNumber acepta punto o coma decimal
+func parseNumber(value string) (float64, error) {
+	return strconv.ParseFloat(strings.Replace(value, ",", ".", 1), 64)
+}
+
+func isMissing(value string) bool {
+	value = strings.ToLower(value)
+	return value == "" || value == "n.d." || value == "nd" || value == "-"
+}
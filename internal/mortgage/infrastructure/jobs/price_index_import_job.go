@@ -0,0 +1,82 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"finanzas-backend/internal/mortgage/domain/model/commands"
+	"finanzas-backend/internal/mortgage/domain/services"
+	"finanzas-backend/internal/mortgage/infrastructure/importers"
+)
+
+// PriceIndexImportJob importa periódicamente el archivo de índices VAC/IPC; solo se relee si cambió
+type PriceIndexImportJob struct {
+	commandService services.PriceIndexCommandService
+	path           string
+	interval       time.Duration
+	imported       time.Time
+}
+
+func NewPriceIndexImportJob(commandService services.PriceIndexCommandService, path string, interval time.Duration) *PriceIndexImportJob {
+	return &PriceIndexImportJob{
+		commandService: commandService,
+		path:           path,
+		interval:       interval,
+	}
+}
+
+// Start importa al iniciar y luego cada intervalo, hasta que ctx se cancele
+func (j *PriceIndexImportJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.run(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *PriceIndexImportJob) run(ctx context.Context) {
+	info, err := os.Stat(j.path)
+	if err != nil {
+		log.Printf("Price index import: cannot read %s: %v", j.path, err)
+		return
+	}
+	if !j.imported.IsZero() && !info.ModTime().After(j.imported) {
+		return
+	}
+
+	imported, err := j.importFile(ctx)
+	if err != nil {
+		log.Printf("Price index import of %s failed: %v", j.path, err)
+		return
+	}
+	j.imported = info.ModTime()
+	log.Printf("Price index import: %d value(s) loaded from %s", imported, j.path)
+}
+
+func (j *PriceIndexImportJob) importFile(ctx context.Context) (int, error) {
+	f, err := os.Open(j.path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	entries, err := importers.ParsePriceIndexFile(f)
+	if err != nil {
+		return 0, err
+	}
+
+	cmd, err := commands.NewImportPriceIndexCommand(entries)
+	if err != nil {
+		return 0, err
+	}
+	return j.commandService.HandleImport(ctx, cmd)
+}
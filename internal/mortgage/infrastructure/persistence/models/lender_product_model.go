@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+// LenderProductModel es un producto del catálogo de entidades financieras
+type LenderProductModel struct {
+	ID                uint64  `gorm:"primaryKey;autoIncrement"`
+	Bank              string  `gorm:"type:varchar(100);not null;index"`
+	Name              string  `gorm:"type:varchar(150);not null"`
+	Currency          string  `gorm:"type:varchar(3);not null;index"`
+	LifeInsuranceRate float64 `gorm:"not null;default:0"`
+	PropertyInsurance float64 `gorm:"not null;default:0"`
+	EvaluationFee     float64 `gorm:"not null;default:0"`
+	DisbursementFee   float64 `gorm:"not null;default:0"`
+	Portes            float64 `gorm:"not null;default:0"`
+	AdministrationFee float64 `gorm:"not null;default:0"`
+	Active            bool    `gorm:"not null"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+
+	// Unidad de los seguros: DECIMAL; vacío solo en productos anteriores aún no migrados (MigrateLegacyRateUnits)
+	RateUnit string `gorm:"type:varchar(10);not null;default:''"`
+
+	// Tramos de TEA por plazo y cuota inicial
+	RateTiers []LenderProductRateTierModel `gorm:"foreignKey:ProductID;constraint:OnDelete:CASCADE"`
+}
+
+func (LenderProductModel) TableName() string {
+	return "lender_products"
+}
+
+// LenderProductRateTierModel es un tramo de TEA publicado para un producto
+type LenderProductRateTierModel struct {
+	ID             uint64  `gorm:"primaryKey;autoIncrement"`
+	ProductID      uint64  `gorm:"not null;index"`
+	MinTermMonths  int     `gorm:"not null"`
+	MaxTermMonths  int     `gorm:"not null"`
+	MinDownPayment float64 `gorm:"not null"` // % del precio de la vivienda
+	MaxDownPayment float64 `gorm:"not null"`
+	MinRate        float64 `gorm:"not null"` // TEA en %
+	MaxRate        float64 `gorm:"not null"`
+}
+
+func (LenderProductRateTierModel) TableName() string {
+	return "lender_product_rate_tiers"
+}
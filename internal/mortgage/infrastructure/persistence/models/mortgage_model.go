@@ -31,6 +31,47 @@ type MortgageModel struct {
 	EvaluationFee        float64   `gorm:"default:0"`
 	DisbursementFee      float64   `gorm:"default:0"`
 
+	// Crédito mancomunado
+	CoBorrowerID    *uuid.UUID `gorm:"type:uuid;index"` // Co-prestatario en el contexto Profile
+	InsuredParties  int        `gorm:"not null;default:1"`
+	HouseholdIncome float64    `gorm:"default:0"`
+	ExistingDebts   float64    `gorm:"default:0"` // Cuotas de otras deudas del titular
+
+	// Producto del catálogo de entidades financieras
+	ProductID *uint64 `gorm:"index"`
+
+	// Soles VAC
+	IndexBase     float64    `gorm:"default:0"`
+	IndexDate     *time.Time `gorm:"type:date"`
+	InflationRate float64    `gorm:"default:0"`
+
+	// Cuotas escalonadas
+	StepUpRate  float64 `gorm:"default:0"`
+	StepUpEvery int     `gorm:"default:0"`
+
+	// Cuota balón: porcentaje del principal diferido a la última cuota
+	BalloonRate float64 `gorm:"default:0"`
+
+	// Reprogramación: simulación original y última cuota que se conserva de ella
+	ReprogrammedFrom    *uint64 `gorm:"index"`
+	ReprogrammingPeriod int     `gorm:"default:0"`
+	OverdueInterest     float64 `gorm:"default:0"`
+	OverdueCapitalized  bool    `gorm:"default:false"`
+
+	// Penalidad por prepago (fracción del capital cancelado anticipadamente)
+	PrepaymentPenaltyRate float64 `gorm:"default:0"`
+
+	// Unidad de las tasas: DECIMAL; vacío solo en simulaciones anteriores aún no migradas (MigrateLegacyRateUnits)
+	RateUnit string `gorm:"type:varchar(10);not null;default:''"`
+
+	// Seguimiento de pagos reales
+	DisbursementDate *time.Time `gorm:"type:date"`
+	LateInterestRate float64    `gorm:"default:0"` // TEA moratoria
+
+	// Versión del motor de cálculo y parámetros efectivos (JSON de entities.CalculationParameters)
+	EngineVersion         string `gorm:"type:varchar(20);default:''"`
+	CalculationParameters string `gorm:"type:jsonb;not null;default:'{}'"`
+
 	// Resultados calculados
 	PrincipalFinanced float64 `gorm:"not null"`
 	PeriodicRate      float64 `gorm:"not null"`
@@ -51,8 +92,30 @@ type MortgageModel struct {
 
 	// Relación con los items del cronograma
 	PaymentScheduleItems []PaymentScheduleItemModel `gorm:"foreignKey:MortgageID;constraint:OnDelete:CASCADE"`
+
+	// Periodos de gracia negociados a mitad del crédito
+	GraceWindows []MortgageGraceWindowModel `gorm:"foreignKey:MortgageID;constraint:OnDelete:CASCADE"`
+
+	// Pagos reales registrados después del desembolso
+	Payments []MortgagePaymentModel `gorm:"foreignKey:MortgageID;constraint:OnDelete:CASCADE"`
+
+	// Versiones inmutables de cada cálculo
+	Versions []MortgageVersionModel `gorm:"foreignKey:MortgageID;constraint:OnDelete:CASCADE"`
 }
 
 func (MortgageModel) TableName() string {
 	return "mortgages"
 }
+
+// MortgageGraceWindowModel es un periodo de gracia negociado a mitad del crédito
+type MortgageGraceWindowModel struct {
+	ID          uint64 `gorm:"primaryKey;autoIncrement"`
+	MortgageID  uint64 `gorm:"not null;index"`
+	StartPeriod int    `gorm:"not null"`
+	Periods     int    `gorm:"not null"`
+	GraceType   string `gorm:"type:varchar(20);not null"` // TOTAL o PARTIAL
+}
+
+func (MortgageGraceWindowModel) TableName() string {
+	return "mortgage_grace_windows"
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// MortgagePaymentModel es un pago real registrado sobre un crédito desembolsado
+type MortgagePaymentModel struct {
+	ID          uint64    `gorm:"primaryKey;autoIncrement"`
+	MortgageID  uint64    `gorm:"not null;index"`
+	PaymentDate time.Time `gorm:"type:date;not null"`
+	Amount      float64   `gorm:"not null"`
+	Reference   string    `gorm:"type:varchar(100);default:''"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+func (MortgagePaymentModel) TableName() string {
+	return "mortgage_payments"
+}
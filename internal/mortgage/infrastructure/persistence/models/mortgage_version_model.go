@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// MortgageVersionModel es una versión inmutable de una simulación: el MortgageModel con su cronograma y
+// ventanas de gracia serializado como JSON al momento de guardarla
+type MortgageVersionModel struct {
+	ID         uint64    `gorm:"primaryKey;autoIncrement"`
+	MortgageID uint64    `gorm:"not null;uniqueIndex:idx_mortgage_version"`
+	Version    int       `gorm:"not null;uniqueIndex:idx_mortgage_version"`
+	Snapshot   string    `gorm:"type:jsonb;not null"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+func (MortgageVersionModel) TableName() string {
+	return "mortgage_versions"
+}
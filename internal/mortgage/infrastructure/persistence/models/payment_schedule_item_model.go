@@ -4,24 +4,32 @@ import "github.com/google/uuid"
 
 // PaymentScheduleItemModel representa un item del cronograma de pagos en la BD
 type PaymentScheduleItemModel struct {
-	ID                uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	MortgageID        uint64    `gorm:"not null;index:idx_mortgage_period"`
-	UserID            uuid.UUID `gorm:"type:uuid;not null;index"`
-	Period            int       `gorm:"not null;index:idx_mortgage_period"`
-	YearNumber        int       `gorm:"not null;default:1"`
-	PeriodicRate      float64   `gorm:"not null;default:0"`
-	Installment       float64   `gorm:"not null"`
-	TotalInstallment  float64   `gorm:"not null"`
-	Interest          float64   `gorm:"not null"`
-	Amortization      float64   `gorm:"not null"`
-	Administration    float64   `gorm:"not null;default:0"`
-	Portes            float64   `gorm:"not null;default:0"`
-	LifeInsurance     float64   `gorm:"not null;default:0"`
-	PropertyInsurance float64   `gorm:"not null;default:0"`
-	AdditionalCosts   float64   `gorm:"not null;default:0"`
-	RemainingBalance  float64   `gorm:"not null"`
-	IsGracePeriod     bool      `gorm:"default:false"`
-	GraceType         string    `gorm:"type:varchar(20);default:''"`
+	ID                   uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	MortgageID           uint64    `gorm:"not null;index:idx_mortgage_period"`
+	UserID               uuid.UUID `gorm:"type:uuid;not null;index"`
+	Period               int       `gorm:"not null;index:idx_mortgage_period"`
+	YearNumber           int       `gorm:"not null;default:1"`
+	PeriodicRate         float64   `gorm:"not null;default:0"`
+	Installment          float64   `gorm:"not null"`
+	TotalInstallment     float64   `gorm:"not null"`
+	Interest             float64   `gorm:"not null"`
+	Amortization         float64   `gorm:"not null"`
+	Administration       float64   `gorm:"not null;default:0"`
+	Portes               float64   `gorm:"not null;default:0"`
+	LifeInsurance        float64   `gorm:"not null;default:0"`
+	PropertyInsurance    float64   `gorm:"not null;default:0"`
+	AdditionalCosts      float64   `gorm:"not null;default:0"`
+	RemainingBalance     float64   `gorm:"not null"`
+	IsGracePeriod        bool      `gorm:"default:false"`
+	GraceType            string    `gorm:"type:varchar(20);default:''"`
+	NegativeAmortization bool      `gorm:"default:false"`
+	BalloonPayment       float64   `gorm:"default:0"`
+	Overdue              bool      `gorm:"default:false"`
+
+	// Proyección en soles de los créditos en Soles VAC
+	ProjectedIndex          float64 `gorm:"default:0"`
+	NominalTotalInstallment float64 `gorm:"default:0"`
+	NominalBalance          float64 `gorm:"default:0"`
 }
 
 func (PaymentScheduleItemModel) TableName() string {
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// PriceIndexModel es un valor diario del VAC/IPC en la BD
+type PriceIndexModel struct {
+	ID        uint64    `gorm:"primaryKey;autoIncrement"`
+	Date      time.Time `gorm:"type:date;not null;uniqueIndex"`
+	VAC       float64   `gorm:"type:decimal(14,6);not null;column:vac"`
+	CPI       float64   `gorm:"type:decimal(14,6);column:cpi"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+func (PriceIndexModel) TableName() string {
+	return "price_index_values"
+}
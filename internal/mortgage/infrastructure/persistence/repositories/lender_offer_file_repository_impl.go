@@ -0,0 +1,128 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+	"finanzas-backend/internal/mortgage/domain/repositories"
+)
+
+// lenderOfferRecord es una oferta en el archivo JSON mantenido por los administradores
+type lenderOfferRecord struct {
+	Entidad        string  `json:"entidad"`
+	Nombre         string  `json:"nombre"`
+	Moneda         string  `json:"moneda"`
+	TasaAnual      float64 `json:"tasa_anual"`
+	TipoTasa       string  `json:"tipo_tasa"`
+	SeguroDesg     float64 `json:"seguro_desgravamen"`
+	SeguroInmueble float64 `json:"seguro_inmueble_anual"`
+	ComisionEval   float64 `json:"comision_evaluacion"`
+	ComisionDesem  float64 `json:"comision_desembolso"`
+	Portes         float64 `json:"portes"`
+	GastosAdm      float64 `json:"gastos_administrativos"`
+	MontoMin       float64 `json:"monto_min"`
+	MontoMax       float64 `json:"monto_max"`
+	PlazoMinMeses  int     `json:"plazo_min_meses"`
+	PlazoMaxMeses  int     `json:"plazo_max_meses"`
+	UnidadTasas    string  `json:"unidad_tasas"`
+}
+
+// LenderOfferFileRepositoryImpl lee las ofertas de un archivo JSON y solo lo vuelve a leer si cambió
+type LenderOfferFileRepositoryImpl struct {
+	path    string
+	mu      sync.Mutex
+	modTime time.Time
+	offers  []*entities.LenderOffer
+}
+
+func NewLenderOfferFileRepository(path string) repositories.LenderOfferRepository {
+	return &LenderOfferFileRepositoryImpl{path: path}
+}
+
+func (r *LenderOfferFileRepositoryImpl) FindAll(ctx context.Context) ([]*entities.LenderOffer, error) {
+	// Sin archivo configurado solo se cotizan ofertas enviadas en la solicitud
+	if r.path == "" {
+		return nil, nil
+	}
+
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read lender offers file: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.offers != nil && info.ModTime().Equal(r.modTime) {
+		return r.offers, nil
+	}
+
+	offers, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+	r.offers = offers
+	r.modTime = info.ModTime()
+	return offers, nil
+}
+
+func (r *LenderOfferFileRepositoryImpl) load() ([]*entities.LenderOffer, error) {
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read lender offers file: %w", err)
+	}
+
+	var records []lenderOfferRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("invalid lender offers file: %w", err)
+	}
+
+	offers := make([]*entities.LenderOffer, 0, len(records))
+	for i, record := range records {
+		offer, err := r.toDomain(record)
+		if err != nil {
+			return nil, fmt.Errorf("invalid lender offer #%d: %w", i+1, err)
+		}
+		offers = append(offers, offer)
+	}
+	return offers, nil
+}
+
+func (r *LenderOfferFileRepositoryImpl) toDomain(record lenderOfferRecord) (*entities.LenderOffer, error) {
+	currency, err := valueobjects.NewCurrency(record.Moneda)
+	if err != nil {
+		return nil, err
+	}
+	rateType, err := valueobjects.NewRateType(record.TipoTasa)
+	if err != nil {
+		return nil, err
+	}
+	rateUnit, err := valueobjects.NewRateUnit(record.UnidadTasas)
+	if err != nil {
+		return nil, err
+	}
+	return entities.NewLenderOffer(
+		record.Entidad,
+		record.Nombre,
+		currency,
+		record.TasaAnual,
+		rateType,
+		record.SeguroDesg,
+		record.SeguroInmueble,
+		record.ComisionEval,
+		record.ComisionDesem,
+		record.Portes,
+		record.GastosAdm,
+		record.MontoMin,
+		record.MontoMax,
+		record.PlazoMinMeses,
+		record.PlazoMaxMeses,
+		rateUnit,
+	)
+}
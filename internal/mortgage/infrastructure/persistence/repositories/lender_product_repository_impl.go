@@ -0,0 +1,190 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+	"finanzas-backend/internal/mortgage/domain/repositories"
+	"finanzas-backend/internal/mortgage/infrastructure/persistence/models"
+	"finanzas-backend/internal/shared/infrastructure/persistence"
+
+	"gorm.io/gorm"
+)
+
+type LenderProductRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewLenderProductRepository(db *gorm.DB) repositories.LenderProductRepository {
+	return &LenderProductRepositoryImpl{db: db}
+}
+
+func (r *LenderProductRepositoryImpl) Save(ctx context.Context, product *entities.LenderProduct) error {
+	model := r.toModel(product)
+	if err := persistence.Conn(ctx, r.db).Create(model).Error; err != nil {
+		return err
+	}
+
+	id, err := valueobjects.NewProductID(model.ID)
+	if err != nil {
+		return err
+	}
+	product.SetID(id)
+	return nil
+}
+
+func (r *LenderProductRepositoryImpl) Update(ctx context.Context, product *entities.LenderProduct) error {
+	return persistence.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		model := r.toModel(product)
+		result := tx.Model(&models.LenderProductModel{}).
+			Where("id = ?", product.ID().Value()).
+			Select("*").Omit("id", "created_at", "RateTiers").
+			Updates(model)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("lender product not found")
+		}
+
+		// Reemplazar los tramos de TEA
+		if err := tx.Where("product_id = ?", product.ID().Value()).
+			Delete(&models.LenderProductRateTierModel{}).Error; err != nil {
+			return err
+		}
+		if len(model.RateTiers) > 0 {
+			for i := range model.RateTiers {
+				model.RateTiers[i].ProductID = product.ID().Value()
+			}
+			return tx.Create(&model.RateTiers).Error
+		}
+		return nil
+	})
+}
+
+func (r *LenderProductRepositoryImpl) FindByID(ctx context.Context, id valueobjects.ProductID) (*entities.LenderProduct, error) {
+	var model models.LenderProductModel
+	result := persistence.Conn(ctx, r.db).
+		Preload("RateTiers", func(db *gorm.DB) *gorm.DB { return db.Order("min_term_months, min_down_payment") }).
+		First(&model, id.Value())
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errors.New("lender product not found")
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model)
+}
+
+func (r *LenderProductRepositoryImpl) FindAll(
+	ctx context.Context,
+	currency *valueobjects.Currency,
+	includeInactive bool,
+) ([]*entities.LenderProduct, error) {
+	query := persistence.Conn(ctx, r.db).
+		Preload("RateTiers", func(db *gorm.DB) *gorm.DB { return db.Order("min_term_months, min_down_payment") }).
+		Order("bank, name")
+	if currency != nil {
+		query = query.Where("currency = ?", currency.String())
+	}
+	if !includeInactive {
+		query = query.Where("active = ?", true)
+	}
+
+	var rows []models.LenderProductModel
+	if err := query.Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	products := make([]*entities.LenderProduct, 0, len(rows))
+	for i := range rows {
+		product, err := r.toDomain(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, product)
+	}
+	return products, nil
+}
+
+func (r *LenderProductRepositoryImpl) toModel(product *entities.LenderProduct) *models.LenderProductModel {
+	tiers := make([]models.LenderProductRateTierModel, 0, len(product.RateTiers()))
+	for _, tier := range product.RateTiers() {
+		tiers = append(tiers, models.LenderProductRateTierModel{
+			ProductID:      product.ID().Value(),
+			MinTermMonths:  tier.MinTermMonths(),
+			MaxTermMonths:  tier.MaxTermMonths(),
+			MinDownPayment: tier.MinDownPayment(),
+			MaxDownPayment: tier.MaxDownPayment(),
+			MinRate:        tier.MinRate(),
+			MaxRate:        tier.MaxRate(),
+		})
+	}
+
+	return &models.LenderProductModel{
+		ID:                product.ID().Value(),
+		Bank:              product.Bank(),
+		Name:              product.Name(),
+		Currency:          product.Currency().String(),
+		LifeInsuranceRate: product.LifeInsuranceRate(),
+		PropertyInsurance: product.PropertyInsuranceRate(),
+		EvaluationFee:     product.EvaluationFee(),
+		DisbursementFee:   product.DisbursementFee(),
+		Portes:            product.Portes(),
+		AdministrationFee: product.AdministrationFee(),
+		Active:            product.IsActive(),
+		CreatedAt:         product.CreatedAt(),
+		UpdatedAt:         product.UpdatedAt(),
+		RateTiers:         tiers,
+
+		RateUnit: valueobjects.RateUnitDecimal.String(),
+	}
+}
+
+func (r *LenderProductRepositoryImpl) toDomain(model *models.LenderProductModel) (*entities.LenderProduct, error) {
+	id, err := valueobjects.NewProductID(model.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	currency, err := valueobjects.NewCurrency(model.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	tiers := make([]valueobjects.RateTier, 0, len(model.RateTiers))
+	for _, tierModel := range model.RateTiers {
+		tier, err := valueobjects.NewRateTier(
+			tierModel.MinTermMonths,
+			tierModel.MaxTermMonths,
+			tierModel.MinDownPayment,
+			tierModel.MaxDownPayment,
+			tierModel.MinRate,
+			tierModel.MaxRate,
+		)
+		if err != nil {
+			return nil, err
+		}
+		tiers = append(tiers, tier)
+	}
+
+	return entities.ReconstructLenderProduct(
+		id,
+		model.Bank,
+		model.Name,
+		currency,
+		tiers,
+		model.LifeInsuranceRate,
+		model.PropertyInsurance,
+		model.EvaluationFee,
+		model.DisbursementFee,
+		model.Portes,
+		model.AdministrationFee,
+		model.Active,
+		model.CreatedAt,
+		model.UpdatedAt,
+	), nil
+}
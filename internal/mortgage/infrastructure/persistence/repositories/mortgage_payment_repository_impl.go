@@ -0,0 +1,108 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+	"finanzas-backend/internal/mortgage/domain/repositories"
+	"finanzas-backend/internal/mortgage/infrastructure/persistence/models"
+	"finanzas-backend/internal/shared/infrastructure/persistence"
+
+	"gorm.io/gorm"
+)
+
+type MortgagePaymentRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewMortgagePaymentRepository(db *gorm.DB) repositories.MortgagePaymentRepository {
+	return &MortgagePaymentRepositoryImpl{db: db}
+}
+
+func (r *MortgagePaymentRepositoryImpl) Save(ctx context.Context, payment *entities.MortgagePayment) error {
+	model := r.toModel(payment)
+	if err := persistence.Conn(ctx, r.db).Create(model).Error; err != nil {
+		return err
+	}
+
+	id, err := valueobjects.NewPaymentID(model.ID)
+	if err != nil {
+		return err
+	}
+	payment.SetID(id)
+	return nil
+}
+
+func (r *MortgagePaymentRepositoryImpl) Delete(ctx context.Context, id valueobjects.PaymentID) error {
+	result := persistence.Conn(ctx, r.db).Delete(&models.MortgagePaymentModel{}, id.Value())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("payment not found")
+	}
+	return nil
+}
+
+func (r *MortgagePaymentRepositoryImpl) FindByID(ctx context.Context, id valueobjects.PaymentID) (*entities.MortgagePayment, error) {
+	var model models.MortgagePaymentModel
+	if err := persistence.Conn(ctx, r.db).First(&model, id.Value()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("payment not found")
+		}
+		return nil, err
+	}
+	return r.toDomain(&model)
+}
+
+func (r *MortgagePaymentRepositoryImpl) FindByMortgageID(ctx context.Context, mortgageID valueobjects.MortgageID) ([]*entities.MortgagePayment, error) {
+	var rows []models.MortgagePaymentModel
+	err := persistence.Conn(ctx, r.db).
+		Where("mortgage_id = ?", mortgageID.Value()).
+		Order("payment_date, id").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	payments := make([]*entities.MortgagePayment, 0, len(rows))
+	for i := range rows {
+		payment, err := r.toDomain(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, payment)
+	}
+	return payments, nil
+}
+
+func (r *MortgagePaymentRepositoryImpl) toModel(payment *entities.MortgagePayment) *models.MortgagePaymentModel {
+	return &models.MortgagePaymentModel{
+		ID:          payment.ID().Value(),
+		MortgageID:  payment.MortgageID().Value(),
+		PaymentDate: payment.PaymentDate(),
+		Amount:      payment.Amount(),
+		Reference:   payment.Reference(),
+		CreatedAt:   payment.CreatedAt(),
+	}
+}
+
+func (r *MortgagePaymentRepositoryImpl) toDomain(model *models.MortgagePaymentModel) (*entities.MortgagePayment, error) {
+	id, err := valueobjects.NewPaymentID(model.ID)
+	if err != nil {
+		return nil, err
+	}
+	mortgageID, err := valueobjects.NewMortgageID(model.MortgageID)
+	if err != nil {
+		return nil, err
+	}
+	return entities.ReconstructMortgagePayment(
+		id,
+		mortgageID,
+		model.PaymentDate,
+		model.Amount,
+		model.Reference,
+		model.CreatedAt,
+	), nil
+}
@@ -2,14 +2,18 @@ package repositories
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"finanzas-backend/internal/mortgage/domain/model/entities"
 	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
 	"finanzas-backend/internal/mortgage/domain/repositories"
 	"finanzas-backend/internal/mortgage/infrastructure/persistence/models"
+	"finanzas-backend/internal/shared/infrastructure/persistence"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type MortgageRepositoryImpl struct {
@@ -21,7 +25,7 @@ func NewMortgageRepository(db *gorm.DB) repositories.MortgageRepository {
 }
 
 func (r *MortgageRepositoryImpl) Save(ctx context.Context, mortgage *entities.Mortgage) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return persistence.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		// Guardar mortgage
 		mortgageModel := r.toModel(mortgage)
 		if err := tx.Create(mortgageModel).Error; err != nil {
@@ -36,8 +40,9 @@ func (r *MortgageRepositoryImpl) Save(ctx context.Context, mortgage *entities.Mo
 		mortgage.SetID(id)
 
 		// Guardar items del cronograma
+		var scheduleItems []models.PaymentScheduleItemModel
 		if mortgage.PaymentSchedule() != nil {
-			scheduleItems := r.toScheduleItemModels(mortgageModel.ID, mortgageModel.UserID, mortgage.PaymentSchedule())
+			scheduleItems = r.toScheduleItemModels(mortgageModel.ID, mortgageModel.UserID, mortgage.PaymentSchedule())
 			if len(scheduleItems) > 0 {
 				if err := tx.Create(&scheduleItems).Error; err != nil {
 					return err
@@ -45,14 +50,22 @@ func (r *MortgageRepositoryImpl) Save(ctx context.Context, mortgage *entities.Mo
 			}
 		}
 
-		return nil
+		if err := r.saveGraceWindows(tx, mortgageModel.ID, mortgage.GraceWindows()); err != nil {
+			return err
+		}
+
+		// El cálculo original es la versión 1
+		mortgageModel.PaymentScheduleItems = scheduleItems
+		mortgageModel.GraceWindows = r.toGraceWindowModels(mortgageModel.ID, mortgage.GraceWindows())
+		return r.saveVersion(tx, mortgageModel)
 	})
 }
 
 func (r *MortgageRepositoryImpl) FindByID(ctx context.Context, id valueobjects.MortgageID) (*entities.Mortgage, error) {
 	var model models.MortgageModel
-	result := r.db.WithContext(ctx).
+	result := persistence.Conn(ctx, r.db).
 		Preload("PaymentScheduleItems").
+		Preload("GraceWindows", orderGraceWindows).
 		First(&model, id.Value())
 
 	if result.Error != nil {
@@ -71,8 +84,9 @@ func (r *MortgageRepositoryImpl) FindByUserID(
 	limit, offset int,
 ) ([]*entities.Mortgage, error) {
 	var models []models.MortgageModel
-	result := r.db.WithContext(ctx).
+	result := persistence.Conn(ctx, r.db).
 		Preload("PaymentScheduleItems").
+		Preload("GraceWindows", orderGraceWindows).
 		Where("user_id = ?", userID.Value()).
 		Order("created_at DESC").
 		Limit(limit).
@@ -95,8 +109,18 @@ func (r *MortgageRepositoryImpl) FindByUserID(
 	return mortgages, nil
 }
 
+// FindAllByUserID retorna todas las simulaciones del usuario, sin paginar
+func (r *MortgageRepositoryImpl) FindAllByUserID(ctx context.Context, userID valueobjects.UserID) ([]*entities.Mortgage, error) {
+	return r.FindByUserID(ctx, userID, -1, -1)
+}
+
 func (r *MortgageRepositoryImpl) Update(ctx context.Context, mortgage *entities.Mortgage) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return persistence.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Las simulaciones guardadas antes de versionar conservan su cálculo anterior como versión 1
+		if err := r.ensureFirstVersion(tx, mortgage.ID().Value()); err != nil {
+			return err
+		}
+
 		// Actualizar mortgage
 		mortgageModel := r.toModel(mortgage)
 		result := tx.Model(&models.MortgageModel{}).
@@ -111,6 +135,27 @@ func (r *MortgageRepositoryImpl) Update(ctx context.Context, mortgage *entities.
 			return errors.New("mortgage not found")
 		}
 
+		// Updates omite los valores cero: el co-prestatario puede quitarse, el ingreso quedar en 0
+		// y un crédito que deja de estar en VAC, de ser escalonado o de tener cuota balón pierde esa configuración
+		if err := tx.Model(&models.MortgageModel{}).
+			Where("id = ?", mortgage.ID().Value()).
+			Updates(map[string]interface{}{
+				"co_borrower_id":   mortgageModel.CoBorrowerID,
+				"insured_parties":  mortgageModel.InsuredParties,
+				"household_income": mortgageModel.HouseholdIncome,
+				"existing_debts":   mortgageModel.ExistingDebts,
+				"index_base":       mortgageModel.IndexBase,
+				"index_date":       mortgageModel.IndexDate,
+				"inflation_rate":   mortgageModel.InflationRate,
+				"step_up_rate":     mortgageModel.StepUpRate,
+				"step_up_every":    mortgageModel.StepUpEvery,
+				"balloon_rate":     mortgageModel.BalloonRate,
+
+				"prepayment_penalty_rate": mortgageModel.PrepaymentPenaltyRate,
+			}).Error; err != nil {
+			return err
+		}
+
 		// Eliminar items antiguos del cronograma
 		if err := tx.Where("mortgage_id = ?", mortgage.ID().Value()).
 			Delete(&models.PaymentScheduleItemModel{}).Error; err != nil {
@@ -118,8 +163,9 @@ func (r *MortgageRepositoryImpl) Update(ctx context.Context, mortgage *entities.
 		}
 
 		// Guardar nuevos items del cronograma
+		var scheduleItems []models.PaymentScheduleItemModel
 		if mortgage.PaymentSchedule() != nil {
-			scheduleItems := r.toScheduleItemModels(mortgage.ID().Value(), mortgage.UserID().Value().String(), mortgage.PaymentSchedule())
+			scheduleItems = r.toScheduleItemModels(mortgage.ID().Value(), mortgage.UserID().Value().String(), mortgage.PaymentSchedule())
 			if len(scheduleItems) > 0 {
 				if err := tx.Create(&scheduleItems).Error; err != nil {
 					return err
@@ -127,13 +173,43 @@ func (r *MortgageRepositoryImpl) Update(ctx context.Context, mortgage *entities.
 			}
 		}
 
-		return nil
+		// Reemplazar las ventanas de gracia
+		if err := tx.Where("mortgage_id = ?", mortgage.ID().Value()).
+			Delete(&models.MortgageGraceWindowModel{}).Error; err != nil {
+			return err
+		}
+		if err := r.saveGraceWindows(tx, mortgage.ID().Value(), mortgage.GraceWindows()); err != nil {
+			return err
+		}
+
+		// Cada actualización agrega una versión; las anteriores no se modifican
+		mortgageModel.PaymentScheduleItems = scheduleItems
+		mortgageModel.GraceWindows = r.toGraceWindowModels(mortgage.ID().Value(), mortgage.GraceWindows())
+		return r.saveVersion(tx, mortgageModel)
 	})
 }
 
+// UpdateDisbursement guarda la fecha de desembolso y la tasa moratoria sin tocar el cronograma
+func (r *MortgageRepositoryImpl) UpdateDisbursement(ctx context.Context, mortgage *entities.Mortgage) error {
+	mortgageModel := r.toModel(mortgage)
+	result := persistence.Conn(ctx, r.db).Model(&models.MortgageModel{}).
+		Where("id = ?", mortgage.ID().Value()).
+		Updates(map[string]interface{}{
+			"disbursement_date":  mortgageModel.DisbursementDate,
+			"late_interest_rate": mortgageModel.LateInterestRate,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("mortgage not found")
+	}
+	return nil
+}
+
 func (r *MortgageRepositoryImpl) Delete(ctx context.Context, id valueobjects.MortgageID) error {
 	// El CASCADE en la FK eliminará automáticamente los items del cronograma
-	result := r.db.WithContext(ctx).Delete(&models.MortgageModel{}, id.Value())
+	result := persistence.Conn(ctx, r.db).Delete(&models.MortgageModel{}, id.Value())
 
 	if result.Error != nil {
 		return result.Error
@@ -146,7 +222,213 @@ func (r *MortgageRepositoryImpl) Delete(ctx context.Context, id valueobjects.Mor
 	return nil
 }
 
+// DeleteByUserID elimina todas las simulaciones del usuario junto con sus cronogramas
+func (r *MortgageRepositoryImpl) DeleteByUserID(ctx context.Context, userID valueobjects.UserID) error {
+	return persistence.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID.Value()).
+			Delete(&models.PaymentScheduleItemModel{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("mortgage_id IN (?)", tx.Model(&models.MortgageModel{}).Select("id").Where("user_id = ?", userID.Value())).
+			Delete(&models.MortgageGraceWindowModel{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("mortgage_id IN (?)", tx.Model(&models.MortgageModel{}).Select("id").Where("user_id = ?", userID.Value())).
+			Delete(&models.MortgagePaymentModel{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("mortgage_id IN (?)", tx.Model(&models.MortgageModel{}).Select("id").Where("user_id = ?", userID.Value())).
+			Delete(&models.MortgageVersionModel{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID.Value()).Delete(&models.MortgageModel{}).Error
+	})
+}
+
+// saveGraceWindows guarda las ventanas de gracia del crédito
+func (r *MortgageRepositoryImpl) saveGraceWindows(tx *gorm.DB, mortgageID uint64, windows []valueobjects.GraceWindow) error {
+	if len(windows) == 0 {
+		return nil
+	}
+	windowModels := r.toGraceWindowModels(mortgageID, windows)
+	return tx.Create(&windowModels).Error
+}
+
+func (r *MortgageRepositoryImpl) toGraceWindowModels(mortgageID uint64, windows []valueobjects.GraceWindow) []models.MortgageGraceWindowModel {
+	windowModels := make([]models.MortgageGraceWindowModel, 0, len(windows))
+	for _, window := range windows {
+		windowModels = append(windowModels, models.MortgageGraceWindowModel{
+			MortgageID:  mortgageID,
+			StartPeriod: window.StartPeriod(),
+			Periods:     window.Periods(),
+			GraceType:   window.Type().String(),
+		})
+	}
+	return windowModels
+}
+
+// FindVersions retorna las versiones de la simulación, de la más antigua a la más reciente
+func (r *MortgageRepositoryImpl) FindVersions(ctx context.Context, id valueobjects.MortgageID) ([]*entities.MortgageVersion, error) {
+	var versionModels []models.MortgageVersionModel
+	if err := persistence.Conn(ctx, r.db).
+		Where("mortgage_id = ?", id.Value()).
+		Order("version").
+		Find(&versionModels).Error; err != nil {
+		return nil, err
+	}
+
+	versions := make([]*entities.MortgageVersion, 0, len(versionModels))
+	for i := range versionModels {
+		version, err := r.toVersionDomain(&versionModels[i])
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
+
+func (r *MortgageRepositoryImpl) FindVersion(
+	ctx context.Context,
+	id valueobjects.MortgageID,
+	version int,
+) (*entities.MortgageVersion, error) {
+	var versionModel models.MortgageVersionModel
+	result := persistence.Conn(ctx, r.db).
+		Where("mortgage_id = ? AND version = ?", id.Value(), version).
+		First(&versionModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errors.New("mortgage version not found")
+		}
+		return nil, result.Error
+	}
+	return r.toVersionDomain(&versionModel)
+}
+
+// saveVersion guarda el modelo (con cronograma y ventanas de gracia) como la siguiente versión
+func (r *MortgageRepositoryImpl) saveVersion(tx *gorm.DB, mortgageModel *models.MortgageModel) error {
+	if err := lockMortgage(tx, mortgageModel.ID); err != nil {
+		return err
+	}
+
+	var last int
+	if err := tx.Model(&models.MortgageVersionModel{}).
+		Where("mortgage_id = ?", mortgageModel.ID).
+		Select("COALESCE(MAX(version), 0)").
+		Scan(&last).Error; err != nil {
+		return err
+	}
+
+	snapshot, err := json.Marshal(mortgageModel)
+	if err != nil {
+		return err
+	}
+	return tx.Create(&models.MortgageVersionModel{
+		MortgageID: mortgageModel.ID,
+		Version:    last + 1,
+		Snapshot:   string(snapshot),
+	}).Error
+}
+
+// ensureFirstVersion guarda el estado actual como versión 1 si la simulación aún no tiene versiones
+func (r *MortgageRepositoryImpl) ensureFirstVersion(tx *gorm.DB, mortgageID uint64) error {
+	if err := lockMortgage(tx, mortgageID); err != nil {
+		return err
+	}
+
+	var count int64
+	if err := tx.Model(&models.MortgageVersionModel{}).
+		Where("mortgage_id = ?", mortgageID).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	var current models.MortgageModel
+	result := tx.Preload("PaymentScheduleItems").
+		Preload("GraceWindows", orderGraceWindows).
+		First(&current, mortgageID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return errors.New("mortgage not found")
+		}
+		return result.Error
+	}
+	return r.saveVersion(tx, &current)
+}
+
+// lockMortgage bloquea la fila de la simulación hasta el fin de la transacción, para que
+// dos escrituras concurrentes no calculen el mismo número de versión con MAX(version)+1
+func lockMortgage(tx *gorm.DB, mortgageID uint64) error {
+	var locked models.MortgageModel
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		Where("id = ?", mortgageID).
+		Take(&locked)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return errors.New("mortgage not found")
+	}
+	return result.Error
+}
+
+func (r *MortgageRepositoryImpl) toVersionDomain(versionModel *models.MortgageVersionModel) (*entities.MortgageVersion, error) {
+	var snapshot models.MortgageModel
+	if err := json.Unmarshal([]byte(versionModel.Snapshot), &snapshot); err != nil {
+		return nil, err
+	}
+	mortgage, err := r.toDomain(&snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.MortgageVersion{
+		MortgageID: versionModel.MortgageID,
+		Version:    versionModel.Version,
+		CreatedAt:  versionModel.CreatedAt,
+		Mortgage:   mortgage,
+	}, nil
+}
+
+func orderGraceWindows(db *gorm.DB) *gorm.DB { return db.Order("start_period") }
+
 func (r *MortgageRepositoryImpl) toModel(mortgage *entities.Mortgage) *models.MortgageModel {
+	var coBorrowerID *uuid.UUID
+	if id, err := uuid.Parse(mortgage.CoBorrowerID()); err == nil {
+		coBorrowerID = &id
+	}
+
+	var productID *uint64
+	if mortgage.ProductID() != 0 {
+		id := mortgage.ProductID()
+		productID = &id
+	}
+
+	var reprogrammedFrom *uint64
+	if mortgage.IsReprogrammed() {
+		id := mortgage.ReprogrammedFrom()
+		reprogrammedFrom = &id
+	}
+
+	var indexDate *time.Time
+	if !mortgage.IndexDate().IsZero() {
+		date := mortgage.IndexDate()
+		indexDate = &date
+	}
+
+	var disbursementDate *time.Time
+	if mortgage.IsDisbursed() {
+		date := mortgage.DisbursementDate()
+		disbursementDate = &date
+	}
+
+	// Los parámetros son números finitos; si no se pudieran serializar se guarda un objeto vacío
+	calculationParameters := "{}"
+	if data, err := json.Marshal(mortgage.CalculationParameters()); err == nil {
+		calculationParameters = string(data)
+	}
+
 	return &models.MortgageModel{
 		ID:                   mortgage.ID().Value(),
 		UserID:               mortgage.UserID().Value(),
@@ -170,6 +452,23 @@ func (r *MortgageRepositoryImpl) toModel(mortgage *entities.Mortgage) *models.Mo
 		PropertyInsurance:    mortgage.PropertyInsuranceRate(),
 		EvaluationFee:        mortgage.EvaluationFee(),
 		DisbursementFee:      mortgage.DisbursementFee(),
+		CoBorrowerID:         coBorrowerID,
+		ProductID:            productID,
+		IndexBase:            mortgage.IndexBase(),
+		IndexDate:            indexDate,
+		InflationRate:        mortgage.InflationRate(),
+		StepUpRate:           mortgage.StepUpRate(),
+		StepUpEvery:          mortgage.StepUpEveryPeriods(),
+		BalloonRate:          mortgage.BalloonRate(),
+		ReprogrammedFrom:     reprogrammedFrom,
+		ReprogrammingPeriod:  mortgage.ReprogrammingPeriod(),
+		OverdueInterest:      mortgage.OverdueInterest(),
+		OverdueCapitalized:   mortgage.OverdueCapitalized(),
+		DisbursementDate:     disbursementDate,
+		LateInterestRate:     mortgage.LateInterestRate(),
+		InsuredParties:       mortgage.InsuredParties(),
+		HouseholdIncome:      mortgage.HouseholdIncome(),
+		ExistingDebts:        mortgage.ExistingDebtPayments(),
 		PrincipalFinanced:    mortgage.PrincipalFinanced(),
 		PeriodicRate:         mortgage.PeriodicRate(),
 		FixedInstallment:     mortgage.FixedInstallment(),
@@ -184,6 +483,11 @@ func (r *MortgageRepositoryImpl) toModel(mortgage *entities.Mortgage) *models.Mo
 		FlowIRR:              mortgage.FlowIRR(),
 		TCEA:                 mortgage.TCEA(),
 		CreatedAt:            mortgage.CreatedAt(),
+
+		PrepaymentPenaltyRate: mortgage.PrepaymentPenaltyRate(),
+		RateUnit:              valueobjects.RateUnitDecimal.String(),
+		EngineVersion:         mortgage.EngineVersion(),
+		CalculationParameters: calculationParameters,
 	}
 }
 
@@ -207,23 +511,30 @@ func (r *MortgageRepositoryImpl) toScheduleItemModels(
 
 	for _, item := range items {
 		itemModels = append(itemModels, models.PaymentScheduleItemModel{
-			MortgageID:        mortgageID,
-			UserID:            userUUID,
-			Period:            item.Period,
-			YearNumber:        item.YearNumber,
-			PeriodicRate:      item.PeriodicRateApplied,
-			Installment:       item.Installment,
-			TotalInstallment:  item.TotalInstallment,
-			Interest:          item.Interest,
-			Amortization:      item.Amortization,
-			Administration:    item.AdministrationFee,
-			Portes:            item.Portes,
-			LifeInsurance:     item.LifeInsurance,
-			PropertyInsurance: item.PropertyInsurance,
-			AdditionalCosts:   item.AdditionalCosts,
-			RemainingBalance:  item.RemainingBalance,
-			IsGracePeriod:     item.IsGracePeriod,
-			GraceType:         item.GraceType,
+			MortgageID:           mortgageID,
+			UserID:               userUUID,
+			Period:               item.Period,
+			YearNumber:           item.YearNumber,
+			PeriodicRate:         item.PeriodicRateApplied,
+			Installment:          item.Installment,
+			TotalInstallment:     item.TotalInstallment,
+			Interest:             item.Interest,
+			Amortization:         item.Amortization,
+			Administration:       item.AdministrationFee,
+			Portes:               item.Portes,
+			LifeInsurance:        item.LifeInsurance,
+			PropertyInsurance:    item.PropertyInsurance,
+			AdditionalCosts:      item.AdditionalCosts,
+			RemainingBalance:     item.RemainingBalance,
+			IsGracePeriod:        item.IsGracePeriod,
+			GraceType:            item.GraceType,
+			NegativeAmortization: item.NegativeAmortization,
+			BalloonPayment:       item.BalloonPayment,
+			Overdue:              item.Overdue,
+
+			ProjectedIndex:          item.ProjectedIndex,
+			NominalTotalInstallment: item.NominalTotalInstallment,
+			NominalBalance:          item.NominalBalance,
 		})
 	}
 
@@ -295,26 +606,78 @@ func (r *MortgageRepositoryImpl) toDomain(model *models.MortgageModel) (*entitie
 		model.CreatedAt,
 	)
 
+	if model.CoBorrowerID != nil {
+		mortgage.SetCoBorrower(model.CoBorrowerID.String(), model.InsuredParties)
+	} else {
+		mortgage.SetCoBorrower("", model.InsuredParties)
+	}
+	mortgage.SetHouseholdIncome(model.HouseholdIncome)
+	mortgage.SetExistingDebtPayments(model.ExistingDebts)
+	if model.ProductID != nil {
+		mortgage.SetProductID(*model.ProductID)
+	}
+	mortgage.SetGraduatedPayment(model.StepUpRate, model.StepUpEvery)
+	mortgage.SetBalloonRate(model.BalloonRate)
+	mortgage.SetPrepaymentPenaltyRate(model.PrepaymentPenaltyRate)
+	if model.ReprogrammedFrom != nil {
+		mortgage.SetReprogramming(*model.ReprogrammedFrom, model.ReprogrammingPeriod, model.OverdueInterest, model.OverdueCapitalized)
+	}
+
+	windows := make([]valueobjects.GraceWindow, 0, len(model.GraceWindows))
+	for _, windowModel := range model.GraceWindows {
+		window, err := valueobjects.NewGraceWindow(
+			windowModel.StartPeriod,
+			windowModel.Periods,
+			valueobjects.GracePeriodType(windowModel.GraceType),
+		)
+		if err != nil {
+			return nil, err
+		}
+		windows = append(windows, window)
+	}
+	mortgage.SetGraceWindows(windows)
+	if model.IndexDate != nil {
+		mortgage.SetIndexation(model.IndexBase, *model.IndexDate, model.InflationRate)
+	}
+	if model.DisbursementDate != nil {
+		mortgage.SetDisbursement(*model.DisbursementDate, model.LateInterestRate)
+	}
+	// Las simulaciones guardadas antes de registrar el motor no tienen versión ni parámetros
+	var params entities.CalculationParameters
+	if model.CalculationParameters != "" {
+		if err := json.Unmarshal([]byte(model.CalculationParameters), &params); err != nil {
+			return nil, err
+		}
+	}
+	mortgage.SetCalculation(model.EngineVersion, params)
+
 	// Reconstruir cronograma desde items
 	if len(model.PaymentScheduleItems) > 0 {
 		schedule := entities.NewPaymentSchedule()
 		for _, itemModel := range model.PaymentScheduleItems {
 			schedule.AddItem(entities.PaymentScheduleItem{
-				Period:              itemModel.Period,
-				YearNumber:          itemModel.YearNumber,
-				PeriodicRateApplied: itemModel.PeriodicRate,
-				Installment:         itemModel.Installment,
-				TotalInstallment:    itemModel.TotalInstallment,
-				Interest:            itemModel.Interest,
-				Amortization:        itemModel.Amortization,
-				AdministrationFee:   itemModel.Administration,
-				Portes:              itemModel.Portes,
-				LifeInsurance:       itemModel.LifeInsurance,
-				PropertyInsurance:   itemModel.PropertyInsurance,
-				AdditionalCosts:     itemModel.AdditionalCosts,
-				RemainingBalance:    itemModel.RemainingBalance,
-				IsGracePeriod:       itemModel.IsGracePeriod,
-				GraceType:           itemModel.GraceType,
+				Period:               itemModel.Period,
+				YearNumber:           itemModel.YearNumber,
+				PeriodicRateApplied:  itemModel.PeriodicRate,
+				Installment:          itemModel.Installment,
+				TotalInstallment:     itemModel.TotalInstallment,
+				Interest:             itemModel.Interest,
+				Amortization:         itemModel.Amortization,
+				AdministrationFee:    itemModel.Administration,
+				Portes:               itemModel.Portes,
+				LifeInsurance:        itemModel.LifeInsurance,
+				PropertyInsurance:    itemModel.PropertyInsurance,
+				AdditionalCosts:      itemModel.AdditionalCosts,
+				RemainingBalance:     itemModel.RemainingBalance,
+				IsGracePeriod:        itemModel.IsGracePeriod,
+				GraceType:            itemModel.GraceType,
+				NegativeAmortization: itemModel.NegativeAmortization,
+				BalloonPayment:       itemModel.BalloonPayment,
+				Overdue:              itemModel.Overdue,
+
+				ProjectedIndex:          itemModel.ProjectedIndex,
+				NominalTotalInstallment: itemModel.NominalTotalInstallment,
+				NominalBalance:          itemModel.NominalBalance,
 			})
 		}
 		mortgage.SetPaymentSchedule(schedule)
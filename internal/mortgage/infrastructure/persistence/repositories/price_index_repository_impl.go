@@ -0,0 +1,74 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/repositories"
+	"finanzas-backend/internal/mortgage/infrastructure/persistence/models"
+	"finanzas-backend/internal/shared/infrastructure/persistence"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PriceIndexRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPriceIndexRepository(db *gorm.DB) repositories.PriceIndexRepository {
+	return &PriceIndexRepositoryImpl{db: db}
+}
+
+func (r *PriceIndexRepositoryImpl) Upsert(ctx context.Context, index *entities.PriceIndex) error {
+	return persistence.Conn(ctx, r.db).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"vac", "cpi", "updated_at"}),
+	}).Create(r.toModel(index)).Error
+}
+
+func (r *PriceIndexRepositoryImpl) FindLatestOnOrBefore(ctx context.Context, date time.Time) (*entities.PriceIndex, error) {
+	var model models.PriceIndexModel
+	err := persistence.Conn(ctx, r.db).
+		Where("date <= ?", date).
+		Order("date DESC").
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toDomain(&model), nil
+}
+
+func (r *PriceIndexRepositoryImpl) FindBetween(ctx context.Context, from, to time.Time) ([]*entities.PriceIndex, error) {
+	var rows []models.PriceIndexModel
+	err := persistence.Conn(ctx, r.db).
+		Where("date BETWEEN ? AND ?", from, to).
+		Order("date").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]*entities.PriceIndex, 0, len(rows))
+	for i := range rows {
+		values = append(values, r.toDomain(&rows[i]))
+	}
+	return values, nil
+}
+
+func (r *PriceIndexRepositoryImpl) toModel(index *entities.PriceIndex) *models.PriceIndexModel {
+	return &models.PriceIndexModel{
+		Date:      index.Date(),
+		VAC:       index.VAC(),
+		CPI:       index.CPI(),
+		UpdatedAt: index.UpdatedAt(),
+	}
+}
+
+func (r *PriceIndexRepositoryImpl) toDomain(model *models.PriceIndexModel) *entities.PriceIndex {
+	return entities.ReconstructPriceIndex(model.Date, model.VAC, model.CPI, model.UpdatedAt)
+}
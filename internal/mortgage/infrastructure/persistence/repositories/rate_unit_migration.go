@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+	"finanzas-backend/internal/mortgage/infrastructure/persistence/models"
+
+	"gorm.io/gorm"
+)
+
+const rateUnitMigrationBatchSize = 200
+
+// MigrateLegacyRateUnits convierte a decimal, una sola vez, las tasas de las simulaciones, sus versiones
+// y los productos guardados antes de registrar la unidad, con la regla con la que el motor las leía:
+// los valores mayores a 1 eran porcentajes. Es idempotente: solo procesa filas sin unidad.
+func MigrateLegacyRateUnits(ctx context.Context, db *gorm.DB) (int, error) {
+	migrated := 0
+
+	for {
+		var rows []models.MortgageModel
+		if err := db.WithContext(ctx).Where("rate_unit = ''").Order("id").Limit(rateUnitMigrationBatchSize).Find(&rows).Error; err != nil {
+			return migrated, err
+		}
+		if len(rows) == 0 {
+			break
+		}
+		for i := range rows {
+			legacyRates(&rows[i])
+			if err := db.WithContext(ctx).Model(&models.MortgageModel{}).
+				Where("id = ? AND rate_unit = ''", rows[i].ID).
+				Updates(map[string]interface{}{
+					"interest_rate":           rows[i].InterestRate,
+					"life_insurance_rate":     rows[i].LifeInsuranceRate,
+					"property_insurance":      rows[i].PropertyInsurance,
+					"inflation_rate":          rows[i].InflationRate,
+					"step_up_rate":            rows[i].StepUpRate,
+					"balloon_rate":            rows[i].BalloonRate,
+					"prepayment_penalty_rate": rows[i].PrepaymentPenaltyRate,
+					"late_interest_rate":      rows[i].LateInterestRate,
+					"rate_unit":               rows[i].RateUnit,
+				}).Error; err != nil {
+				return migrated, err
+			}
+			migrated++
+		}
+	}
+
+	// Las versiones guardan el MortgageModel como JSON; las anteriores a la unidad no traen RateUnit
+	for {
+		var versions []models.MortgageVersionModel
+		if err := db.WithContext(ctx).Where("COALESCE(snapshot->>'RateUnit', '') = ''").Order("id").Limit(rateUnitMigrationBatchSize).Find(&versions).Error; err != nil {
+			return migrated, err
+		}
+		if len(versions) == 0 {
+			break
+		}
+		for _, version := range versions {
+			var snapshot models.MortgageModel
+			if err := json.Unmarshal([]byte(version.Snapshot), &snapshot); err != nil {
+				return migrated, err
+			}
+			legacyRates(&snapshot)
+			converted, err := json.Marshal(snapshot)
+			if err != nil {
+				return migrated, err
+			}
+			if err := db.WithContext(ctx).Model(&models.MortgageVersionModel{}).
+				Where("id = ?", version.ID).
+				Update("snapshot", string(converted)).Error; err != nil {
+				return migrated, err
+			}
+			migrated++
+		}
+	}
+
+	for {
+		var products []models.LenderProductModel
+		if err := db.WithContext(ctx).Where("rate_unit = ''").Order("id").Limit(rateUnitMigrationBatchSize).Find(&products).Error; err != nil {
+			return migrated, err
+		}
+		if len(products) == 0 {
+			break
+		}
+		for _, product := range products {
+			if err := db.WithContext(ctx).Model(&models.LenderProductModel{}).
+				Where("id = ? AND rate_unit = ''", product.ID).
+				Updates(map[string]interface{}{
+					"life_insurance_rate": legacyDecimal(product.LifeInsuranceRate),
+					"property_insurance":  legacyDecimal(product.PropertyInsurance),
+					"rate_unit":           valueobjects.RateUnitDecimal.String(),
+				}).Error; err != nil {
+				return migrated, err
+			}
+			migrated++
+		}
+	}
+
+	return migrated, nil
+}
+
+// legacyRates convierte a decimal las tasas de una simulación guardada antes de registrar su unidad
+func legacyRates(model *models.MortgageModel) {
+	model.InterestRate = legacyDecimal(model.InterestRate)
+	model.LifeInsuranceRate = legacyDecimal(model.LifeInsuranceRate)
+	model.PropertyInsurance = legacyDecimal(model.PropertyInsurance)
+	model.InflationRate = legacyDecimal(model.InflationRate)
+	model.StepUpRate = legacyDecimal(model.StepUpRate)
+	model.BalloonRate = legacyDecimal(model.BalloonRate)
+	model.PrepaymentPenaltyRate = legacyDecimal(model.PrepaymentPenaltyRate)
+	model.LateInterestRate = legacyDecimal(model.LateInterestRate)
+	model.RateUnit = valueobjects.RateUnitDecimal.String()
+}
+
+// legacyDecimal aplica la regla con la que el motor leía las tasas sin unidad: mayores a 1 eran porcentajes
+func legacyDecimal(rate float64) float64 {
+	if rate > 1 {
+		return rate / 100
+	}
+	return rate
+}
@@ -0,0 +1,15 @@
+package acl
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// MortgageContextFacade define el contrato ACL para que otros bounded contexts operen sobre Mortgage
+type MortgageContextFacade interface {
+	// ExportUserData retorna todas las simulaciones del usuario (con cronograma) como un arreglo JSON
+	ExportUserData(ctx context.Context, userID string) (json.RawMessage, error)
+
+	// DeleteUserData elimina definitivamente todas las simulaciones del usuario
+	DeleteUserData(ctx context.Context, userID string) error
+}
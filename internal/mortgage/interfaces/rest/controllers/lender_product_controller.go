@@ -0,0 +1,230 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"finanzas-backend/internal/mortgage/domain/model/commands"
+	"finanzas-backend/internal/mortgage/domain/model/queries"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+	"finanzas-backend/internal/mortgage/domain/services"
+	"finanzas-backend/internal/mortgage/interfaces/rest/resources"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LenderProductController struct {
+	commandService services.LenderProductCommandService
+	queryService   services.LenderProductQueryService
+}
+
+func NewLenderProductController(
+	commandService services.LenderProductCommandService,
+	queryService services.LenderProductQueryService,
+) *LenderProductController {
+	return &LenderProductController{
+		commandService: commandService,
+		queryService:   queryService,
+	}
+}
+
+// ListProducts godoc
+// @Summary List lender products
+// @Description Lists the active mortgage products of the lender catalog, optionally filtered by currency
+// @Tags LenderProducts
+// @Produce json
+// @Param moneda query string false "Currency (PEN or USD)"
+// @Param incluir_inactivos query bool false "Include inactive products"
+// @Success 200 {array} resources.LenderProductResource
+// @Failure 400 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/lender-products [get]
+func (c *LenderProductController) ListProducts(ctx *gin.Context) {
+	query, err := queries.NewListLenderProductsQuery(ctx.Query("moneda"), ctx.Query("incluir_inactivos") == "true")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	products, err := c.queryService.HandleList(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]resources.LenderProductResource, 0, len(products))
+	for _, product := range products {
+		response = append(response, resources.TransformToLenderProductResource(product))
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// GetProduct godoc
+// @Summary Get lender product
+// @Description Get a mortgage product of the lender catalog by ID
+// @Tags LenderProducts
+// @Produce json
+// @Param id path uint64 true "Product ID"
+// @Success 200 {object} resources.LenderProductResource
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/lender-products/{id} [get]
+func (c *LenderProductController) GetProduct(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid product ID"})
+		return
+	}
+
+	query, err := queries.NewGetLenderProductByIDQuery(id)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	product, err := c.queryService.HandleGetByID(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(productErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resources.TransformToLenderProductResource(product))
+}
+
+// CreateProduct godoc
+// @Summary Create lender product
+// @Description Adds a mortgage product to the lender catalog (administrators only)
+// @Tags LenderProducts
+// @Accept json
+// @Produce json
+// @Param request body resources.SaveLenderProductRequest true "Lender product"
+// @Success 201 {object} resources.LenderProductResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/lender-products [post]
+func (c *LenderProductController) CreateProduct(ctx *gin.Context) {
+	c.saveProduct(ctx, nil, http.StatusCreated)
+}
+
+// UpdateProduct godoc
+// @Summary Update lender product
+// @Description Replaces the published rates and fees of a lender product (administrators only)
+// @Tags LenderProducts
+// @Accept json
+// @Produce json
+// @Param id path uint64 true "Product ID"
+// @Param request body resources.SaveLenderProductRequest true "Lender product"
+// @Success 200 {object} resources.LenderProductResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/lender-products/{id} [put]
+func (c *LenderProductController) UpdateProduct(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid product ID"})
+		return
+	}
+
+	productID, err := valueobjects.NewProductID(id)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.saveProduct(ctx, &productID, http.StatusOK)
+}
+
+// DeactivateProduct godoc
+// @Summary Deactivate lender product
+// @Description Removes a product from the catalog; simulations that reference it are kept (administrators only)
+// @Tags LenderProducts
+// @Param id path uint64 true "Product ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/lender-products/{id} [delete]
+func (c *LenderProductController) DeactivateProduct(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid product ID"})
+		return
+	}
+
+	cmd, err := commands.NewDeactivateLenderProductCommand(id)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.commandService.HandleDeactivateProduct(ctx.Request.Context(), cmd); err != nil {
+		ctx.JSON(productErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *LenderProductController) saveProduct(ctx *gin.Context, productID *valueobjects.ProductID, successStatus int) {
+	var req resources.SaveLenderProductRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rateTiers, err := req.ToRateTiers()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	active := true
+	if req.Activo != nil {
+		active = *req.Activo
+	}
+
+	cmd, err := commands.NewSaveLenderProductCommand(
+		productID,
+		req.Banco,
+		req.Nombre,
+		req.Moneda,
+		rateTiers,
+		req.SeguroDesg,
+		req.SeguroInmueble,
+		req.ComisionEval,
+		req.ComisionDesem,
+		req.Portes,
+		req.GastosAdm,
+		active,
+		req.UnidadTasas,
+	)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	product, err := c.commandService.HandleSaveProduct(ctx.Request.Context(), cmd)
+	if err != nil {
+		ctx.JSON(productErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(successStatus, resources.TransformToLenderProductResource(product))
+}
+
+func productErrorStatus(err error) int {
+	switch err.Error() {
+	case "lender product not found":
+		return http.StatusNotFound
+	case "bank and product name are required", "at least one rate tier is required",
+		"insurance rates cannot be negative", "fees cannot be negative":
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -4,8 +4,11 @@ import (
 	"math"
 	"net/http"
 	"strconv"
+	"time"
 
+	"finanzas-backend/internal/mortgage/application/acl"
 	"finanzas-backend/internal/mortgage/domain/model/commands"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
 	"finanzas-backend/internal/mortgage/domain/model/queries"
 	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
 	"finanzas-backend/internal/mortgage/domain/services"
@@ -17,15 +20,18 @@ import (
 type MortgageController struct {
 	commandService services.MortgageCommandService
 	queryService   services.MortgageQueryService
+	exchangeRates  *acl.ExternalExchangeRateService
 }
 
 func NewMortgageController(
 	commandService services.MortgageCommandService,
 	queryService services.MortgageQueryService,
+	exchangeRates *acl.ExternalExchangeRateService,
 ) *MortgageController {
 	return &MortgageController{
 		commandService: commandService,
 		queryService:   queryService,
+		exchangeRates:  exchangeRates,
 	}
 }
 
@@ -79,6 +85,12 @@ func (c *MortgageController) CalculateMortgage(ctx *gin.Context) {
 		npvRate = req.TasaDescuento
 	}
 
+	graceWindows, err := resources.ToGraceWindows(req.VentanasGracia)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	cmd, err := commands.NewCalculateMortgageCommand(
 		userID,
 		req.PrecioVenta,
@@ -102,6 +114,15 @@ func (c *MortgageController) CalculateMortgage(ctx *gin.Context) {
 		req.SeguroInmueble,
 		req.ComisionEval,
 		req.ComisionDesem,
+		req.CoPrestatarioID,
+		req.ProductoID,
+		req.InflacionAnual,
+		req.IncrementoCuota,
+		req.IncrementoCada,
+		req.TasaCuotaBalon,
+		graceWindows,
+		req.TasaPenalidadPrepago,
+		req.UnidadTasas,
 	)
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -110,12 +131,11 @@ func (c *MortgageController) CalculateMortgage(ctx *gin.Context) {
 
 	mortgage, err := c.commandService.HandleCalculateMortgage(ctx.Request.Context(), cmd)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		ctx.JSON(calculationErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
-	response := resources.TransformToMortgageResponse(mortgage)
-	ctx.JSON(http.StatusOK, response)
+	c.respondMortgage(ctx, mortgage)
 }
 
 // GetMortgageByID godoc
@@ -151,8 +171,7 @@ func (c *MortgageController) GetMortgageByID(ctx *gin.Context) {
 		return
 	}
 
-	response := resources.TransformToMortgageResponse(mortgage)
-	ctx.JSON(http.StatusOK, response)
+	c.respondMortgage(ctx, mortgage)
 }
 
 // GetMortgageHistory godoc
@@ -222,7 +241,9 @@ func (c *MortgageController) GetMortgageHistory(ctx *gin.Context) {
 // @Success 200 {object} resources.MortgageResponse
 // @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
 // @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
 // @Security BearerAuth
 // @Router /api/v1/mortgage/{id} [put]
 func (c *MortgageController) UpdateMortgage(ctx *gin.Context) {
@@ -272,8 +293,32 @@ func (c *MortgageController) UpdateMortgage(ctx *gin.Context) {
 		discountRate = req.TasaDescuento
 	}
 
+	var graceWindows *[]valueobjects.GraceWindow
+	if req.VentanasGracia != nil {
+		windows, err := resources.ToGraceWindows(*req.VentanasGracia)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		graceWindows = &windows
+	}
+
+	// Obtener user_id del contexto (guardado por el middleware JWT)
+	userIDValue, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userID, err := valueobjects.NewUserID(userIDValue.(string))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	cmd, err := commands.NewUpdateMortgageCommand(
 		mortgageID,
+		userID,
 		req.PrecioVenta,
 		req.CuotaInicial,
 		req.MontoPrestamo,
@@ -295,6 +340,14 @@ func (c *MortgageController) UpdateMortgage(ctx *gin.Context) {
 		req.SeguroInmueble,
 		req.ComisionEval,
 		req.ComisionDesem,
+		req.CoPrestatarioID,
+		req.InflacionAnual,
+		req.IncrementoCuota,
+		req.IncrementoCada,
+		req.TasaCuotaBalon,
+		graceWindows,
+		req.TasaPenalidadPrepago,
+		req.UnidadTasas,
 	)
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -303,12 +356,19 @@ func (c *MortgageController) UpdateMortgage(ctx *gin.Context) {
 
 	mortgage, err := c.commandService.HandleUpdateMortgage(ctx.Request.Context(), cmd)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		if err.Error() == "unauthorized access to mortgage" {
+			ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+		if err.Error() == "mortgage not found" {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.JSON(calculationErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
-	response := resources.TransformToMortgageResponse(mortgage)
-	ctx.JSON(http.StatusOK, response)
+	c.respondMortgage(ctx, mortgage)
 }
 
 // DeleteMortgage godoc
@@ -373,3 +433,519 @@ func (c *MortgageController) DeleteMortgage(ctx *gin.Context) {
 
 	ctx.Status(http.StatusNoContent)
 }
+
+// AnalyzeRefinance godoc
+// @Summary Analyze refinancing a mortgage with another lender
+// @Description Takes the balance of a saved mortgage after the given period, builds the new loan schedule and reports savings, break-even period and NPV of switching at the user's COK
+// @Tags Mortgage
+// @Accept json
+// @Produce json
+// @Param id path uint64 true "Mortgage ID"
+// @Param request body resources.RefinanceAnalysisRequest true "New loan terms"
+// @Success 200 {object} resources.RefinanceAnalysisResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/mortgage/{id}/refinance-analysis [post]
+func (c *MortgageController) AnalyzeRefinance(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid mortgage ID"})
+		return
+	}
+
+	var req resources.RefinanceAnalysisRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userIDValue, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	query, err := queries.NewAnalyzeRefinanceQuery(
+		id,
+		userIDValue.(string),
+		req.PeriodoTraslado,
+		req.TasaAnual,
+		req.TipoTasa,
+		req.PlazoMeses,
+		req.SeguroDesg,
+		req.SeguroInmueble,
+		req.ComisionEval,
+		req.ComisionDesem,
+		req.Portes,
+		req.GastosAdm,
+		req.GastosTraslado,
+		req.COK,
+		req.UnidadTasas,
+	)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	analysis, err := c.queryService.HandleAnalyzeRefinance(ctx.Request.Context(), query)
+	if err != nil {
+		switch err.Error() {
+		case "mortgage not found":
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "unauthorized access to mortgage":
+			ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case "payment schedule not calculated",
+			"refinance period must be before the last installment",
+			"mortgage has no outstanding balance at the refinance period":
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resources.TransformToRefinanceAnalysisResource(analysis))
+}
+
+// ReprogramMortgage godoc
+// @Summary Reprogram a saved mortgage
+// @Description Keeps the installments of a saved mortgage up to the given period and recalculates the rest with the extended term and/or new rate, optionally capitalizing overdue interest. The reprogrammed version is saved as a new mortgage and returned next to the original.
+// @Tags Mortgage
+// @Accept json
+// @Produce json
+// @Param id path uint64 true "Mortgage ID"
+// @Param request body resources.ReprogramMortgageRequest true "Reprogramming terms"
+// @Success 201 {object} resources.ReprogrammingResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/mortgage/{id}/reprogram [post]
+func (c *MortgageController) ReprogramMortgage(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid mortgage ID"})
+		return
+	}
+
+	var req resources.ReprogramMortgageRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userIDValue, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	cmd, err := commands.NewReprogramMortgageCommand(
+		id,
+		userIDValue.(string),
+		req.PeriodoReprogramacion,
+		req.CuotasAdicionales,
+		req.TasaAnual,
+		req.TipoTasa,
+		req.CuotasVencidas,
+		req.CapitalizarIntereses,
+		req.COK,
+		req.UnidadTasas,
+	)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reprogramming, err := c.commandService.HandleReprogramMortgage(ctx.Request.Context(), cmd)
+	if err != nil {
+		ctx.JSON(reprogrammingErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, resources.TransformToReprogrammingResource(reprogramming))
+}
+
+// GetReprogramming godoc
+// @Summary Compare a reprogrammed mortgage with its original
+// @Tags Mortgage
+// @Produce json
+// @Param id path uint64 true "Reprogrammed mortgage ID"
+// @Success 200 {object} resources.ReprogrammingResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/mortgage/{id}/reprogramming [get]
+func (c *MortgageController) GetReprogramming(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid mortgage ID"})
+		return
+	}
+
+	userIDValue, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	query, err := queries.NewGetMortgageReprogrammingQuery(id, userIDValue.(string))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reprogramming, err := c.queryService.HandleGetReprogramming(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(reprogrammingErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resources.TransformToReprogrammingResource(reprogramming))
+}
+
+// GetPayoffQuote godoc
+// @Summary Quote the full payoff of a saved mortgage
+// @Description Breaks down the amount to cancel the mortgage at a date (disbursed mortgages) or after a paid period: outstanding principal, interest accrued since the last due date, pro-rated insurance of the current period and the prepayment penalty. Earlier installments are assumed paid on schedule.
+// @Tags Mortgage
+// @Produce json
+// @Param id path uint64 true "Mortgage ID"
+// @Param fecha query string false "Payoff date (YYYY-MM-DD)"
+// @Param periodo query int false "Installments paid, used when fecha is not given"
+// @Param dias query int false "Days into the next period, used with periodo"
+// @Success 200 {object} resources.PayoffQuoteResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/mortgage/{id}/payoff [get]
+func (c *MortgageController) GetPayoffQuote(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid mortgage ID"})
+		return
+	}
+	date, ok := parseIndexDate(ctx, "fecha", time.Time{})
+	if !ok {
+		return
+	}
+	period, err := strconv.Atoi(ctx.DefaultQuery("periodo", "0"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid periodo"})
+		return
+	}
+	days, err := strconv.Atoi(ctx.DefaultQuery("dias", "0"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid dias"})
+		return
+	}
+
+	userIDValue, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	query, err := queries.NewGetPayoffQuoteQuery(id, userIDValue.(string), date, period, days)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	quote, err := c.queryService.HandleGetPayoffQuote(ctx.Request.Context(), query)
+	if err != nil {
+		switch err.Error() {
+		case "mortgage not found":
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "unauthorized access to mortgage":
+			ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case "mortgage has not been disbursed":
+			ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		case "payment schedule not calculated",
+			"payoff date is before the disbursement date",
+			"mortgage is fully paid at the payoff date",
+			"days must be within the payment period":
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resources.TransformToPayoffQuoteResource(quote))
+}
+
+// ListVersions godoc
+// @Summary List the saved versions of a mortgage
+// @Description Every calculation and update is kept as an immutable version with its inputs, results and schedule. Version 1 is the original calculation.
+// @Tags Mortgage
+// @Produce json
+// @Param id path uint64 true "Mortgage ID"
+// @Success 200 {array} resources.MortgageVersionSummaryResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/mortgage/{id}/versions [get]
+func (c *MortgageController) ListVersions(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid mortgage ID"})
+		return
+	}
+
+	userIDValue, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	query, err := queries.NewListMortgageVersionsQuery(id, userIDValue.(string))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	versions, err := c.queryService.HandleListVersions(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(versionErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]resources.MortgageVersionSummaryResource, 0, len(versions))
+	for _, version := range versions {
+		response = append(response, resources.TransformToVersionSummaryResource(version))
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// GetVersion godoc
+// @Summary Get a saved version of a mortgage
+// @Tags Mortgage
+// @Produce json
+// @Param id path uint64 true "Mortgage ID"
+// @Param version path int true "Version number"
+// @Success 200 {object} resources.MortgageVersionResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/mortgage/{id}/versions/{version} [get]
+func (c *MortgageController) GetVersion(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid mortgage ID"})
+		return
+	}
+	version, err := strconv.Atoi(ctx.Param("version"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid version"})
+		return
+	}
+
+	userIDValue, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	query, err := queries.NewGetMortgageVersionQuery(id, userIDValue.(string), version)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	mortgageVersion, err := c.queryService.HandleGetVersion(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(versionErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resources.TransformToVersionResource(mortgageVersion))
+}
+
+// CompareVersions godoc
+// @Summary Compare two versions of a mortgage
+// @Description Lists the inputs and results that changed between two saved versions and the installments whose amount or balance differ
+// @Tags Mortgage
+// @Produce json
+// @Param id path uint64 true "Mortgage ID"
+// @Param desde query int true "Version to compare from"
+// @Param hasta query int true "Version to compare to"
+// @Success 200 {object} resources.VersionDiffResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/mortgage/{id}/versions/compare [get]
+func (c *MortgageController) CompareVersions(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid mortgage ID"})
+		return
+	}
+	fromVersion, err := strconv.Atoi(ctx.Query("desde"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid desde version"})
+		return
+	}
+	toVersion, err := strconv.Atoi(ctx.Query("hasta"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid hasta version"})
+		return
+	}
+
+	userIDValue, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	query, err := queries.NewCompareMortgageVersionsQuery(id, userIDValue.(string), fromVersion, toVersion)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	diff, err := c.queryService.HandleCompareVersions(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(versionErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resources.TransformToVersionDiffResource(diff))
+}
+
+// RecomputeMortgage godoc
+// @Summary Recompute a saved mortgage with the current calculation engine
+// @Description Recalculates the mortgage from its saved inputs with the current engine, without saving the result, and lists the engine parameters, results and installments that differ from the saved calculation. Used to audit the impact of formula changes.
+// @Tags Mortgage
+// @Produce json
+// @Param id path uint64 true "Mortgage ID"
+// @Success 200 {object} resources.RecomputationResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/mortgage/{id}/recompute [get]
+func (c *MortgageController) RecomputeMortgage(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid mortgage ID"})
+		return
+	}
+
+	userIDValue, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	query, err := queries.NewRecomputeMortgageQuery(id, userIDValue.(string))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	recomputation, err := c.queryService.HandleRecompute(ctx.Request.Context(), query)
+	if err != nil {
+		switch err.Error() {
+		case "mortgage not found":
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "unauthorized access to mortgage":
+			ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		default:
+			ctx.JSON(calculationErrorStatus(err), gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resources.TransformToRecomputationResource(recomputation))
+}
+
+// versionErrorStatus mapea los errores al consultar las versiones de una simulación
+func versionErrorStatus(err error) int {
+	switch err.Error() {
+	case "mortgage not found", "mortgage version not found":
+		return http.StatusNotFound
+	case "unauthorized access to mortgage":
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// reprogrammingErrorStatus mapea los errores al reprogramar o consultar una reprogramación
+func reprogrammingErrorStatus(err error) int {
+	switch err.Error() {
+	case "mortgage not found", "original mortgage not found":
+		return http.StatusNotFound
+	case "unauthorized access to mortgage":
+		return http.StatusForbidden
+	case "payment schedule not calculated",
+		"mortgage is already a reprogramming",
+		"mortgage is not a reprogramming",
+		"reprogramming period must be before the last installment",
+		"overdue installments cannot exceed the reprogramming period",
+		"mortgage has no outstanding balance at the reprogramming period":
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// calculationErrorStatus mapea los errores al calcular o recalcular una simulación
+func calculationErrorStatus(err error) int {
+	switch err.Error() {
+	case "co-borrower not found", "lender product not found":
+		return http.StatusNotFound
+	case "VAC index is not available", "no current exchange rate to convert existing debts":
+		return http.StatusUnprocessableEntity
+	case "lender product is not available",
+		"currency does not match the lender product",
+		"product has no published rate for this term and down payment",
+		"rate type is required when the interest rate is given",
+		"inflation rate only applies to VAC mortgages",
+		"step-up periods must be greater than zero",
+		"balloon rate must be less than 100%",
+		"grace windows must start after the initial grace period",
+		"grace windows must end before the last period",
+		"grace windows must not overlap",
+		"too many grace windows":
+		return http.StatusBadRequest
+	case "reprogrammed mortgages cannot be recalculated", "disbursed mortgages cannot be recalculated":
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// respondMortgage responde el crédito con su equivalente en la otra moneda, si hay tipo de cambio vigente
+func (c *MortgageController) respondMortgage(ctx *gin.Context, mortgage *entities.Mortgage) {
+	response := resources.TransformToMortgageResponse(mortgage)
+
+	rate, err := c.exchangeRates.CurrentRate(ctx.Request.Context())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	// Un crédito en VAC ya reporta su proyección en soles
+	if rate != nil && !mortgage.Currency().IsIndexed() {
+		response.Equivalente = resources.TransformToCurrencyEquivalent(response, *rate)
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
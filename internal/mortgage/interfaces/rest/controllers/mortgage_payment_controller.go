@@ -0,0 +1,296 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"finanzas-backend/internal/mortgage/domain/model/commands"
+	"finanzas-backend/internal/mortgage/domain/model/queries"
+	"finanzas-backend/internal/mortgage/domain/services"
+	"finanzas-backend/internal/mortgage/interfaces/rest/resources"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MortgagePaymentController sigue los pagos reales de un crédito desembolsado
+type MortgagePaymentController struct {
+	commandService services.MortgagePaymentCommandService
+	queryService   services.MortgagePaymentQueryService
+}
+
+func NewMortgagePaymentController(
+	commandService services.MortgagePaymentCommandService,
+	queryService services.MortgagePaymentQueryService,
+) *MortgagePaymentController {
+	return &MortgagePaymentController{
+		commandService: commandService,
+		queryService:   queryService,
+	}
+}
+
+// RegisterDisbursement godoc
+// @Summary Register the actual disbursement of a mortgage
+// @Description Sets the disbursement date from which installments fall due and the annual effective late interest rate. The date cannot change once payments are recorded.
+// @Tags MortgagePayments
+// @Accept json
+// @Produce json
+// @Param id path uint64 true "Mortgage ID"
+// @Param request body resources.RegisterDisbursementRequest true "Disbursement"
+// @Success 200 {object} resources.DisbursementResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/mortgage/{id}/disbursement [put]
+func (c *MortgagePaymentController) RegisterDisbursement(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid mortgage ID"})
+		return
+	}
+
+	var req resources.RegisterDisbursementRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	date, err := time.Parse("2006-01-02", req.FechaDesembolso)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid fecha_desembolso, expected YYYY-MM-DD"})
+		return
+	}
+
+	userIDValue, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	cmd, err := commands.NewRegisterDisbursementCommand(id, userIDValue.(string), date, req.TasaMoratoria, req.UnidadTasas)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	mortgage, err := c.commandService.HandleRegisterDisbursement(ctx.Request.Context(), cmd)
+	if err != nil {
+		ctx.JSON(paymentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resources.TransformToDisbursementResource(mortgage))
+}
+
+// RecordPayment godoc
+// @Summary Record an actual payment
+// @Description Records a payment made on a disbursed mortgage. Payments are applied to the oldest due installments in order: late interest, charges, interest and principal.
+// @Tags MortgagePayments
+// @Accept json
+// @Produce json
+// @Param id path uint64 true "Mortgage ID"
+// @Param request body resources.RecordPaymentRequest true "Payment"
+// @Success 201 {object} resources.PaymentResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/mortgage/{id}/payments [post]
+func (c *MortgagePaymentController) RecordPayment(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid mortgage ID"})
+		return
+	}
+
+	var req resources.RecordPaymentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	date, err := time.Parse("2006-01-02", req.Fecha)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid fecha, expected YYYY-MM-DD"})
+		return
+	}
+
+	userIDValue, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	cmd, err := commands.NewRecordPaymentCommand(id, userIDValue.(string), date, req.Monto, req.Referencia)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	payment, err := c.commandService.HandleRecordPayment(ctx.Request.Context(), cmd)
+	if err != nil {
+		ctx.JSON(paymentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, resources.TransformToPaymentResource(payment))
+}
+
+// ListPayments godoc
+// @Summary List the recorded payments of a mortgage
+// @Tags MortgagePayments
+// @Produce json
+// @Param id path uint64 true "Mortgage ID"
+// @Success 200 {array} resources.PaymentResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/mortgage/{id}/payments [get]
+func (c *MortgagePaymentController) ListPayments(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid mortgage ID"})
+		return
+	}
+
+	userIDValue, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	query, err := queries.NewListMortgagePaymentsQuery(id, userIDValue.(string))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	payments, err := c.queryService.HandleListPayments(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(paymentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]resources.PaymentResource, 0, len(payments))
+	for _, payment := range payments {
+		response = append(response, resources.TransformToPaymentResource(payment))
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// DeletePayment godoc
+// @Summary Delete a payment recorded by mistake
+// @Tags MortgagePayments
+// @Param id path uint64 true "Mortgage ID"
+// @Param paymentId path uint64 true "Payment ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/mortgage/{id}/payments/{paymentId} [delete]
+func (c *MortgagePaymentController) DeletePayment(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid mortgage ID"})
+		return
+	}
+	paymentID, err := strconv.ParseUint(ctx.Param("paymentId"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid payment ID"})
+		return
+	}
+
+	userIDValue, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	cmd, err := commands.NewDeletePaymentCommand(id, userIDValue.(string), paymentID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.commandService.HandleDeletePayment(ctx.Request.Context(), cmd); err != nil {
+		ctx.JSON(paymentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// GetStatement godoc
+// @Summary Get the statement of a disbursed mortgage
+// @Description Applies the recorded payments to the saved schedule and reports paid-to-date, overdue installments with late interest, the next due amount and the payoff balance
+// @Tags MortgagePayments
+// @Produce json
+// @Param id path uint64 true "Mortgage ID"
+// @Param fecha query string false "Statement date (YYYY-MM-DD), defaults to today"
+// @Success 200 {object} resources.StatementResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/mortgage/{id}/statement [get]
+func (c *MortgagePaymentController) GetStatement(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid mortgage ID"})
+		return
+	}
+	asOf, ok := parseIndexDate(ctx, "fecha", time.Now())
+	if !ok {
+		return
+	}
+
+	userIDValue, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	query, err := queries.NewGetMortgageStatementQuery(id, userIDValue.(string), asOf)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	statement, err := c.queryService.HandleGetStatement(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(paymentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resources.TransformToStatementResource(statement))
+}
+
+// paymentErrorStatus mapea los errores del seguimiento de pagos
+func paymentErrorStatus(err error) int {
+	switch err.Error() {
+	case "mortgage not found", "payment not found":
+		return http.StatusNotFound
+	case "unauthorized access to mortgage":
+		return http.StatusForbidden
+	case "mortgage has not been disbursed",
+		"disbursement date cannot change once payments are recorded":
+		return http.StatusConflict
+	case "payment schedule not calculated",
+		"payment tracking is not available for VAC mortgages",
+		"payment date is before the disbursement date",
+		"statement date is before the disbursement date",
+		"payment reference is too long":
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,123 @@
+package controllers
+
+import (
+	"math"
+	"net/http"
+
+	"finanzas-backend/internal/mortgage/domain/model/commands"
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/services"
+	"finanzas-backend/internal/mortgage/interfaces/rest/resources"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MortgageQuoteController struct {
+	quoteService services.MortgageQuoteService
+}
+
+func NewMortgageQuoteController(quoteService services.MortgageQuoteService) *MortgageQuoteController {
+	return &MortgageQuoteController{quoteService: quoteService}
+}
+
+// QuoteMortgage godoc
+// @Summary Quote a mortgage against many lender offers
+// @Description Calculates the same request with each lender offer (inline or from the published offers file), discards ineligible offers and ranks the rest by TCEA
+// @Tags Mortgage
+// @Accept json
+// @Produce json
+// @Param request body resources.QuoteMortgageRequest true "Mortgage quote request"
+// @Success 200 {object} resources.MortgageQuoteResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/mortgage/quote [post]
+func (c *MortgageQuoteController) QuoteMortgage(ctx *gin.Context) {
+	var req resources.QuoteMortgageRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userIDValue, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userID := userIDValue.(string)
+
+	frecuenciaPago := req.FrecuenciaPago
+	if frecuenciaPago == 0 {
+		switch req.Frecuencia {
+		case "BIMESTRAL":
+			frecuenciaPago = 60
+		case "TRIMESTRAL":
+			frecuenciaPago = 90
+		default:
+			frecuenciaPago = 30
+		}
+	}
+
+	plazoMeses := req.PlazoMeses
+	if plazoMeses == 0 && req.NumeroAnios > 0 {
+		plazoMeses = int(math.Round(float64(req.NumeroAnios) * (float64(req.DiasAnio) / float64(frecuenciaPago))))
+	}
+
+	offers := make([]*entities.LenderOffer, 0, len(req.Ofertas))
+	for _, oferta := range req.Ofertas {
+		offer, err := oferta.ToLenderOffer(req.UnidadTasas)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		offers = append(offers, offer)
+	}
+
+	cmd, err := commands.NewQuoteMortgageCommand(
+		userID,
+		req.PrecioVenta,
+		req.CuotaInicial,
+		req.MontoPrestamo,
+		req.BonoTechoPropio,
+		frecuenciaPago,
+		req.DiasAnio,
+		plazoMeses,
+		req.NumeroAnios,
+		req.MesesGracia,
+		req.TipoGracia,
+		req.Moneda,
+		req.COK,
+		req.CostosMensuales,
+		req.CoPrestatarioID,
+		offers,
+		req.UnidadTasas,
+	)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	quote, err := c.quoteService.HandleQuote(ctx.Request.Context(), cmd)
+	if err != nil {
+		ctx.JSON(quoteErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resources.TransformToMortgageQuoteResponse(quote, cmd.Currency, cmd.LoanAmount, cmd.TermMonths))
+}
+
+// quoteErrorStatus mapea los errores de una cotización
+func quoteErrorStatus(err error) int {
+	switch err.Error() {
+	case "co-borrower not found":
+		return http.StatusNotFound
+	case "no lender offers to quote":
+		return http.StatusBadRequest
+	case "no current exchange rate to convert existing debts":
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
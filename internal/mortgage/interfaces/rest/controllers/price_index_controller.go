@@ -0,0 +1,79 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"finanzas-backend/internal/mortgage/domain/model/queries"
+	"finanzas-backend/internal/mortgage/domain/services"
+	"finanzas-backend/internal/mortgage/interfaces/rest/resources"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultIndexListDays es el rango consultado cuando no se indica desde
+const defaultIndexListDays = 30
+
+type PriceIndexController struct {
+	queryService services.PriceIndexQueryService
+}
+
+func NewPriceIndexController(queryService services.PriceIndexQueryService) *PriceIndexController {
+	return &PriceIndexController{queryService: queryService}
+}
+
+// ListPriceIndex godoc
+// @Summary List VAC/CPI index values
+// @Description Lists the daily VAC (and CPI, when published) values imported from the index file, used by VAC mortgages
+// @Tags Mortgage
+// @Produce json
+// @Param from query string false "From date (YYYY-MM-DD), defaults to 30 days before to"
+// @Param to query string false "To date (YYYY-MM-DD), defaults to today"
+// @Success 200 {array} resources.PriceIndexResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/price-index [get]
+func (c *PriceIndexController) ListPriceIndex(ctx *gin.Context) {
+	now := time.Now()
+	to, ok := parseIndexDate(ctx, "to", time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC))
+	if !ok {
+		return
+	}
+	from, ok := parseIndexDate(ctx, "from", to.AddDate(0, 0, -defaultIndexListDays))
+	if !ok {
+		return
+	}
+
+	query, err := queries.NewListPriceIndexQuery(from, to)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	values, err := c.queryService.HandleList(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]resources.PriceIndexResource, 0, len(values))
+	for _, value := range values {
+		response = append(response, resources.TransformToPriceIndexResource(value))
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func parseIndexDate(ctx *gin.Context, name string, fallback time.Time) (time.Time, bool) {
+	value := ctx.Query(name)
+	if value == "" {
+		return fallback, true
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid " + name + " date, expected YYYY-MM-DD"})
+		return time.Time{}, false
+	}
+	return date, true
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"finanzas-backend/internal/mortgage/application/acl"
+	"github.com/gin-gonic/gin"
+)
+
+// RequireAdmin restringe el endpoint a sesiones de usuario cuyo email está en adminEmails
+// (ADMIN_EMAILS). Va después de JWTAuthMiddleware; las API keys no se aceptan.
+func RequireAdmin(externalAuthService *acl.ExternalAuthenticationService, adminEmails []string) gin.HandlerFunc {
+	admins := make(map[string]bool, len(adminEmails))
+	for _, email := range adminEmails {
+		if email = strings.ToLower(strings.TrimSpace(email)); email != "" {
+			admins[email] = true
+		}
+	}
+
+	return func(c *gin.Context) {
+		if c.GetString("auth_method") == AuthMethodAPIKey {
+			c.JSON(http.StatusForbidden, gin.H{"error": "This endpoint requires a user session, API keys are not allowed"})
+			c.Abort()
+			return
+		}
+
+		email, err := externalAuthService.GetUserEmail(c.Request.Context(), c.GetString("user_id"))
+		if err != nil || !admins[strings.ToLower(email)] {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Administrator access required"})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -4,11 +4,17 @@ import (
 	"net/http"
 	"strings"
 
-	"github.com/gin-gonic/gin"
 	"finanzas-backend/internal/mortgage/application/acl"
+	"github.com/gin-gonic/gin"
 )
 
-// JWTAuthMiddleware verifica el token JWT usando el servicio externo de autenticación (ACL)
+// Métodos de autenticación guardados en el contexto bajo "auth_method"
+const (
+	AuthMethodBearer = "bearer"
+	AuthMethodAPIKey = "api_key"
+)
+
+// JWTAuthMiddleware verifica el token JWT (o una API key) usando el servicio externo de autenticación (ACL)
 func JWTAuthMiddleware(externalAuthService *acl.ExternalAuthenticationService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Obtener el token del header Authorization
@@ -19,14 +25,31 @@ func JWTAuthMiddleware(externalAuthService *acl.ExternalAuthenticationService) g
 			return
 		}
 
-		// Verificar formato "Bearer <token>"
+		// Verificar formato "Bearer <token>" o "ApiKey <key>"
 		parts := strings.SplitN(authHeader, " ", 2)
-		if len(parts) != 2 || parts[0] != "Bearer" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization format. Use: Bearer <token>"})
+		if len(parts) != 2 || (parts[0] != "Bearer" && parts[0] != "ApiKey") {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization format. Use: Bearer <token> or ApiKey <key>"})
 			c.Abort()
 			return
 		}
 
+		if parts[0] == "ApiKey" {
+			// Validar la API key a través del servicio externo (ACL)
+			userID, scopes, err := externalAuthService.ValidateAPIKeyAndGetUserID(c.Request.Context(), parts[1])
+			if err != nil {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid, expired or revoked API key"})
+				c.Abort()
+				return
+			}
+
+			c.Set("user_id", userID)
+			c.Set("auth_method", AuthMethodAPIKey)
+			c.Set("api_key_scopes", scopes)
+
+			c.Next()
+			return
+		}
+
 		tokenString := parts[1]
 
 		// Validar el token a través del servicio externo (ACL)
@@ -39,6 +62,7 @@ func JWTAuthMiddleware(externalAuthService *acl.ExternalAuthenticationService) g
 
 		// Guardar información del usuario en el contexto (UUID string)
 		c.Set("user_id", userID)
+		c.Set("auth_method", AuthMethodBearer)
 
 		c.Next()
 	}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireScope exige que una petición autenticada con API key tenga el scope indicado.
+// Las sesiones de usuario (Bearer) tienen acceso completo.
+func RequireScope(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString("auth_method") != AuthMethodAPIKey {
+			c.Next()
+			return
+		}
+
+		for _, granted := range c.GetStringSlice("api_key_scopes") {
+			if granted == scope {
+				c.Next()
+				return
+			}
+		}
+
+		c.JSON(http.StatusForbidden, gin.H{"error": "API key is missing required scope: " + scope})
+		c.Abort()
+	}
+}
+
+// RequireUserSession rechaza las API keys; se usa en la gestión de la cuenta
+func RequireUserSession() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString("auth_method") == AuthMethodAPIKey {
+			c.JSON(http.StatusForbidden, gin.H{"error": "This endpoint requires a user session, API keys are not allowed"})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,76 @@
+package resources
+
+import "finanzas-backend/internal/mortgage/domain/model/entities"
+
+// CalculationEngineResource es la versión del motor y los parámetros efectivos con los que se calculó
+type CalculationEngineResource struct {
+	Version                    string  `json:"version"`
+	CuotasPorAnio              float64 `json:"cuotas_por_anio"`
+	NumeroCuotas               int     `json:"numero_cuotas"`
+	PeriodosGracia             int     `json:"periodos_gracia"`
+	TasaAnualDecimal           float64 `json:"tasa_anual_decimal"`
+	TEA                        float64 `json:"tea"`
+	TasaPeriodo                float64 `json:"tasa_periodo"`
+	SeguroDesgravamenPeriodo   float64 `json:"seguro_desgravamen_periodo"` // Por todos los asegurados
+	SeguroInmuebleAnualDecimal float64 `json:"seguro_inmueble_anual_decimal"`
+	SeguroInmueblePeriodo      float64 `json:"seguro_inmueble_periodo"`
+	CuotaBalonDecimal          float64 `json:"cuota_balon_decimal"`
+	IncrementoCuotaDecimal     float64 `json:"incremento_cuota_decimal"`
+	InflacionAnualDecimal      float64 `json:"inflacion_anual_decimal"`
+	TasaDescuento              float64 `json:"tasa_descuento"` // COK del VAN, 0 si no se calculó
+}
+
+// RecomputationResource compara el resultado guardado con el del motor vigente
+type RecomputationResource struct {
+	MortgageID           uint64                     `json:"mortgage_id"`
+	VersionMotorGuardada string                     `json:"version_motor_guardada"` // Vacía si es anterior al registro del motor
+	VersionMotorActual   string                     `json:"version_motor_actual"`
+	ResultadosCambian    bool                       `json:"resultados_cambian"`
+	Parametros           []VersionChangeResource    `json:"parametros"`
+	Resultados           []VersionChangeResource    `json:"resultados"`
+	CuotasAntes          int                        `json:"cuotas_antes"`
+	CuotasDespues        int                        `json:"cuotas_despues"`
+	Cronograma           []ScheduleChangeResource   `json:"cronograma"` // Solo las cuotas que cambiaron
+	MotorActual          *CalculationEngineResource `json:"motor_actual"`
+}
+
+// TransformToCalculationEngineResource transforma los parámetros del cálculo; nil si no se registraron
+func TransformToCalculationEngineResource(mortgage *entities.Mortgage) *CalculationEngineResource {
+	if mortgage.EngineVersion() == "" {
+		return nil
+	}
+	params := mortgage.CalculationParameters()
+	return &CalculationEngineResource{
+		Version:                    mortgage.EngineVersion(),
+		CuotasPorAnio:              params.PeriodsPerYear,
+		NumeroCuotas:               params.TotalPeriods,
+		PeriodosGracia:             params.GracePeriods,
+		TasaAnualDecimal:           params.AnnualRate,
+		TEA:                        params.EffectiveAnnualRate,
+		TasaPeriodo:                params.PeriodicRate,
+		SeguroDesgravamenPeriodo:   params.LifeInsuranceRate,
+		SeguroInmuebleAnualDecimal: params.PropertyInsuranceRate,
+		SeguroInmueblePeriodo:      params.PropertyInsurancePerPeriod,
+		CuotaBalonDecimal:          params.BalloonRate,
+		IncrementoCuotaDecimal:     params.StepUpRate,
+		InflacionAnualDecimal:      params.InflationRate,
+		TasaDescuento:              params.DiscountRate,
+	}
+}
+
+// TransformToRecomputationResource transforma el recálculo con el motor vigente a su recurso
+func TransformToRecomputationResource(recomputation *entities.MortgageRecomputation) RecomputationResource {
+	changes := recomputation.Changes
+	return RecomputationResource{
+		MortgageID:           changes.MortgageID,
+		VersionMotorGuardada: recomputation.Saved.EngineVersion(),
+		VersionMotorActual:   recomputation.Recomputed.EngineVersion(),
+		ResultadosCambian:    recomputation.ResultsChanged(),
+		Parametros:           transformVersionChanges(changes.Parameters),
+		Resultados:           transformVersionChanges(changes.Outputs),
+		CuotasAntes:          changes.PeriodsBefore,
+		CuotasDespues:        changes.PeriodsAfter,
+		Cronograma:           transformScheduleChanges(changes.Schedule),
+		MotorActual:          TransformToCalculationEngineResource(recomputation.Recomputed),
+	}
+}
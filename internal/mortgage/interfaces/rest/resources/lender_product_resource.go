@@ -0,0 +1,108 @@
+package resources
+
+import (
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+	"time"
+)
+
+// RateTierResource es un tramo de TEA (en %) por plazo en meses y cuota inicial (en % del precio)
+type RateTierResource struct {
+	PlazoMinMeses      int     `json:"plazo_min_meses" binding:"required,gt=0"`
+	PlazoMaxMeses      int     `json:"plazo_max_meses" binding:"required,gtefield=PlazoMinMeses"`
+	CuotaInicialMinPct float64 `json:"cuota_inicial_min_pct" binding:"gte=0,lte=100"`
+	CuotaInicialMaxPct float64 `json:"cuota_inicial_max_pct" binding:"required,gtefield=CuotaInicialMinPct,lte=100"`
+	TEAMin             float64 `json:"tea_min" binding:"required,gt=0"`
+	TEAMax             float64 `json:"tea_max" binding:"required,gtefield=TEAMin"`
+}
+
+// SaveLenderProductRequest crea o reemplaza un producto del catálogo
+type SaveLenderProductRequest struct {
+	Banco          string             `json:"banco" binding:"required,max=100"`
+	Nombre         string             `json:"nombre" binding:"required,max=150"`
+	Moneda         string             `json:"moneda" binding:"required,oneof=PEN USD"`
+	TramosTasa     []RateTierResource `json:"tramos_tasa" binding:"required,min=1,dive"`
+	SeguroDesg     float64            `json:"seguro_desgravamen" binding:"gte=0"`
+	SeguroInmueble float64            `json:"seguro_inmueble_anual" binding:"gte=0"`
+	ComisionEval   float64            `json:"comision_evaluacion" binding:"gte=0"`
+	ComisionDesem  float64            `json:"comision_desembolso" binding:"gte=0"`
+	Portes         float64            `json:"portes" binding:"gte=0"`
+	GastosAdm      float64            `json:"gastos_administrativos" binding:"gte=0"`
+	Activo         *bool              `json:"activo,omitempty"` // Por defecto true
+
+	// Unidad de los seguros: PERCENT (0.03) o DECIMAL (0.0003); los tramos de TEA siempre van en %
+	UnidadTasas string `json:"unidad_tasas,omitempty" binding:"omitempty,oneof=PERCENT DECIMAL"`
+}
+
+// LenderProductResource es un producto del catálogo de entidades financieras
+type LenderProductResource struct {
+	ID             uint64             `json:"id"`
+	Banco          string             `json:"banco"`
+	Nombre         string             `json:"nombre"`
+	Moneda         string             `json:"moneda"`
+	TramosTasa     []RateTierResource `json:"tramos_tasa"`
+	SeguroDesg     float64            `json:"seguro_desgravamen"`
+	SeguroInmueble float64            `json:"seguro_inmueble_anual"`
+	ComisionEval   float64            `json:"comision_evaluacion"`
+	ComisionDesem  float64            `json:"comision_desembolso"`
+	Portes         float64            `json:"portes"`
+	GastosAdm      float64            `json:"gastos_administrativos"`
+	Activo         bool               `json:"activo"`
+	UpdatedAt      time.Time          `json:"updated_at"`
+
+	// Los seguros se responden en decimal; los tramos de TEA en %
+	UnidadTasas string `json:"unidad_tasas"`
+}
+
+// ToRateTiers convierte los tramos de la solicitud en value objects
+func (r SaveLenderProductRequest) ToRateTiers() ([]valueobjects.RateTier, error) {
+	tiers := make([]valueobjects.RateTier, 0, len(r.TramosTasa))
+	for _, tramo := range r.TramosTasa {
+		tier, err := valueobjects.NewRateTier(
+			tramo.PlazoMinMeses,
+			tramo.PlazoMaxMeses,
+			tramo.CuotaInicialMinPct,
+			tramo.CuotaInicialMaxPct,
+			tramo.TEAMin,
+			tramo.TEAMax,
+		)
+		if err != nil {
+			return nil, err
+		}
+		tiers = append(tiers, tier)
+	}
+	return tiers, nil
+}
+
+// TransformToLenderProductResource transforma una entidad LenderProduct a su recurso
+func TransformToLenderProductResource(product *entities.LenderProduct) LenderProductResource {
+	tramos := make([]RateTierResource, 0, len(product.RateTiers()))
+	for _, tier := range product.RateTiers() {
+		tramos = append(tramos, RateTierResource{
+			PlazoMinMeses:      tier.MinTermMonths(),
+			PlazoMaxMeses:      tier.MaxTermMonths(),
+			CuotaInicialMinPct: tier.MinDownPayment(),
+			CuotaInicialMaxPct: tier.MaxDownPayment(),
+			TEAMin:             tier.MinRate(),
+			TEAMax:             tier.MaxRate(),
+		})
+	}
+
+	return LenderProductResource{
+		ID:             product.ID().Value(),
+		Banco:          product.Bank(),
+		Nombre:         product.Name(),
+		Moneda:         product.Currency().String(),
+		TramosTasa:     tramos,
+		SeguroDesg:     product.LifeInsuranceRate(),
+		SeguroInmueble: product.PropertyInsuranceRate(),
+		ComisionEval:   product.EvaluationFee(),
+		ComisionDesem:  product.DisbursementFee(),
+		Portes:         product.Portes(),
+		GastosAdm:      product.AdministrationFee(),
+		Activo:         product.IsActive(),
+		UpdatedAt:      product.UpdatedAt(),
+
+		UnidadTasas: valueobjects.RateUnitDecimal.String(),
+	}
+}
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
 	"math"
 	"time"
 )
@@ -12,8 +13,8 @@ type CalculateMortgageRequest struct {
 	CuotaInicial    float64 `json:"cuota_inicial" binding:"gte=0"`
 	MontoPrestamo   float64 `json:"monto_prestamo" binding:"required,gt=0"`
 	BonoTechoPropio float64 `json:"bono_techo_propio" binding:"gte=0"`
-	TasaAnual       float64 `json:"tasa_anual" binding:"required,gte=0"`
-	TipoTasa        string  `json:"tipo_tasa" binding:"required,oneof=NOMINAL EFFECTIVE"`
+	TasaAnual       float64 `json:"tasa_anual" binding:"gte=0"`                            // Opcional con producto_id
+	TipoTasa        string  `json:"tipo_tasa" binding:"omitempty,oneof=NOMINAL EFFECTIVE"` // Opcional con producto_id
 	Frecuencia      string  `json:"frecuencia,omitempty" binding:"omitempty,oneof=MENSUAL BIMESTRAL TRIMESTRAL"`
 	FrecuenciaPago  int     `json:"frecuencia_pago" binding:"omitempty,gt=0"`
 	DiasAnio        int     `json:"dias_anio" binding:"required,gt=0"`
@@ -21,7 +22,7 @@ type CalculateMortgageRequest struct {
 	NumeroAnios     int     `json:"numero_anios" binding:"omitempty,gte=0"`
 	MesesGracia     int     `json:"meses_gracia" binding:"gte=0"`
 	TipoGracia      string  `json:"tipo_gracia" binding:"required,oneof=NONE TOTAL PARTIAL"`
-	Moneda          string  `json:"moneda" binding:"required,oneof=PEN USD"`
+	Moneda          string  `json:"moneda" binding:"omitempty,oneof=PEN USD VAC"` // Opcional con producto_id
 	TasaDescuento   float64 `json:"tasa_descuento" binding:"gte=0"`
 	COK             float64 `json:"cok" binding:"omitempty,gte=0"`
 	Portes          float64 `json:"portes" binding:"omitempty,gte=0"`
@@ -31,6 +32,21 @@ type CalculateMortgageRequest struct {
 	ComisionEval    float64 `json:"comision_evaluacion" binding:"omitempty,gte=0"`
 	ComisionDesem   float64 `json:"comision_desembolso" binding:"omitempty,gte=0"`
 	CostosMensuales float64 `json:"costos_mensuales_adicionales" binding:"omitempty,gte=0"`
+	CoPrestatarioID string  `json:"co_prestatario_id,omitempty" binding:"omitempty,uuid"`
+	ProductoID      uint64  `json:"producto_id,omitempty"`                                // Producto del catálogo que prellena tasa, seguros y comisiones
+	InflacionAnual  float64 `json:"inflacion_anual,omitempty" binding:"omitempty,gte=0"`  // Solo VAC: proyección de las cuotas en soles
+	IncrementoCuota float64 `json:"incremento_cuota,omitempty" binding:"omitempty,gte=0"` // Cuotas escalonadas: % de aumento
+	IncrementoCada  int     `json:"incremento_cada,omitempty" binding:"omitempty,gt=0"`   // Cuotas escalonadas: cada cuántos periodos
+	TasaCuotaBalon  float64 `json:"tasa_cuota_balon,omitempty" binding:"omitempty,gte=0"` // Fracción del principal diferida, en la unidad de unidad_tasas
+
+	// Periodos de gracia negociados a mitad del crédito
+	VentanasGracia []GraceWindowResource `json:"ventanas_gracia,omitempty" binding:"omitempty,max=12,dive"`
+
+	// Penalidad por prepago: tasa sobre el capital cancelado anticipadamente, en la unidad de unidad_tasas
+	TasaPenalidadPrepago float64 `json:"tasa_penalidad_prepago,omitempty" binding:"omitempty,gte=0"`
+
+	// Unidad de todas las tasas: PERCENT (8.5) o DECIMAL (0.085); sin ella se rechazan las tasas entre 0 y 1
+	UnidadTasas string `json:"unidad_tasas,omitempty" binding:"omitempty,oneof=PERCENT DECIMAL"`
 }
 
 // UpdateMortgageRequest representa la solicitud para actualizar un crédito hipotecario
@@ -48,7 +64,7 @@ type UpdateMortgageRequest struct {
 	NumeroAnios     *int     `json:"numero_anios,omitempty" binding:"omitempty,gte=0"`
 	MesesGracia     *int     `json:"meses_gracia,omitempty" binding:"omitempty,gte=0"`
 	TipoGracia      *string  `json:"tipo_gracia,omitempty" binding:"omitempty,oneof=NONE TOTAL PARTIAL"`
-	Moneda          *string  `json:"moneda,omitempty" binding:"omitempty,oneof=PEN USD"`
+	Moneda          *string  `json:"moneda,omitempty" binding:"omitempty,oneof=PEN USD VAC"`
 	TasaDescuento   *float64 `json:"tasa_descuento,omitempty" binding:"omitempty,gte=0"`
 	COK             *float64 `json:"cok,omitempty" binding:"omitempty,gte=0"`
 	Portes          *float64 `json:"portes,omitempty" binding:"omitempty,gte=0"`
@@ -58,6 +74,27 @@ type UpdateMortgageRequest struct {
 	ComisionEval    *float64 `json:"comision_evaluacion,omitempty" binding:"omitempty,gte=0"`
 	ComisionDesem   *float64 `json:"comision_desembolso,omitempty" binding:"omitempty,gte=0"`
 	CostosMensuales *float64 `json:"costos_mensuales_adicionales,omitempty" binding:"omitempty,gte=0"`
+	CoPrestatarioID *string  `json:"co_prestatario_id,omitempty"` // "" quita el co-prestatario
+	InflacionAnual  *float64 `json:"inflacion_anual,omitempty" binding:"omitempty,gte=0"`
+	IncrementoCuota *float64 `json:"incremento_cuota,omitempty" binding:"omitempty,gte=0"` // 0 vuelve a cuotas fijas
+	IncrementoCada  *int     `json:"incremento_cada,omitempty" binding:"omitempty,gt=0"`
+	TasaCuotaBalon  *float64 `json:"tasa_cuota_balon,omitempty" binding:"omitempty,gte=0"` // 0 quita la cuota balón
+
+	// [] quita las ventanas de gracia
+	VentanasGracia *[]GraceWindowResource `json:"ventanas_gracia,omitempty" binding:"omitempty,max=12,dive"`
+
+	// 0 quita la penalidad; no recalcula el cronograma
+	TasaPenalidadPrepago *float64 `json:"tasa_penalidad_prepago,omitempty" binding:"omitempty,gte=0"`
+
+	// Unidad de las tasas enviadas: PERCENT (8.5) o DECIMAL (0.085)
+	UnidadTasas string `json:"unidad_tasas,omitempty" binding:"omitempty,oneof=PERCENT DECIMAL"`
+}
+
+// GraceWindowResource es un periodo de gracia negociado a mitad del crédito
+type GraceWindowResource struct {
+	PeriodoInicio int    `json:"periodo_inicio" binding:"required,gt=0"`
+	Periodos      int    `json:"periodos" binding:"required,gt=0"`
+	Tipo          string `json:"tipo" binding:"required,oneof=TOTAL PARTIAL"`
 }
 
 // PaymentScheduleItemResource representa un item del cronograma
@@ -77,6 +114,14 @@ type PaymentScheduleItemResource struct {
 	SaldoFinal            float64 `json:"saldo_final"`
 	EsPeriodoGracia       bool    `json:"es_periodo_gracia"`
 	TipoGracia            string  `json:"tipo_gracia,omitempty"`
+	AmortizacionNegativa  bool    `json:"amortizacion_negativa,omitempty"` // La cuota escalonada no cubre el interés
+	CuotaBalon            float64 `json:"cuota_balon,omitempty"`           // Cuota balón incluida en la cuota del periodo
+	Vencida               bool    `json:"vencida,omitempty"`               // Cuota impaga trasladada a la reprogramación
+
+	// Solo en créditos en Soles VAC (el resto de montos de la fila está en unidades VAC)
+	VACProyectado   float64 `json:"vac_proyectado,omitempty"`
+	CuotaTotalSoles float64 `json:"cuota_total_soles,omitempty"`
+	SaldoFinalSoles float64 `json:"saldo_final_soles,omitempty"`
 }
 
 // MortgageResponse representa la respuesta completa con todos los cálculos
@@ -105,12 +150,35 @@ type MortgageResponse struct {
 	CostosMensuales float64 `json:"costos_mensuales_adicionales"`
 	CuotasPorAnio   int     `json:"cuotas_por_anio"`
 	NumeroCuotas    int     `json:"numero_cuotas"`
+	CoPrestatarioID string  `json:"co_prestatario_id,omitempty"`
+	Asegurados      int     `json:"asegurados_desgravamen"`
+	ProductoID      uint64  `json:"producto_id,omitempty"`
+	IncrementoCuota float64 `json:"incremento_cuota,omitempty"`
+	IncrementoCada  int     `json:"incremento_cada,omitempty"`
+	TasaCuotaBalon  float64 `json:"tasa_cuota_balon,omitempty"`
+
+	VentanasGracia []GraceWindowResource `json:"ventanas_gracia,omitempty"`
+
+	TasaPenalidadPrepago float64 `json:"tasa_penalidad_prepago,omitempty"`
+
+	// Las tasas de la respuesta se expresan siempre en decimal
+	UnidadTasas string `json:"unidad_tasas"`
+
+	// Solo en versiones reprogramadas: simulación original y última cuota que se conserva de ella
+	ReprogramadoDe        uint64 `json:"reprogramado_de,omitempty"`
+	PeriodoReprogramacion int    `json:"periodo_reprogramacion,omitempty"`
+
+	// Solo en créditos desembolsados: desde cuándo vencen las cuotas y la TEA moratoria
+	FechaDesembolso string  `json:"fecha_desembolso,omitempty"`
+	TasaMoratoria   float64 `json:"tasa_moratoria,omitempty"`
 
 	// Resultados calculados
 	SaldoFinanciar    float64                       `json:"saldo_financiar"`
 	TasaPeriodo       float64                       `json:"tasa_periodo"`
 	CuotaFija         float64                       `json:"cuota_fija"`
 	CuotaTotal        float64                       `json:"cuota_total"`
+	CuotaFinal        float64                       `json:"cuota_final,omitempty"` // Última cuota de un cronograma escalonado
+	CuotaBalon        float64                       `json:"cuota_balon,omitempty"` // Monto diferido que se paga con la última cuota
 	CronogramaPagos   []PaymentScheduleItemResource `json:"cronograma_pagos"`
 	TotalIntereses    float64                       `json:"total_intereses"`
 	TotalPagado       float64                       `json:"total_pagado"`
@@ -124,9 +192,49 @@ type MortgageResponse struct {
 	TEA               float64                       `json:"tea"`
 	TCEA              float64                       `json:"tcea"`
 
+	// Capacidad de pago con el ingreso familiar (titular más co-prestatario)
+	IngresoFamiliar         float64 `json:"ingreso_familiar"`
+	DeudasMensuales         float64 `json:"deudas_mensuales"`
+	RatioCuotaIngreso       float64 `json:"ratio_cuota_ingreso"`
+	RatioEndeudamientoTotal float64 `json:"ratio_endeudamiento_total"`
+	EsAsequible             bool    `json:"es_asequible"`
+
+	// Proyección en soles de un crédito en Soles VAC (omitido en PEN y USD)
+	Indexacion *IndexationResource `json:"indexacion,omitempty"`
+
+	// Montos principales en la otra moneda (omitido si no hay tipo de cambio vigente)
+	Equivalente *CurrencyEquivalentResource `json:"equivalente,omitempty"`
+
+	// Motor de cálculo y parámetros efectivos (omitido en simulaciones anteriores a su registro)
+	Motor *CalculationEngineResource `json:"motor,omitempty"`
+
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// CurrencyEquivalentResource reporta los montos principales del crédito en la otra moneda (PEN o USD)
+type CurrencyEquivalentResource struct {
+	Moneda            string  `json:"moneda"`
+	TipoCambioCompra  float64 `json:"tipo_cambio_compra"`
+	TipoCambioVenta   float64 `json:"tipo_cambio_venta"`
+	FechaTipoCambio   string  `json:"fecha_tipo_cambio"`
+	PrecioVenta       float64 `json:"precio_venta"`
+	MontoPrestamo     float64 `json:"monto_prestamo"`
+	CuotaTotal        float64 `json:"cuota_total"`
+	TotalPagadoCargos float64 `json:"total_pagado_con_cargos"`
+	IngresoFamiliar   float64 `json:"ingreso_familiar"`
+}
+
+// IndexationResource resume la proyección en soles de un crédito en Soles VAC
+type IndexationResource struct {
+	MonedaPago         string  `json:"moneda_pago"`
+	ValorVACBase       float64 `json:"valor_vac_base"`
+	FechaVACBase       string  `json:"fecha_vac_base"`
+	InflacionAnual     float64 `json:"inflacion_anual"`
+	MontoPrestamoSoles float64 `json:"monto_prestamo_soles"`
+	CuotaTotalSoles    float64 `json:"cuota_total_soles"` // Primera cuota proyectada
+	TotalPagadoSoles   float64 `json:"total_pagado_con_cargos_soles"`
+}
+
 // MortgageSummaryResource representa un resumen de hipoteca (para listas)
 type MortgageSummaryResource struct {
 	ID            uint64    `json:"id"`
@@ -161,6 +269,12 @@ func TransformToMortgageResponse(mortgage *entities.Mortgage) MortgageResponse {
 				SaldoFinal:            item.RemainingBalance,
 				EsPeriodoGracia:       item.IsGracePeriod,
 				TipoGracia:            item.GraceType,
+				AmortizacionNegativa:  item.NegativeAmortization,
+				CuotaBalon:            item.BalloonPayment,
+				Vencida:               item.Overdue,
+				VACProyectado:         item.ProjectedIndex,
+				CuotaTotalSoles:       item.NominalTotalInstallment,
+				SaldoFinalSoles:       item.NominalBalance,
 			})
 		}
 	}
@@ -176,50 +290,155 @@ func TransformToMortgageResponse(mortgage *entities.Mortgage) MortgageResponse {
 		cuotaTotal = scheduleItems[0].CuotaTotal
 	}
 
+	cuotaFinal := 0.0
+	if (mortgage.IsGraduated() || mortgage.HasBalloon()) && len(scheduleItems) > 0 {
+		cuotaFinal = scheduleItems[len(scheduleItems)-1].Cuota
+	}
+
+	cuotaBalon := 0.0
+	if mortgage.PaymentSchedule() != nil {
+		cuotaBalon = mortgage.PaymentSchedule().BalloonPayment()
+	}
+
 	tea := math.Pow(1+mortgage.PeriodicRate(), mortgage.PeriodsPerYear()) - 1
 
+	var indexacion *IndexationResource
+	if mortgage.Currency().IsIndexed() {
+		indexacion = transformToIndexation(mortgage, scheduleItems)
+	}
+
+	fechaDesembolso := ""
+	if mortgage.IsDisbursed() {
+		fechaDesembolso = mortgage.DisbursementDate().Format("2006-01-02")
+	}
+
 	return MortgageResponse{
-		ID:                mortgage.ID().Value(),
-		UserID:            mortgage.UserID().String(),
-		PrecioVenta:       mortgage.PropertyPrice(),
-		CuotaInicial:      mortgage.DownPayment(),
-		MontoPrestamo:     mortgage.LoanAmount(),
-		BonoTechoPropio:   mortgage.BonoTechoPropio(),
-		TasaAnual:         mortgage.InterestRate(),
-		TipoTasa:          mortgage.RateType().String(),
-		PlazoMeses:        mortgage.TermMonths(),
-		NumeroAnios:       mortgage.TermYears(),
-		MesesGracia:       mortgage.GracePeriodMonths(),
-		TipoGracia:        mortgage.GracePeriodType().String(),
-		Moneda:            mortgage.Currency().String(),
-		FrecuenciaPago:    mortgage.PaymentFrequencyDays(),
-		DiasAnio:          mortgage.DaysInYear(),
-		Portes:            mortgage.Portes(),
-		GastosAdm:         mortgage.AdministrationFee(),
-		SeguroDesg:        mortgage.LifeInsuranceRate(),
-		SeguroInmueble:    mortgage.PropertyInsuranceRate(),
-		ComisionEval:      mortgage.EvaluationFee(),
-		ComisionDesem:     mortgage.DisbursementFee(),
-		CostosMensuales:   mortgage.AdditionalCosts(),
-		CuotasPorAnio:     cuotasPorAnio,
-		NumeroCuotas:      numeroCuotas,
-		SaldoFinanciar:    mortgage.PrincipalFinanced(),
-		TasaPeriodo:       mortgage.PeriodicRate(),
-		CuotaFija:         mortgage.FixedInstallment(),
-		CuotaTotal:        cuotaTotal,
-		CronogramaPagos:   scheduleItems,
-		TotalIntereses:    mortgage.TotalInterestPaid(),
-		TotalPagado:       mortgage.TotalPaid(),
-		TotalPagadoCargos: mortgage.TotalPaidWithFees(),
-		TotalCargos:       mortgage.TotalCharges(),
-		TotalSeguros:      mortgage.TotalInsurance(),
-		TotalGastos:       mortgage.TotalAdmin(),
-		VAN:               mortgage.NPV(),
-		TIR:               mortgage.IRR(),
-		TIRFlujo:          mortgage.FlowIRR(),
-		TEA:               tea,
-		TCEA:              mortgage.TCEA(),
-		CreatedAt:         mortgage.CreatedAt(),
+		ID:                      mortgage.ID().Value(),
+		UserID:                  mortgage.UserID().String(),
+		PrecioVenta:             mortgage.PropertyPrice(),
+		CuotaInicial:            mortgage.DownPayment(),
+		MontoPrestamo:           mortgage.LoanAmount(),
+		BonoTechoPropio:         mortgage.BonoTechoPropio(),
+		TasaAnual:               mortgage.InterestRate(),
+		TipoTasa:                mortgage.RateType().String(),
+		PlazoMeses:              mortgage.TermMonths(),
+		NumeroAnios:             mortgage.TermYears(),
+		MesesGracia:             mortgage.GracePeriodMonths(),
+		TipoGracia:              mortgage.GracePeriodType().String(),
+		Moneda:                  mortgage.Currency().String(),
+		FrecuenciaPago:          mortgage.PaymentFrequencyDays(),
+		DiasAnio:                mortgage.DaysInYear(),
+		Portes:                  mortgage.Portes(),
+		GastosAdm:               mortgage.AdministrationFee(),
+		SeguroDesg:              mortgage.LifeInsuranceRate(),
+		SeguroInmueble:          mortgage.PropertyInsuranceRate(),
+		ComisionEval:            mortgage.EvaluationFee(),
+		ComisionDesem:           mortgage.DisbursementFee(),
+		CostosMensuales:         mortgage.AdditionalCosts(),
+		CuotasPorAnio:           cuotasPorAnio,
+		NumeroCuotas:            numeroCuotas,
+		CoPrestatarioID:         mortgage.CoBorrowerID(),
+		Asegurados:              mortgage.InsuredParties(),
+		ProductoID:              mortgage.ProductID(),
+		IncrementoCuota:         mortgage.StepUpRate(),
+		IncrementoCada:          mortgage.StepUpEveryPeriods(),
+		TasaCuotaBalon:          mortgage.BalloonRate(),
+		VentanasGracia:          transformToGraceWindows(mortgage.GraceWindows()),
+		ReprogramadoDe:          mortgage.ReprogrammedFrom(),
+		PeriodoReprogramacion:   mortgage.ReprogrammingPeriod(),
+		TasaPenalidadPrepago:    mortgage.PrepaymentPenaltyRate(),
+		UnidadTasas:             valueobjects.RateUnitDecimal.String(),
+		FechaDesembolso:         fechaDesembolso,
+		TasaMoratoria:           mortgage.LateInterestRate(),
+		SaldoFinanciar:          mortgage.PrincipalFinanced(),
+		TasaPeriodo:             mortgage.PeriodicRate(),
+		CuotaFija:               mortgage.FixedInstallment(),
+		CuotaTotal:              cuotaTotal,
+		CuotaFinal:              cuotaFinal,
+		CuotaBalon:              cuotaBalon,
+		CronogramaPagos:         scheduleItems,
+		TotalIntereses:          mortgage.TotalInterestPaid(),
+		TotalPagado:             mortgage.TotalPaid(),
+		TotalPagadoCargos:       mortgage.TotalPaidWithFees(),
+		TotalCargos:             mortgage.TotalCharges(),
+		TotalSeguros:            mortgage.TotalInsurance(),
+		TotalGastos:             mortgage.TotalAdmin(),
+		VAN:                     mortgage.NPV(),
+		TIR:                     mortgage.IRR(),
+		TIRFlujo:                mortgage.FlowIRR(),
+		TEA:                     tea,
+		TCEA:                    mortgage.TCEA(),
+		IngresoFamiliar:         mortgage.HouseholdIncome(),
+		DeudasMensuales:         mortgage.ExistingDebtPayments(),
+		RatioCuotaIngreso:       mortgage.PaymentToIncomeRatio(),
+		RatioEndeudamientoTotal: mortgage.DebtServiceRatio(),
+		EsAsequible:             mortgage.IsAffordable(),
+		Indexacion:              indexacion,
+		Motor:                   TransformToCalculationEngineResource(mortgage),
+		CreatedAt:               mortgage.CreatedAt(),
+	}
+}
+
+func transformToIndexation(mortgage *entities.Mortgage, scheduleItems []PaymentScheduleItemResource) *IndexationResource {
+	resource := &IndexationResource{
+		MonedaPago:         mortgage.Currency().SettlementCurrency().String(),
+		ValorVACBase:       mortgage.IndexBase(),
+		FechaVACBase:       mortgage.IndexDate().Format("2006-01-02"),
+		InflacionAnual:     mortgage.InflationRate(),
+		MontoPrestamoSoles: mortgage.LoanAmount() * mortgage.IndexBase(),
+	}
+	for i, item := range scheduleItems {
+		if i == 0 {
+			resource.CuotaTotalSoles = item.CuotaTotalSoles
+		}
+		resource.TotalPagadoSoles += item.CuotaTotalSoles
+	}
+	return resource
+}
+
+// ToGraceWindows convierte las ventanas de gracia de la solicitud en value objects
+func ToGraceWindows(items []GraceWindowResource) ([]valueobjects.GraceWindow, error) {
+	windows := make([]valueobjects.GraceWindow, 0, len(items))
+	for _, item := range items {
+		window, err := valueobjects.NewGraceWindow(item.PeriodoInicio, item.Periodos, valueobjects.GracePeriodType(item.Tipo))
+		if err != nil {
+			return nil, err
+		}
+		windows = append(windows, window)
+	}
+	return windows, nil
+}
+
+func transformToGraceWindows(windows []valueobjects.GraceWindow) []GraceWindowResource {
+	if len(windows) == 0 {
+		return nil
+	}
+	items := make([]GraceWindowResource, 0, len(windows))
+	for _, window := range windows {
+		items = append(items, GraceWindowResource{
+			PeriodoInicio: window.StartPeriod(),
+			Periodos:      window.Periods(),
+			Tipo:          window.Type().String(),
+		})
+	}
+	return items
+}
+
+// TransformToCurrencyEquivalent expresa los montos principales de la respuesta en la otra moneda
+func TransformToCurrencyEquivalent(response MortgageResponse, rate valueobjects.ExchangeRate) *CurrencyEquivalentResource {
+	from := valueobjects.Currency(response.Moneda)
+	to := from.OtherCurrency()
+
+	return &CurrencyEquivalentResource{
+		Moneda:            to.String(),
+		TipoCambioCompra:  rate.Buy(),
+		TipoCambioVenta:   rate.Sell(),
+		FechaTipoCambio:   rate.Date().Format("2006-01-02"),
+		PrecioVenta:       rate.Convert(response.PrecioVenta, from, to),
+		MontoPrestamo:     rate.Convert(response.MontoPrestamo, from, to),
+		CuotaTotal:        rate.Convert(response.CuotaTotal, from, to),
+		TotalPagadoCargos: rate.Convert(response.TotalPagadoCargos, from, to),
+		IngresoFamiliar:   rate.Convert(response.IngresoFamiliar, from, to),
 	}
 }
 
@@ -0,0 +1,131 @@
+package resources
+
+import (
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"time"
+)
+
+// RegisterDisbursementRequest registra el desembolso real de una simulación
+type RegisterDisbursementRequest struct {
+	FechaDesembolso string  `json:"fecha_desembolso" binding:"required"`                              // YYYY-MM-DD
+	TasaMoratoria   float64 `json:"tasa_moratoria" binding:"gte=0"`                                   // TEA moratoria
+	UnidadTasas     string  `json:"unidad_tasas,omitempty" binding:"omitempty,oneof=PERCENT DECIMAL"` // PERCENT (8.5) o DECIMAL (0.085)
+}
+
+// DisbursementResource es el desembolso registrado de un crédito
+type DisbursementResource struct {
+	MortgageID      uint64  `json:"mortgage_id"`
+	FechaDesembolso string  `json:"fecha_desembolso"`
+	TasaMoratoria   float64 `json:"tasa_moratoria"`
+}
+
+// RecordPaymentRequest registra un pago real
+type RecordPaymentRequest struct {
+	Fecha      string  `json:"fecha" binding:"required"` // YYYY-MM-DD
+	Monto      float64 `json:"monto" binding:"required,gt=0"`
+	Referencia string  `json:"referencia" binding:"omitempty,max=100"` // Número de operación o voucher
+}
+
+// PaymentResource es un pago registrado
+type PaymentResource struct {
+	ID         uint64    `json:"id"`
+	Fecha      string    `json:"fecha"`
+	Monto      float64   `json:"monto"`
+	Referencia string    `json:"referencia,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// InstallmentStatementResource es una cuota con los pagos aplicados
+type InstallmentStatementResource struct {
+	NumeroCuota      int     `json:"numero_cuota"`
+	FechaVencimiento string  `json:"fecha_vencimiento"`
+	CuotaTotal       float64 `json:"cuota_total"`
+	CargosPagados    float64 `json:"cargos_pagados"`
+	InteresPagado    float64 `json:"interes_pagado"`
+	CapitalPagado    float64 `json:"capital_pagado"`
+	InteresMoratorio float64 `json:"interes_moratorio"`
+	MoratorioPagado  float64 `json:"moratorio_pagado"`
+	Pendiente        float64 `json:"pendiente"`
+	DiasAtraso       int     `json:"dias_atraso"`
+	Estado           string  `json:"estado"` // PAID, OVERDUE o PENDING
+}
+
+// StatementResource es el estado de cuenta de un crédito desembolsado
+type StatementResource struct {
+	MortgageID         uint64                         `json:"mortgage_id"`
+	Fecha              string                         `json:"fecha"`
+	PagadoALaFecha     float64                        `json:"pagado_a_la_fecha"`
+	CuotasVencidas     int                            `json:"cuotas_vencidas"`
+	MontoVencido       float64                        `json:"monto_vencido"` // Incluye el interés moratorio
+	InteresMoratorio   float64                        `json:"interes_moratorio"`
+	ProximaCuota       int                            `json:"proxima_cuota,omitempty"`
+	ProximoVencimiento string                         `json:"proximo_vencimiento,omitempty"`
+	MontoProximaCuota  float64                        `json:"monto_proxima_cuota"`
+	SaldoCapital       float64                        `json:"saldo_capital"`
+	InteresCorrido     float64                        `json:"interes_corrido"`
+	SaldoCancelacion   float64                        `json:"saldo_cancelacion"` // Monto para cancelar el crédito hoy
+	SaldoAFavor        float64                        `json:"saldo_a_favor"`
+	Cuotas             []InstallmentStatementResource `json:"cuotas"`
+}
+
+// TransformToDisbursementResource transforma el desembolso de un crédito a su recurso
+func TransformToDisbursementResource(mortgage *entities.Mortgage) DisbursementResource {
+	return DisbursementResource{
+		MortgageID:      mortgage.ID().Value(),
+		FechaDesembolso: mortgage.DisbursementDate().Format("2006-01-02"),
+		TasaMoratoria:   mortgage.LateInterestRate(),
+	}
+}
+
+// TransformToPaymentResource transforma un pago a su recurso
+func TransformToPaymentResource(payment *entities.MortgagePayment) PaymentResource {
+	return PaymentResource{
+		ID:         payment.ID().Value(),
+		Fecha:      payment.PaymentDate().Format("2006-01-02"),
+		Monto:      payment.Amount(),
+		Referencia: payment.Reference(),
+		CreatedAt:  payment.CreatedAt(),
+	}
+}
+
+// TransformToStatementResource transforma el estado de cuenta a su recurso
+func TransformToStatementResource(statement *entities.MortgageStatement) StatementResource {
+	cuotas := make([]InstallmentStatementResource, 0, len(statement.Installments))
+	for _, installment := range statement.Installments {
+		cuotas = append(cuotas, InstallmentStatementResource{
+			NumeroCuota:      installment.Period,
+			FechaVencimiento: installment.DueDate.Format("2006-01-02"),
+			CuotaTotal:       installment.AmountDue,
+			CargosPagados:    installment.ChargesPaid,
+			InteresPagado:    installment.InterestPaid,
+			CapitalPagado:    installment.PrincipalPaid,
+			InteresMoratorio: installment.LateInterest,
+			MoratorioPagado:  installment.LatePaid,
+			Pendiente:        installment.Outstanding,
+			DiasAtraso:       installment.DaysPastDue,
+			Estado:           string(installment.Status),
+		})
+	}
+
+	proximoVencimiento := ""
+	if statement.NextDuePeriod > 0 {
+		proximoVencimiento = statement.NextDueDate.Format("2006-01-02")
+	}
+
+	return StatementResource{
+		MortgageID:         statement.MortgageID,
+		Fecha:              statement.AsOf.Format("2006-01-02"),
+		PagadoALaFecha:     statement.PaidToDate,
+		CuotasVencidas:     statement.OverdueInstallments,
+		MontoVencido:       statement.OverdueAmount,
+		InteresMoratorio:   statement.LateInterest,
+		ProximaCuota:       statement.NextDuePeriod,
+		ProximoVencimiento: proximoVencimiento,
+		MontoProximaCuota:  statement.NextDueAmount,
+		SaldoCapital:       statement.PrincipalBalance,
+		InteresCorrido:     statement.AccruedInterest,
+		SaldoCancelacion:   statement.PayoffBalance,
+		SaldoAFavor:        statement.CreditBalance,
+		Cuotas:             cuotas,
+	}
+}
@@ -0,0 +1,53 @@
+package resources
+
+import "finanzas-backend/internal/mortgage/domain/model/entities"
+
+// PayoffQuoteResource es el desglose de la carta de cancelación anticipada
+type PayoffQuoteResource struct {
+	MortgageID           uint64  `json:"mortgage_id"`
+	Moneda               string  `json:"moneda"`
+	FechaCancelacion     string  `json:"fecha_cancelacion,omitempty"` // Solo si el crédito tiene fecha de desembolso
+	CuotasPagadas        int     `json:"cuotas_pagadas"`
+	UltimoVencimiento    string  `json:"ultimo_vencimiento,omitempty"`
+	DiasTranscurridos    int     `json:"dias_transcurridos"`
+	SaldoCapital         float64 `json:"saldo_capital"`
+	InteresCorrido       float64 `json:"interes_corrido"`
+	SeguroDesgravamen    float64 `json:"seguro_desgravamen"`
+	SeguroInmueble       float64 `json:"seguro_inmueble"`
+	TasaPenalidadPrepago float64 `json:"tasa_penalidad_prepago"`
+	PenalidadPrepago     float64 `json:"penalidad_prepago"`
+	TotalCancelacion     float64 `json:"total_cancelacion"`
+
+	// Según los pagos registrados (solo en la cotización a una fecha)
+	CuotasVencidas        int     `json:"cuotas_vencidas"`
+	InteresCuotasVencidas float64 `json:"interes_cuotas_vencidas"` // Interés y cargos impagos
+	InteresMoratorio      float64 `json:"interes_moratorio"`
+	SaldoAFavor           float64 `json:"saldo_a_favor"`
+}
+
+// TransformToPayoffQuoteResource transforma una cotización de cancelación a su recurso
+func TransformToPayoffQuoteResource(quote *entities.PayoffQuote) PayoffQuoteResource {
+	resource := PayoffQuoteResource{
+		MortgageID:           quote.MortgageID,
+		Moneda:               quote.Currency.String(),
+		CuotasPagadas:        quote.InstallmentsPaid,
+		DiasTranscurridos:    quote.DaysElapsed,
+		SaldoCapital:         quote.PrincipalBalance,
+		InteresCorrido:       quote.AccruedInterest,
+		SeguroDesgravamen:    quote.LifeInsurance,
+		SeguroInmueble:       quote.PropertyInsurance,
+		TasaPenalidadPrepago: quote.PenaltyRate,
+		PenalidadPrepago:     quote.PrepaymentPenalty,
+		TotalCancelacion:     quote.Total,
+
+		CuotasVencidas:        quote.OverdueInstallments,
+		InteresCuotasVencidas: quote.OverdueInterest,
+		InteresMoratorio:      quote.LateInterest,
+		SaldoAFavor:           quote.CreditBalance,
+	}
+	if !quote.QuoteDate.IsZero() {
+		resource.FechaCancelacion = quote.QuoteDate.Format("2006-01-02")
+		resource.UltimoVencimiento = quote.LastDueDate.Format("2006-01-02")
+	}
+	return resource
+}
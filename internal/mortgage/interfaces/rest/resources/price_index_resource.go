@@ -0,0 +1,19 @@
+package resources
+
+import "finanzas-backend/internal/mortgage/domain/model/entities"
+
+// PriceIndexResource es el valor del VAC (S/ por unidad VAC) y del IPC de un día
+type PriceIndexResource struct {
+	Fecha string  `json:"fecha"`
+	VAC   float64 `json:"vac"`
+	IPC   float64 `json:"ipc,omitempty"`
+}
+
+// TransformToPriceIndexResource transforma un valor del índice a su recurso
+func TransformToPriceIndexResource(index *entities.PriceIndex) PriceIndexResource {
+	return PriceIndexResource{
+		Fecha: index.Date().Format("2006-01-02"),
+		VAC:   index.VAC(),
+		IPC:   index.CPI(),
+	}
+}
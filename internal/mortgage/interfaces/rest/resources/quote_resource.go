@@ -0,0 +1,172 @@
+package resources
+
+import (
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"finanzas-backend/internal/mortgage/domain/model/valueobjects"
+)
+
+// LenderOfferResource es una oferta de una entidad para cotizar (también el formato del archivo de ofertas)
+type LenderOfferResource struct {
+	Entidad        string  `json:"entidad" binding:"required,max=100"`
+	Nombre         string  `json:"nombre" binding:"max=150"`
+	Moneda         string  `json:"moneda" binding:"required,oneof=PEN USD"`
+	TasaAnual      float64 `json:"tasa_anual" binding:"required,gt=0"`
+	TipoTasa       string  `json:"tipo_tasa" binding:"required,oneof=NOMINAL EFFECTIVE"`
+	SeguroDesg     float64 `json:"seguro_desgravamen" binding:"gte=0"`
+	SeguroInmueble float64 `json:"seguro_inmueble_anual" binding:"gte=0"`
+	ComisionEval   float64 `json:"comision_evaluacion" binding:"gte=0"`
+	ComisionDesem  float64 `json:"comision_desembolso" binding:"gte=0"`
+	Portes         float64 `json:"portes" binding:"gte=0"`
+	GastosAdm      float64 `json:"gastos_administrativos" binding:"gte=0"`
+	MontoMin       float64 `json:"monto_min" binding:"gte=0"`
+	MontoMax       float64 `json:"monto_max" binding:"gte=0"`
+	PlazoMinMeses  int     `json:"plazo_min_meses" binding:"gte=0"`
+	PlazoMaxMeses  int     `json:"plazo_max_meses" binding:"gte=0"`
+	UnidadTasas    string  `json:"unidad_tasas,omitempty" binding:"omitempty,oneof=PERCENT DECIMAL"`
+}
+
+// QuoteMortgageRequest cotiza una solicitud contra varias ofertas; sin ofertas se usan las publicadas
+type QuoteMortgageRequest struct {
+	PrecioVenta     float64               `json:"precio_venta" binding:"required,gt=0"`
+	CuotaInicial    float64               `json:"cuota_inicial" binding:"gte=0"`
+	MontoPrestamo   float64               `json:"monto_prestamo" binding:"required,gt=0"`
+	BonoTechoPropio float64               `json:"bono_techo_propio" binding:"gte=0"`
+	Frecuencia      string                `json:"frecuencia,omitempty" binding:"omitempty,oneof=MENSUAL BIMESTRAL TRIMESTRAL"`
+	FrecuenciaPago  int                   `json:"frecuencia_pago" binding:"omitempty,gt=0"`
+	DiasAnio        int                   `json:"dias_anio" binding:"required,gt=0"`
+	PlazoMeses      int                   `json:"plazo_meses" binding:"omitempty,gt=0"`
+	NumeroAnios     int                   `json:"numero_anios" binding:"omitempty,gte=0"`
+	MesesGracia     int                   `json:"meses_gracia" binding:"gte=0"`
+	TipoGracia      string                `json:"tipo_gracia" binding:"required,oneof=NONE TOTAL PARTIAL"`
+	Moneda          string                `json:"moneda" binding:"required,oneof=PEN USD"`
+	COK             float64               `json:"cok" binding:"omitempty,gte=0"`
+	CostosMensuales float64               `json:"costos_mensuales_adicionales" binding:"omitempty,gte=0"`
+	CoPrestatarioID string                `json:"co_prestatario_id,omitempty" binding:"omitempty,uuid"`
+	Ofertas         []LenderOfferResource `json:"ofertas,omitempty" binding:"omitempty,max=50,dive"`
+
+	// Unidad del COK y de las ofertas que no indican la suya: PERCENT (8.5) o DECIMAL (0.085)
+	UnidadTasas string `json:"unidad_tasas,omitempty" binding:"omitempty,oneof=PERCENT DECIMAL"`
+}
+
+// QuoteOptionResource es el resultado de una oferta en la cotización
+type QuoteOptionResource struct {
+	Posicion          int     `json:"posicion,omitempty"` // 1 es la más barata; 0 si no es elegible
+	Entidad           string  `json:"entidad"`
+	Nombre            string  `json:"nombre,omitempty"`
+	Elegible          bool    `json:"elegible"`
+	MotivoNoElegible  string  `json:"motivo_no_elegible,omitempty"`
+	EsMasBarata       bool    `json:"es_mas_barata"`
+	TasaAnual         float64 `json:"tasa_anual"`
+	TipoTasa          string  `json:"tipo_tasa"`
+	CuotaFija         float64 `json:"cuota_fija,omitempty"`
+	CuotaTotal        float64 `json:"cuota_total,omitempty"`
+	TotalIntereses    float64 `json:"total_intereses,omitempty"`
+	TotalPagadoCargos float64 `json:"total_pagado_con_cargos,omitempty"`
+	TEA               float64 `json:"tea,omitempty"`
+	TCEA              float64 `json:"tcea,omitempty"`
+	SobrecostoVsMejor float64 `json:"sobrecosto_vs_mejor"`
+	RatioCuotaIngreso float64 `json:"ratio_cuota_ingreso,omitempty"`
+	EsAsequible       bool    `json:"es_asequible"`
+}
+
+// MortgageQuoteResponse lista las ofertas elegibles de la más barata a la más cara y luego las descartadas
+type MortgageQuoteResponse struct {
+	Moneda           string                `json:"moneda"`
+	MontoPrestamo    float64               `json:"monto_prestamo"`
+	PlazoMeses       int                   `json:"plazo_meses"`
+	OfertasElegibles int                   `json:"ofertas_elegibles"`
+	MejorOferta      *QuoteOptionResource  `json:"mejor_oferta,omitempty"`
+	Ofertas          []QuoteOptionResource `json:"ofertas"`
+}
+
+// ToLenderOffer convierte la oferta del recurso en entidad; defaultUnit aplica si la oferta no indica su unidad
+func (r LenderOfferResource) ToLenderOffer(defaultUnit string) (*entities.LenderOffer, error) {
+	currency, err := valueobjects.NewCurrency(r.Moneda)
+	if err != nil {
+		return nil, err
+	}
+	rateType, err := valueobjects.NewRateType(r.TipoTasa)
+	if err != nil {
+		return nil, err
+	}
+	unit := r.UnidadTasas
+	if unit == "" {
+		unit = defaultUnit
+	}
+	rateUnit, err := valueobjects.NewRateUnit(unit)
+	if err != nil {
+		return nil, err
+	}
+	return entities.NewLenderOffer(
+		r.Entidad,
+		r.Nombre,
+		currency,
+		r.TasaAnual,
+		rateType,
+		r.SeguroDesg,
+		r.SeguroInmueble,
+		r.ComisionEval,
+		r.ComisionDesem,
+		r.Portes,
+		r.GastosAdm,
+		r.MontoMin,
+		r.MontoMax,
+		r.PlazoMinMeses,
+		r.PlazoMaxMeses,
+		rateUnit,
+	)
+}
+
+// TransformToMortgageQuoteResponse transforma una cotización a su respuesta
+func TransformToMortgageQuoteResponse(quote *entities.MortgageQuote, currency string, loanAmount float64, termMonths int) MortgageQuoteResponse {
+	response := MortgageQuoteResponse{
+		Moneda:           currency,
+		MontoPrestamo:    loanAmount,
+		PlazoMeses:       termMonths,
+		OfertasElegibles: len(quote.Eligible()),
+		Ofertas:          make([]QuoteOptionResource, 0, len(quote.Eligible())+len(quote.Ineligible())),
+	}
+
+	for i, option := range quote.Eligible() {
+		resource := transformToQuoteOption(option)
+		resource.Posicion = i + 1
+		resource.EsMasBarata = i == 0
+		response.Ofertas = append(response.Ofertas, resource)
+	}
+	if len(response.Ofertas) > 0 {
+		best := response.Ofertas[0]
+		response.MejorOferta = &best
+	}
+	for _, option := range quote.Ineligible() {
+		response.Ofertas = append(response.Ofertas, transformToQuoteOption(option))
+	}
+
+	return response
+}
+
+func transformToQuoteOption(option *entities.QuoteOption) QuoteOptionResource {
+	offer := option.Offer
+	resource := QuoteOptionResource{
+		Entidad:          offer.Lender(),
+		Nombre:           offer.Name(),
+		Elegible:         option.IsEligible(),
+		MotivoNoElegible: option.IneligibleReason,
+		TasaAnual:        offer.InterestRate(),
+		TipoTasa:         offer.RateType().String(),
+	}
+	if !option.IsEligible() {
+		return resource
+	}
+
+	mortgage := TransformToMortgageResponse(option.Mortgage)
+	resource.CuotaFija = mortgage.CuotaFija
+	resource.CuotaTotal = mortgage.CuotaTotal
+	resource.TotalIntereses = mortgage.TotalIntereses
+	resource.TotalPagadoCargos = mortgage.TotalPagadoCargos
+	resource.TEA = mortgage.TEA
+	resource.TCEA = mortgage.TCEA
+	resource.SobrecostoVsMejor = option.ExtraCostVsBest
+	resource.RatioCuotaIngreso = mortgage.RatioCuotaIngreso
+	resource.EsAsequible = mortgage.EsAsequible
+	return resource
+}
@@ -0,0 +1,68 @@
+package resources
+
+import "finanzas-backend/internal/mortgage/domain/model/entities"
+
+// RefinanceAnalysisRequest describe el traslado del saldo a otra entidad (compra de deuda)
+type RefinanceAnalysisRequest struct {
+	PeriodoTraslado int     `json:"periodo_traslado" binding:"required,gt=0"` // Cuotas pagadas antes del traslado
+	TasaAnual       float64 `json:"tasa_anual" binding:"required,gt=0"`
+	TipoTasa        string  `json:"tipo_tasa" binding:"required,oneof=NOMINAL EFFECTIVE"`
+	PlazoMeses      int     `json:"plazo_meses" binding:"omitempty,gt=0"` // Por defecto, las cuotas que faltan
+	SeguroDesg      float64 `json:"seguro_desgravamen" binding:"gte=0"`
+	SeguroInmueble  float64 `json:"seguro_inmueble_anual" binding:"gte=0"`
+	ComisionEval    float64 `json:"comision_evaluacion" binding:"gte=0"`
+	ComisionDesem   float64 `json:"comision_desembolso" binding:"gte=0"`
+	Portes          float64 `json:"portes" binding:"gte=0"`
+	GastosAdm       float64 `json:"gastos_administrativos" binding:"gte=0"`
+	GastosTraslado  float64 `json:"gastos_traslado" binding:"gte=0"` // Notaría, registros, tasación
+	COK             float64 `json:"cok" binding:"required,gt=0"`
+	UnidadTasas     string  `json:"unidad_tasas,omitempty" binding:"omitempty,oneof=PERCENT DECIMAL"` // PERCENT (8.5) o DECIMAL (0.085)
+}
+
+// RefinanceAnalysisResource compara seguir con el crédito actual contra trasladarlo
+type RefinanceAnalysisResource struct {
+	HipotecaID          uint64           `json:"hipoteca_id"`
+	PeriodoTraslado     int              `json:"periodo_traslado"`
+	SaldoTraslado       float64          `json:"saldo_traslado"`
+	CuotaActual         float64          `json:"cuota_actual"`
+	CuotaNueva          float64          `json:"cuota_nueva"`
+	PendienteActual     float64          `json:"pendiente_actual_con_cargos"`
+	TotalNuevoCredito   float64          `json:"total_nuevo_credito_con_cargos"`
+	CostoTraslado       float64          `json:"costo_traslado"`
+	AhorroTotal         float64          `json:"ahorro_total"`
+	PeriodoEquilibrio   int              `json:"periodo_equilibrio,omitempty"` // Omitido si el ahorro nunca cubre el costo
+	VANTraslado         float64          `json:"van_traslado"`
+	ConvieneTrasladarse bool             `json:"conviene_trasladarse"`
+	TCEAActual          float64          `json:"tcea_actual"`
+	TCEANueva           float64          `json:"tcea_nueva"`
+	NuevoCredito        MortgageResponse `json:"nuevo_credito"`
+}
+
+// TransformToRefinanceAnalysisResource transforma un análisis de compra de deuda a su recurso
+func TransformToRefinanceAnalysisResource(analysis *entities.RefinanceAnalysis) RefinanceAnalysisResource {
+	refinanced := TransformToMortgageResponse(analysis.Refinanced)
+
+	currentInstallment := 0.0
+	items := analysis.Current.PaymentSchedule().GetItems()
+	if analysis.RefinancePeriod < len(items) {
+		currentInstallment = items[analysis.RefinancePeriod].TotalInstallment
+	}
+
+	return RefinanceAnalysisResource{
+		HipotecaID:          analysis.Current.ID().Value(),
+		PeriodoTraslado:     analysis.RefinancePeriod,
+		SaldoTraslado:       analysis.RemainingBalance,
+		CuotaActual:         currentInstallment,
+		CuotaNueva:          refinanced.CuotaTotal,
+		PendienteActual:     analysis.RemainingPaid,
+		TotalNuevoCredito:   analysis.Refinanced.TotalPaidWithFees(),
+		CostoTraslado:       analysis.SwitchingCosts,
+		AhorroTotal:         analysis.TotalSavings,
+		PeriodoEquilibrio:   analysis.BreakEvenPeriod,
+		VANTraslado:         analysis.NPV,
+		ConvieneTrasladarse: analysis.IsWorthIt(),
+		TCEAActual:          analysis.Current.TCEA(),
+		TCEANueva:           analysis.Refinanced.TCEA(),
+		NuevoCredito:        refinanced,
+	}
+}
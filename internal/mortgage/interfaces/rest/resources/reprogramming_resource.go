@@ -0,0 +1,47 @@
+package resources
+
+import "finanzas-backend/internal/mortgage/domain/model/entities"
+
+// ReprogramMortgageRequest describe las nuevas condiciones pactadas para reprogramar una simulación
+type ReprogramMortgageRequest struct {
+	PeriodoReprogramacion int     `json:"periodo_reprogramacion" binding:"required,gt=0"` // Última cuota que se conserva
+	CuotasAdicionales     int     `json:"cuotas_adicionales" binding:"gte=0"`             // Extensión del plazo
+	TasaAnual             float64 `json:"tasa_anual" binding:"gte=0"`                     // 0 mantiene la tasa
+	TipoTasa              string  `json:"tipo_tasa" binding:"omitempty,oneof=NOMINAL EFFECTIVE"`
+	CuotasVencidas        int     `json:"cuotas_vencidas" binding:"gte=0"` // Cuotas impagas antes de la reprogramación
+	CapitalizarIntereses  bool    `json:"capitalizar_intereses"`           // Si no, se cobran en la primera cuota
+	COK                   float64 `json:"cok" binding:"gte=0"`
+	UnidadTasas           string  `json:"unidad_tasas,omitempty" binding:"omitempty,oneof=PERCENT DECIMAL"` // PERCENT (8.5) o DECIMAL (0.085)
+}
+
+// ReprogrammingResource compara una simulación con su versión reprogramada
+type ReprogrammingResource struct {
+	PeriodoReprogramacion  int              `json:"periodo_reprogramacion"`
+	CuotasAdicionales      int              `json:"cuotas_adicionales"`
+	InteresesVencidos      float64          `json:"intereses_vencidos"`
+	InteresesCapitalizados bool             `json:"intereses_capitalizados"`
+	CuotaAntes             float64          `json:"cuota_antes"`
+	CuotaDespues           float64          `json:"cuota_despues"`
+	CostoAdicional         float64          `json:"costo_adicional"` // Total con cargos reprogramado menos el original
+	TCEAOriginal           float64          `json:"tcea_original"`
+	TCEAReprogramada       float64          `json:"tcea_reprogramada"`
+	Original               MortgageResponse `json:"original"`
+	Reprogramado           MortgageResponse `json:"reprogramado"`
+}
+
+// TransformToReprogrammingResource transforma una reprogramación a su recurso
+func TransformToReprogrammingResource(reprogramming *entities.MortgageReprogramming) ReprogrammingResource {
+	return ReprogrammingResource{
+		PeriodoReprogramacion:  reprogramming.Period(),
+		CuotasAdicionales:      reprogramming.ExtraPeriods(),
+		InteresesVencidos:      reprogramming.Reprogrammed.OverdueInterest(),
+		InteresesCapitalizados: reprogramming.Reprogrammed.OverdueCapitalized(),
+		CuotaAntes:             reprogramming.InstallmentBefore(),
+		CuotaDespues:           reprogramming.InstallmentAfter(),
+		CostoAdicional:         reprogramming.ExtraCost(),
+		TCEAOriginal:           reprogramming.Original.TCEA(),
+		TCEAReprogramada:       reprogramming.Reprogrammed.TCEA(),
+		Original:               TransformToMortgageResponse(reprogramming.Original),
+		Reprogramado:           TransformToMortgageResponse(reprogramming.Reprogrammed),
+	}
+}
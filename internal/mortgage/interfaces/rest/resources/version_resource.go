@@ -0,0 +1,127 @@
+package resources
+
+import (
+	"finanzas-backend/internal/mortgage/domain/model/entities"
+	"time"
+)
+
+// MortgageVersionSummaryResource resume una versión guardada de la simulación
+type MortgageVersionSummaryResource struct {
+	Version           int       `json:"version"`
+	MontoPrestamo     float64   `json:"monto_prestamo"`
+	TasaAnual         float64   `json:"tasa_anual"`
+	TipoTasa          string    `json:"tipo_tasa"`
+	PlazoMeses        int       `json:"plazo_meses"`
+	CuotaTotal        float64   `json:"cuota_total"`
+	TotalPagadoCargos float64   `json:"total_pagado_con_cargos"`
+	TCEA              float64   `json:"tcea"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// MortgageVersionResource es una versión guardada con sus datos, resultados y cronograma
+type MortgageVersionResource struct {
+	Version    int              `json:"version"`
+	CreatedAt  time.Time        `json:"created_at"`
+	Simulacion MortgageResponse `json:"simulacion"`
+}
+
+// VersionChangeResource es un campo que cambió entre dos versiones
+type VersionChangeResource struct {
+	Campo      string      `json:"campo"`
+	Antes      interface{} `json:"antes"`
+	Despues    interface{} `json:"despues"`
+	Diferencia float64     `json:"diferencia,omitempty"` // Solo en valores numéricos
+}
+
+// ScheduleChangeResource es una cuota cuyo monto o saldo cambió entre dos versiones
+type ScheduleChangeResource struct {
+	Periodo      int     `json:"periodo"`
+	CuotaAntes   float64 `json:"cuota_antes"`
+	CuotaDespues float64 `json:"cuota_despues"`
+	SaldoAntes   float64 `json:"saldo_antes"`
+	SaldoDespues float64 `json:"saldo_despues"`
+}
+
+// VersionDiffResource compara dos versiones de la misma simulación
+type VersionDiffResource struct {
+	MortgageID    uint64                   `json:"mortgage_id"`
+	VersionDesde  int                      `json:"version_desde"`
+	VersionHasta  int                      `json:"version_hasta"`
+	FechaDesde    time.Time                `json:"fecha_desde"`
+	FechaHasta    time.Time                `json:"fecha_hasta"`
+	Datos         []VersionChangeResource  `json:"datos"`
+	Parametros    []VersionChangeResource  `json:"parametros"` // Versión del motor y parámetros efectivos
+	Resultados    []VersionChangeResource  `json:"resultados"`
+	CuotasAntes   int                      `json:"cuotas_antes"`
+	CuotasDespues int                      `json:"cuotas_despues"`
+	Cronograma    []ScheduleChangeResource `json:"cronograma"` // Solo las cuotas que cambiaron
+}
+
+// TransformToVersionSummaryResource resume una versión
+func TransformToVersionSummaryResource(version *entities.MortgageVersion) MortgageVersionSummaryResource {
+	response := TransformToMortgageResponse(version.Mortgage)
+	return MortgageVersionSummaryResource{
+		Version:           version.Version,
+		MontoPrestamo:     response.MontoPrestamo,
+		TasaAnual:         response.TasaAnual,
+		TipoTasa:          response.TipoTasa,
+		PlazoMeses:        response.PlazoMeses,
+		CuotaTotal:        response.CuotaTotal,
+		TotalPagadoCargos: response.TotalPagadoCargos,
+		TCEA:              response.TCEA,
+		CreatedAt:         version.CreatedAt,
+	}
+}
+
+// TransformToVersionResource transforma una versión a su recurso
+func TransformToVersionResource(version *entities.MortgageVersion) MortgageVersionResource {
+	return MortgageVersionResource{
+		Version:    version.Version,
+		CreatedAt:  version.CreatedAt,
+		Simulacion: TransformToMortgageResponse(version.Mortgage),
+	}
+}
+
+// TransformToVersionDiffResource transforma la comparación de dos versiones a su recurso
+func TransformToVersionDiffResource(diff *entities.MortgageVersionDiff) VersionDiffResource {
+	return VersionDiffResource{
+		MortgageID:    diff.MortgageID,
+		VersionDesde:  diff.From.Version,
+		VersionHasta:  diff.To.Version,
+		FechaDesde:    diff.From.CreatedAt,
+		FechaHasta:    diff.To.CreatedAt,
+		Datos:         transformVersionChanges(diff.Inputs),
+		Parametros:    transformVersionChanges(diff.Parameters),
+		Resultados:    transformVersionChanges(diff.Outputs),
+		CuotasAntes:   diff.PeriodsBefore,
+		CuotasDespues: diff.PeriodsAfter,
+		Cronograma:    transformScheduleChanges(diff.Schedule),
+	}
+}
+
+func transformScheduleChanges(changes []entities.ScheduleChange) []ScheduleChangeResource {
+	resources := make([]ScheduleChangeResource, 0, len(changes))
+	for _, change := range changes {
+		resources = append(resources, ScheduleChangeResource{
+			Periodo:      change.Period,
+			CuotaAntes:   change.InstallmentBefore,
+			CuotaDespues: change.InstallmentAfter,
+			SaldoAntes:   change.BalanceBefore,
+			SaldoDespues: change.BalanceAfter,
+		})
+	}
+	return resources
+}
+
+func transformVersionChanges(changes []entities.VersionChange) []VersionChangeResource {
+	resources := make([]VersionChangeResource, 0, len(changes))
+	for _, change := range changes {
+		resources = append(resources, VersionChangeResource{
+			Campo:      change.Field,
+			Antes:      change.Before,
+			Despues:    change.After,
+			Diferencia: change.Delta,
+		})
+	}
+	return resources
+}
@@ -0,0 +1,33 @@
+package acl
+
+import (
+	"context"
+
+	exchangerate_acl "finanzas-backend/internal/exchangerate/interfaces/acl"
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+)
+
+// ExternalExchangeRateService - ACL implementation para consultar el tipo de cambio desde Profile
+type ExternalExchangeRateService struct {
+	exchangeRateFacade exchangerate_acl.ExchangeRateContextFacade
+}
+
+func NewExternalExchangeRateService(exchangeRateFacade exchangerate_acl.ExchangeRateContextFacade) *ExternalExchangeRateService {
+	return &ExternalExchangeRateService{
+		exchangeRateFacade: exchangeRateFacade,
+	}
+}
+
+// CurrentRate retorna el tipo de cambio vigente, o nil si no hay uno registrado
+func (s *ExternalExchangeRateService) CurrentRate(ctx context.Context) (*valueobjects.ExchangeRate, error) {
+	data, err := s.exchangeRateFacade.GetCurrentRate(ctx)
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	rate, err := valueobjects.NewExchangeRate(data.Buy, data.Sell, data.Date)
+	if err != nil {
+		return nil, err
+	}
+	return &rate, nil
+}
@@ -2,23 +2,34 @@ package acl
 
 import (
 	"context"
+	"encoding/json"
 
 	"finanzas-backend/internal/profile/domain/model/entities"
 	"finanzas-backend/internal/profile/domain/model/valueobjects"
 	"finanzas-backend/internal/profile/domain/repositories"
 	"finanzas-backend/internal/profile/interfaces/acl"
+	"finanzas-backend/internal/profile/interfaces/rest/resources"
 )
 
 type profileContextFacadeImpl struct {
-	profileRepo repositories.ProfileRepository
+	profileRepo    repositories.ProfileRepository
+	coBorrowerRepo repositories.CoBorrowerRepository
+	obligationRepo repositories.FinancialObligationRepository
+	exchangeRates  *ExternalExchangeRateService
 }
 
 // NewProfileContextFacade crea una nueva instancia del facade ACL de Profile
 func NewProfileContextFacade(
 	profileRepo repositories.ProfileRepository,
+	coBorrowerRepo repositories.CoBorrowerRepository,
+	obligationRepo repositories.FinancialObligationRepository,
+	exchangeRates *ExternalExchangeRateService,
 ) acl.ProfileContextFacade {
 	return &profileContextFacadeImpl{
-		profileRepo: profileRepo,
+		profileRepo:    profileRepo,
+		coBorrowerRepo: coBorrowerRepo,
+		obligationRepo: obligationRepo,
+		exchangeRates:  exchangeRates,
 	}
 }
 
@@ -73,3 +84,127 @@ func (f *profileContextFacadeImpl) CreateProfile(ctx context.Context, userID, dn
 	// Save profile
 	return f.profileRepo.Save(ctx, profile)
 }
+
+// GetMonthlyIncome obtiene el ingreso mensual declarado por el titular; ok es false si no tiene perfil
+func (f *profileContextFacadeImpl) GetMonthlyIncome(ctx context.Context, userID string) (float64, string, bool, error) {
+	userIDVO, err := valueobjects.NewUserIDFromString(userID)
+	if err != nil {
+		return 0, "", false, err
+	}
+
+	profile, err := f.profileRepo.FindByUserID(ctx, userIDVO)
+	if err != nil || profile == nil {
+		return 0, "", false, err
+	}
+
+	return profile.MonthlyIncome().Amount(), string(profile.MonthlyIncome().Currency()), true, nil
+}
+
+// FindCoBorrower obtiene un co-prestatario del perfil del usuario, o nil si no existe o es de otro perfil
+func (f *profileContextFacadeImpl) FindCoBorrower(ctx context.Context, userID, coBorrowerID string) (*acl.CoBorrowerData, error) {
+	userIDVO, err := valueobjects.NewUserIDFromString(userID)
+	if err != nil {
+		return nil, err
+	}
+	coBorrowerIDVO, err := valueobjects.NewCoBorrowerIDFromString(coBorrowerID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := f.profileRepo.FindByUserID(ctx, userIDVO)
+	if err != nil || profile == nil {
+		return nil, err
+	}
+
+	coBorrower, err := f.coBorrowerRepo.FindByID(ctx, coBorrowerIDVO)
+	if err != nil {
+		return nil, err
+	}
+	if coBorrower == nil || coBorrower.ProfileID().Value() != profile.ID().Value() {
+		return nil, nil
+	}
+
+	return &acl.CoBorrowerData{
+		ID:            coBorrower.ID().String(),
+		FullName:      coBorrower.FullName(),
+		MonthlyIncome: coBorrower.MonthlyIncome().Amount(),
+		Currency:      string(coBorrower.MonthlyIncome().Currency()),
+		Relationship:  coBorrower.Relationship().String(),
+	}, nil
+}
+
+// GetFinancialSnapshot obtiene ingreso, deudas y gastos del titular, o nil si no tiene perfil
+func (f *profileContextFacadeImpl) GetFinancialSnapshot(ctx context.Context, userID string) (*acl.FinancialSnapshotData, error) {
+	userIDVO, err := valueobjects.NewUserIDFromString(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := f.profileRepo.FindByUserID(ctx, userIDVO)
+	if err != nil || profile == nil {
+		return nil, err
+	}
+
+	obligations, err := f.obligationRepo.FindByProfileID(ctx, profile.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	exchangeRate, err := f.exchangeRates.CurrentRate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := entities.NewFinancialSnapshot(profile, obligations, exchangeRate)
+	return &acl.FinancialSnapshotData{
+		Currency:            string(snapshot.Currency()),
+		MonthlyIncome:       snapshot.MonthlyIncome(),
+		TotalDebtPayments:   snapshot.TotalDebtPayments(),
+		TotalExpenses:       snapshot.TotalExpenses(),
+		NetDisposableIncome: snapshot.NetDisposableIncome(),
+		DebtServiceRatio:    snapshot.DebtServiceRatio(),
+		Dependents:          snapshot.Dependents(),
+	}, nil
+}
+
+// ExportUserData retorna el perfil del usuario (descifrado) con sus co-prestatarios y obligaciones como JSON, o null si no tiene perfil
+func (f *profileContextFacadeImpl) ExportUserData(ctx context.Context, userID string) (json.RawMessage, error) {
+	userIDVO, err := valueobjects.NewUserIDFromString(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := f.profileRepo.FindByUserID(ctx, userIDVO)
+	if err != nil {
+		return nil, err
+	}
+	if profile == nil {
+		return json.RawMessage("null"), nil
+	}
+
+	coBorrowers, err := f.coBorrowerRepo.FindByProfileID(ctx, profile.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	obligations, err := f.obligationRepo.FindByProfileID(ctx, profile.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(resources.ProfileExportResource{
+		ProfileResource: resources.TransformToProfileResource(profile),
+		CoBorrowers:     resources.TransformToCoBorrowerResources(coBorrowers),
+		Obligations:     resources.TransformToFinancialObligationResources(obligations),
+	})
+}
+
+// DeleteUserData elimina definitivamente el perfil del usuario; sus co-prestatarios y obligaciones se borran en cascada
+func (f *profileContextFacadeImpl) DeleteUserData(ctx context.Context, userID string) error {
+	userIDVO, err := valueobjects.NewUserIDFromString(userID)
+	if err != nil {
+		return err
+	}
+
+	return f.profileRepo.DeleteByUserID(ctx, userIDVO)
+}
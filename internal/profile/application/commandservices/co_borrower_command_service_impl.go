@@ -0,0 +1,180 @@
+package commandservices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"finanzas-backend/internal/profile/domain/model/commands"
+	"finanzas-backend/internal/profile/domain/model/entities"
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+	"finanzas-backend/internal/profile/domain/repositories"
+	"finanzas-backend/internal/profile/domain/services"
+	"finanzas-backend/internal/shared/infrastructure/identity"
+)
+
+// maxCoBorrowersPerProfile limita los co-prestatarios registrados por titular
+const maxCoBorrowersPerProfile = 3
+
+type coBorrowerCommandServiceImpl struct {
+	profileRepo      repositories.ProfileRepository
+	coBorrowerRepo   repositories.CoBorrowerRepository
+	identityProvider identity.Provider
+}
+
+func NewCoBorrowerCommandService(
+	profileRepo repositories.ProfileRepository,
+	coBorrowerRepo repositories.CoBorrowerRepository,
+	identityProvider identity.Provider,
+) services.CoBorrowerCommandService {
+	return &coBorrowerCommandServiceImpl{
+		profileRepo:      profileRepo,
+		coBorrowerRepo:   coBorrowerRepo,
+		identityProvider: identityProvider,
+	}
+}
+
+func (s *coBorrowerCommandServiceImpl) HandleAdd(ctx context.Context, cmd *commands.AddCoBorrowerCommand) (*entities.CoBorrower, error) {
+	profile, err := s.findProfile(ctx, cmd.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.DNI() == profile.DNI().Value() {
+		return nil, errors.New("co-borrower DNI cannot be the holder's DNI")
+	}
+
+	existing, err := s.coBorrowerRepo.FindByProfileID(ctx, profile.ID())
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= maxCoBorrowersPerProfile {
+		return nil, fmt.Errorf("a profile can have at most %d co-borrowers", maxCoBorrowersPerProfile)
+	}
+
+	exists, err := s.coBorrowerRepo.ExistsByProfileIDAndDNI(ctx, profile.ID(), cmd.DNI())
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New("co-borrower already registered")
+	}
+
+	// Validar el DNI con RENIEC y tomar los nombres oficiales
+	personData, err := s.identityProvider.LookupDNI(ctx, cmd.DNI())
+	if err != nil {
+		return nil, fmt.Errorf("DNI validation failed: %w", err)
+	}
+
+	dni, err := valueobjects.NewDNI(cmd.DNI())
+	if err != nil {
+		return nil, err
+	}
+
+	monthlyIncome, err := valueobjects.NewMonthlyIncome(cmd.MonthlyIncome(), valueobjects.Currency(cmd.Currency()))
+	if err != nil {
+		return nil, err
+	}
+
+	relationship, err := valueobjects.NewRelationship(cmd.Relationship())
+	if err != nil {
+		return nil, err
+	}
+
+	coBorrower, err := entities.NewCoBorrower(
+		profile.ID(),
+		dni,
+		personData.FirstName,
+		personData.FirstLastName,
+		personData.SecondLastName,
+		monthlyIncome,
+		relationship,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.coBorrowerRepo.Save(ctx, coBorrower); err != nil {
+		return nil, err
+	}
+	return coBorrower, nil
+}
+
+func (s *coBorrowerCommandServiceImpl) HandleUpdate(ctx context.Context, cmd *commands.UpdateCoBorrowerCommand) (*entities.CoBorrower, error) {
+	coBorrower, err := s.findOwnedCoBorrower(ctx, cmd.UserID(), cmd.CoBorrowerID())
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.MonthlyIncome() != nil || cmd.Currency() != nil {
+		amount := coBorrower.MonthlyIncome().Amount()
+		if cmd.MonthlyIncome() != nil {
+			amount = *cmd.MonthlyIncome()
+		}
+		currency := coBorrower.MonthlyIncome().Currency()
+		if cmd.Currency() != nil {
+			currency = valueobjects.Currency(*cmd.Currency())
+		}
+
+		monthlyIncome, err := valueobjects.NewMonthlyIncome(amount, currency)
+		if err != nil {
+			return nil, err
+		}
+		if err := coBorrower.UpdateMonthlyIncome(monthlyIncome); err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.Relationship() != nil {
+		relationship, err := valueobjects.NewRelationship(*cmd.Relationship())
+		if err != nil {
+			return nil, err
+		}
+		coBorrower.UpdateRelationship(relationship)
+	}
+
+	if err := s.coBorrowerRepo.Update(ctx, coBorrower); err != nil {
+		return nil, err
+	}
+	return coBorrower, nil
+}
+
+func (s *coBorrowerCommandServiceImpl) HandleRemove(ctx context.Context, cmd *commands.RemoveCoBorrowerCommand) error {
+	coBorrower, err := s.findOwnedCoBorrower(ctx, cmd.UserID(), cmd.CoBorrowerID())
+	if err != nil {
+		return err
+	}
+	return s.coBorrowerRepo.Delete(ctx, coBorrower.ID())
+}
+
+func (s *coBorrowerCommandServiceImpl) findProfile(ctx context.Context, userID valueobjects.UserID) (*entities.Profile, error) {
+	profile, err := s.profileRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if profile == nil {
+		return nil, errors.New("profile not found")
+	}
+	return profile, nil
+}
+
+// findOwnedCoBorrower busca el co-prestatario y verifica que pertenezca al perfil del usuario
+func (s *coBorrowerCommandServiceImpl) findOwnedCoBorrower(
+	ctx context.Context,
+	userID valueobjects.UserID,
+	coBorrowerID valueobjects.CoBorrowerID,
+) (*entities.CoBorrower, error) {
+	profile, err := s.findProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	coBorrower, err := s.coBorrowerRepo.FindByID(ctx, coBorrowerID)
+	if err != nil {
+		return nil, err
+	}
+	if coBorrower == nil || coBorrower.ProfileID().Value() != profile.ID().Value() {
+		return nil, errors.New("co-borrower not found")
+	}
+	return coBorrower, nil
+}
@@ -0,0 +1,148 @@
+package commandservices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"finanzas-backend/internal/profile/domain/model/commands"
+	"finanzas-backend/internal/profile/domain/model/entities"
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+	"finanzas-backend/internal/profile/domain/repositories"
+	"finanzas-backend/internal/profile/domain/services"
+)
+
+// maxObligationsPerProfile limita las obligaciones registradas por titular
+const maxObligationsPerProfile = 20
+
+type financialObligationCommandServiceImpl struct {
+	profileRepo    repositories.ProfileRepository
+	obligationRepo repositories.FinancialObligationRepository
+}
+
+func NewFinancialObligationCommandService(
+	profileRepo repositories.ProfileRepository,
+	obligationRepo repositories.FinancialObligationRepository,
+) services.FinancialObligationCommandService {
+	return &financialObligationCommandServiceImpl{
+		profileRepo:    profileRepo,
+		obligationRepo: obligationRepo,
+	}
+}
+
+func (s *financialObligationCommandServiceImpl) HandleAdd(ctx context.Context, cmd *commands.AddObligationCommand) (*entities.FinancialObligation, error) {
+	profile, err := s.profileRepo.FindByUserID(ctx, cmd.UserID())
+	if err != nil {
+		return nil, err
+	}
+	if profile == nil {
+		return nil, errors.New("profile not found")
+	}
+
+	existing, err := s.obligationRepo.FindByProfileID(ctx, profile.ID())
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= maxObligationsPerProfile {
+		return nil, fmt.Errorf("a profile can have at most %d obligations", maxObligationsPerProfile)
+	}
+
+	obligationType, err := valueobjects.NewObligationType(cmd.ObligationType())
+	if err != nil {
+		return nil, err
+	}
+
+	obligation, err := entities.NewFinancialObligation(
+		profile.ID(),
+		obligationType,
+		cmd.Creditor(),
+		cmd.MonthlyPayment(),
+		valueobjects.Currency(cmd.Currency()),
+		cmd.OutstandingBalance(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.obligationRepo.Save(ctx, obligation); err != nil {
+		return nil, err
+	}
+	return obligation, nil
+}
+
+func (s *financialObligationCommandServiceImpl) HandleUpdate(ctx context.Context, cmd *commands.UpdateObligationCommand) (*entities.FinancialObligation, error) {
+	obligation, err := s.findOwnedObligation(ctx, cmd.UserID(), cmd.ObligationID())
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.ObligationType() != nil || cmd.Creditor() != nil {
+		obligationType := obligation.Type()
+		if cmd.ObligationType() != nil {
+			obligationType, err = valueobjects.NewObligationType(*cmd.ObligationType())
+			if err != nil {
+				return nil, err
+			}
+		}
+		creditor := obligation.Creditor()
+		if cmd.Creditor() != nil {
+			creditor = *cmd.Creditor()
+		}
+		obligation.UpdateDetails(obligationType, creditor)
+	}
+
+	if cmd.MonthlyPayment() != nil || cmd.Currency() != nil || cmd.OutstandingBalance() != nil {
+		monthlyPayment := obligation.MonthlyPayment()
+		if cmd.MonthlyPayment() != nil {
+			monthlyPayment = *cmd.MonthlyPayment()
+		}
+		currency := obligation.Currency()
+		if cmd.Currency() != nil {
+			currency = valueobjects.Currency(*cmd.Currency())
+		}
+		outstandingBalance := obligation.OutstandingBalance()
+		if cmd.OutstandingBalance() != nil {
+			outstandingBalance = *cmd.OutstandingBalance()
+		}
+		if err := obligation.UpdatePayment(monthlyPayment, currency, outstandingBalance); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.obligationRepo.Update(ctx, obligation); err != nil {
+		return nil, err
+	}
+	return obligation, nil
+}
+
+func (s *financialObligationCommandServiceImpl) HandleRemove(ctx context.Context, cmd *commands.RemoveObligationCommand) error {
+	obligation, err := s.findOwnedObligation(ctx, cmd.UserID(), cmd.ObligationID())
+	if err != nil {
+		return err
+	}
+	return s.obligationRepo.Delete(ctx, obligation.ID())
+}
+
+// findOwnedObligation busca la obligación y verifica que pertenezca al perfil del usuario
+func (s *financialObligationCommandServiceImpl) findOwnedObligation(
+	ctx context.Context,
+	userID valueobjects.UserID,
+	obligationID valueobjects.ObligationID,
+) (*entities.FinancialObligation, error) {
+	profile, err := s.profileRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if profile == nil {
+		return nil, errors.New("profile not found")
+	}
+
+	obligation, err := s.obligationRepo.FindByID(ctx, obligationID)
+	if err != nil {
+		return nil, err
+	}
+	if obligation == nil || obligation.ProfileID().Value() != profile.ID().Value() {
+		return nil, errors.New("obligation not found")
+	}
+	return obligation, nil
+}
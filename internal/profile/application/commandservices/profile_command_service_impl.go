@@ -8,21 +8,22 @@ import (
 	"finanzas-backend/internal/profile/domain/model/valueobjects"
 	"finanzas-backend/internal/profile/domain/repositories"
 	"finanzas-backend/internal/profile/domain/services"
-	"finanzas-backend/internal/profile/infrastructure/external"
+	"finanzas-backend/internal/shared/infrastructure/identity"
+	"fmt"
 )
 
 type profileCommandServiceImpl struct {
-	profileRepo   repositories.ProfileRepository
-	reniecService *external.ReniecService
+	profileRepo      repositories.ProfileRepository
+	identityProvider identity.Provider
 }
 
 func NewProfileCommandService(
 	profileRepo repositories.ProfileRepository,
-	reniecService *external.ReniecService,
+	identityProvider identity.Provider,
 ) services.ProfileCommandService {
 	return &profileCommandServiceImpl{
-		profileRepo:   profileRepo,
-		reniecService: reniecService,
+		profileRepo:      profileRepo,
+		identityProvider: identityProvider,
 	}
 }
 
@@ -37,9 +38,9 @@ func (s *profileCommandServiceImpl) HandleCreate(ctx context.Context, cmd comman
 	}
 
 	// Step 2: Validate DNI with RENIEC
-	personData, err := s.reniecService.GetPersonDataByDNI(ctx, cmd.DNI())
+	personData, err := s.identityProvider.LookupDNI(ctx, cmd.DNI())
 	if err != nil {
-		return nil, errors.New("DNI validation failed: " + err.Error())
+		return nil, fmt.Errorf("DNI validation failed: %w", err)
 	}
 
 	// Step 3: Check if DNI is already registered by another user
@@ -145,6 +146,13 @@ func (s *profileCommandServiceImpl) HandleUpdate(ctx context.Context, cmd *comma
 		profile.UpdateHasOwnLand(*cmd.HasOwnLand())
 	}
 
+	// Update dependents if provided
+	if cmd.Dependents() != nil {
+		if err := profile.UpdateDependents(*cmd.Dependents()); err != nil {
+			return err
+		}
+	}
+
 	// Update in repository
 	return s.profileRepo.Update(ctx, profile)
 }
@@ -0,0 +1,33 @@
+package queryservices
+
+import (
+	"context"
+	"finanzas-backend/internal/profile/domain/model/entities"
+	"finanzas-backend/internal/profile/domain/model/queries"
+	"finanzas-backend/internal/profile/domain/repositories"
+	"finanzas-backend/internal/profile/domain/services"
+)
+
+type coBorrowerQueryServiceImpl struct {
+	profileRepo    repositories.ProfileRepository
+	coBorrowerRepo repositories.CoBorrowerRepository
+}
+
+func NewCoBorrowerQueryService(
+	profileRepo repositories.ProfileRepository,
+	coBorrowerRepo repositories.CoBorrowerRepository,
+) services.CoBorrowerQueryService {
+	return &coBorrowerQueryServiceImpl{
+		profileRepo:    profileRepo,
+		coBorrowerRepo: coBorrowerRepo,
+	}
+}
+
+// HandleFindByUserID retorna los co-prestatarios del perfil del usuario, o nil si no tiene perfil
+func (s *coBorrowerQueryServiceImpl) HandleFindByUserID(ctx context.Context, query queries.FindCoBorrowersByUserIDQuery) ([]*entities.CoBorrower, error) {
+	profile, err := s.profileRepo.FindByUserID(ctx, query.UserID())
+	if err != nil || profile == nil {
+		return nil, err
+	}
+	return s.coBorrowerRepo.FindByProfileID(ctx, profile.ID())
+}
@@ -0,0 +1,56 @@
+package queryservices
+
+import (
+	"context"
+	"finanzas-backend/internal/profile/application/acl"
+	"finanzas-backend/internal/profile/domain/model/entities"
+	"finanzas-backend/internal/profile/domain/model/queries"
+	"finanzas-backend/internal/profile/domain/repositories"
+	"finanzas-backend/internal/profile/domain/services"
+)
+
+type financialObligationQueryServiceImpl struct {
+	profileRepo    repositories.ProfileRepository
+	obligationRepo repositories.FinancialObligationRepository
+	exchangeRates  *acl.ExternalExchangeRateService
+}
+
+func NewFinancialObligationQueryService(
+	profileRepo repositories.ProfileRepository,
+	obligationRepo repositories.FinancialObligationRepository,
+	exchangeRates *acl.ExternalExchangeRateService,
+) services.FinancialObligationQueryService {
+	return &financialObligationQueryServiceImpl{
+		profileRepo:    profileRepo,
+		obligationRepo: obligationRepo,
+		exchangeRates:  exchangeRates,
+	}
+}
+
+// HandleFindByUserID retorna las obligaciones del perfil del usuario, o nil si no tiene perfil
+func (s *financialObligationQueryServiceImpl) HandleFindByUserID(ctx context.Context, query queries.FindObligationsByUserIDQuery) ([]*entities.FinancialObligation, error) {
+	profile, err := s.profileRepo.FindByUserID(ctx, query.UserID())
+	if err != nil || profile == nil {
+		return nil, err
+	}
+	return s.obligationRepo.FindByProfileID(ctx, profile.ID())
+}
+
+// HandleGetFinancialSnapshot calcula la situación financiera del usuario, o nil si no tiene perfil
+func (s *financialObligationQueryServiceImpl) HandleGetFinancialSnapshot(ctx context.Context, query queries.GetFinancialSnapshotQuery) (*entities.FinancialSnapshot, error) {
+	profile, err := s.profileRepo.FindByUserID(ctx, query.UserID())
+	if err != nil || profile == nil {
+		return nil, err
+	}
+
+	obligations, err := s.obligationRepo.FindByProfileID(ctx, profile.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	exchangeRate, err := s.exchangeRates.CurrentRate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return entities.NewFinancialSnapshot(profile, obligations, exchangeRate), nil
+}
@@ -29,3 +29,7 @@ func (s *profileQueryServiceImpl) HandleFindByUserID(ctx context.Context, query
 func (s *profileQueryServiceImpl) HandleFindByDNI(ctx context.Context, query queries.FindProfileByDNIQuery) (*entities.Profile, error) {
 	return s.profileRepo.FindByDNI(ctx, query.DNI())
 }
+
+func (s *profileQueryServiceImpl) HandleFindByIncomeBand(ctx context.Context, query queries.FindProfilesByIncomeBandQuery) ([]*entities.Profile, error) {
+	return s.profileRepo.FindByIncomeBand(ctx, query.Band())
+}
@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"errors"
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+)
+
+type AddCoBorrowerCommand struct {
+	userID        valueobjects.UserID
+	dni           string
+	monthlyIncome float64
+	currency      string
+	relationship  string
+}
+
+func NewAddCoBorrowerCommand(
+	userID valueobjects.UserID,
+	dni string,
+	monthlyIncome float64,
+	currency string,
+	relationship string,
+) (*AddCoBorrowerCommand, error) {
+	if _, err := valueobjects.NewDNI(dni); err != nil {
+		return nil, err
+	}
+	if monthlyIncome <= 0 {
+		return nil, errors.New("co-borrower monthly income must be greater than zero")
+	}
+	if _, err := valueobjects.NewMonthlyIncome(monthlyIncome, valueobjects.Currency(currency)); err != nil {
+		return nil, err
+	}
+	if _, err := valueobjects.NewRelationship(relationship); err != nil {
+		return nil, err
+	}
+
+	return &AddCoBorrowerCommand{
+		userID:        userID,
+		dni:           dni,
+		monthlyIncome: monthlyIncome,
+		currency:      currency,
+		relationship:  relationship,
+	}, nil
+}
+
+func (c *AddCoBorrowerCommand) UserID() valueobjects.UserID { return c.userID }
+func (c *AddCoBorrowerCommand) DNI() string                 { return c.dni }
+func (c *AddCoBorrowerCommand) MonthlyIncome() float64      { return c.monthlyIncome }
+func (c *AddCoBorrowerCommand) Currency() string            { return c.currency }
+func (c *AddCoBorrowerCommand) Relationship() string        { return c.relationship }
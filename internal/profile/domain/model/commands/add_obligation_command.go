@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"errors"
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+)
+
+type AddObligationCommand struct {
+	userID             valueobjects.UserID
+	obligationType     string
+	creditor           string
+	monthlyPayment     float64
+	currency           string
+	outstandingBalance float64
+}
+
+func NewAddObligationCommand(
+	userID valueobjects.UserID,
+	obligationType string,
+	creditor string,
+	monthlyPayment float64,
+	currency string,
+	outstandingBalance float64,
+) (*AddObligationCommand, error) {
+	if _, err := valueobjects.NewObligationType(obligationType); err != nil {
+		return nil, err
+	}
+	if monthlyPayment <= 0 {
+		return nil, errors.New("monthly payment must be greater than zero")
+	}
+	if valueobjects.Currency(currency) != valueobjects.CurrencyPEN && valueobjects.Currency(currency) != valueobjects.CurrencyUSD {
+		return nil, errors.New("currency must be PEN or USD")
+	}
+	if outstandingBalance < 0 {
+		return nil, errors.New("outstanding balance cannot be negative")
+	}
+
+	return &AddObligationCommand{
+		userID:             userID,
+		obligationType:     obligationType,
+		creditor:           creditor,
+		monthlyPayment:     monthlyPayment,
+		currency:           currency,
+		outstandingBalance: outstandingBalance,
+	}, nil
+}
+
+func (c *AddObligationCommand) UserID() valueobjects.UserID { return c.userID }
+func (c *AddObligationCommand) ObligationType() string      { return c.obligationType }
+func (c *AddObligationCommand) Creditor() string            { return c.creditor }
+func (c *AddObligationCommand) MonthlyPayment() float64     { return c.monthlyPayment }
+func (c *AddObligationCommand) Currency() string            { return c.currency }
+func (c *AddObligationCommand) OutstandingBalance() float64 { return c.outstandingBalance }
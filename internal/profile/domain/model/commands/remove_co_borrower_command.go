@@ -0,0 +1,21 @@
+package commands
+
+import (
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+)
+
+type RemoveCoBorrowerCommand struct {
+	userID       valueobjects.UserID
+	coBorrowerID valueobjects.CoBorrowerID
+}
+
+func NewRemoveCoBorrowerCommand(userID valueobjects.UserID, coBorrowerID string) (*RemoveCoBorrowerCommand, error) {
+	id, err := valueobjects.NewCoBorrowerIDFromString(coBorrowerID)
+	if err != nil {
+		return nil, err
+	}
+	return &RemoveCoBorrowerCommand{userID: userID, coBorrowerID: id}, nil
+}
+
+func (c *RemoveCoBorrowerCommand) UserID() valueobjects.UserID             { return c.userID }
+func (c *RemoveCoBorrowerCommand) CoBorrowerID() valueobjects.CoBorrowerID { return c.coBorrowerID }
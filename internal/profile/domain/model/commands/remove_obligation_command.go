@@ -0,0 +1,21 @@
+package commands
+
+import (
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+)
+
+type RemoveObligationCommand struct {
+	userID       valueobjects.UserID
+	obligationID valueobjects.ObligationID
+}
+
+func NewRemoveObligationCommand(userID valueobjects.UserID, obligationID string) (*RemoveObligationCommand, error) {
+	id, err := valueobjects.NewObligationIDFromString(obligationID)
+	if err != nil {
+		return nil, err
+	}
+	return &RemoveObligationCommand{userID: userID, obligationID: id}, nil
+}
+
+func (c *RemoveObligationCommand) UserID() valueobjects.UserID             { return c.userID }
+func (c *RemoveObligationCommand) ObligationID() valueobjects.ObligationID { return c.obligationID }
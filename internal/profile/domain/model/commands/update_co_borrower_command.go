@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"errors"
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+)
+
+type UpdateCoBorrowerCommand struct {
+	userID        valueobjects.UserID
+	coBorrowerID  valueobjects.CoBorrowerID
+	monthlyIncome *float64
+	currency      *string
+	relationship  *string
+}
+
+func NewUpdateCoBorrowerCommand(
+	userID valueobjects.UserID,
+	coBorrowerID string,
+	monthlyIncome *float64,
+	currency *string,
+	relationship *string,
+) (*UpdateCoBorrowerCommand, error) {
+	id, err := valueobjects.NewCoBorrowerIDFromString(coBorrowerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Treat empty strings as nil
+	if currency != nil && *currency == "" {
+		currency = nil
+	}
+	if relationship != nil && *relationship == "" {
+		relationship = nil
+	}
+
+	if monthlyIncome == nil && currency == nil && relationship == nil {
+		return nil, errors.New("at least one field must be provided for update")
+	}
+	if monthlyIncome != nil && *monthlyIncome <= 0 {
+		return nil, errors.New("co-borrower monthly income must be greater than zero")
+	}
+	if relationship != nil {
+		if _, err := valueobjects.NewRelationship(*relationship); err != nil {
+			return nil, err
+		}
+	}
+
+	return &UpdateCoBorrowerCommand{
+		userID:        userID,
+		coBorrowerID:  id,
+		monthlyIncome: monthlyIncome,
+		currency:      currency,
+		relationship:  relationship,
+	}, nil
+}
+
+func (c *UpdateCoBorrowerCommand) UserID() valueobjects.UserID             { return c.userID }
+func (c *UpdateCoBorrowerCommand) CoBorrowerID() valueobjects.CoBorrowerID { return c.coBorrowerID }
+func (c *UpdateCoBorrowerCommand) MonthlyIncome() *float64                 { return c.monthlyIncome }
+func (c *UpdateCoBorrowerCommand) Currency() *string                       { return c.currency }
+func (c *UpdateCoBorrowerCommand) Relationship() *string                   { return c.relationship }
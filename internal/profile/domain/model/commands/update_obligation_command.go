@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"errors"
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+)
+
+type UpdateObligationCommand struct {
+	userID             valueobjects.UserID
+	obligationID       valueobjects.ObligationID
+	obligationType     *string
+	creditor           *string
+	monthlyPayment     *float64
+	currency           *string
+	outstandingBalance *float64
+}
+
+func NewUpdateObligationCommand(
+	userID valueobjects.UserID,
+	obligationID string,
+	obligationType *string,
+	creditor *string,
+	monthlyPayment *float64,
+	currency *string,
+	outstandingBalance *float64,
+) (*UpdateObligationCommand, error) {
+	id, err := valueobjects.NewObligationIDFromString(obligationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Treat empty strings as nil
+	if obligationType != nil && *obligationType == "" {
+		obligationType = nil
+	}
+	if currency != nil && *currency == "" {
+		currency = nil
+	}
+
+	if obligationType == nil && creditor == nil && monthlyPayment == nil && currency == nil && outstandingBalance == nil {
+		return nil, errors.New("at least one field must be provided for update")
+	}
+	if obligationType != nil {
+		if _, err := valueobjects.NewObligationType(*obligationType); err != nil {
+			return nil, err
+		}
+	}
+	if monthlyPayment != nil && *monthlyPayment <= 0 {
+		return nil, errors.New("monthly payment must be greater than zero")
+	}
+	if outstandingBalance != nil && *outstandingBalance < 0 {
+		return nil, errors.New("outstanding balance cannot be negative")
+	}
+
+	return &UpdateObligationCommand{
+		userID:             userID,
+		obligationID:       id,
+		obligationType:     obligationType,
+		creditor:           creditor,
+		monthlyPayment:     monthlyPayment,
+		currency:           currency,
+		outstandingBalance: outstandingBalance,
+	}, nil
+}
+
+func (c *UpdateObligationCommand) UserID() valueobjects.UserID             { return c.userID }
+func (c *UpdateObligationCommand) ObligationID() valueobjects.ObligationID { return c.obligationID }
+func (c *UpdateObligationCommand) ObligationType() *string                 { return c.obligationType }
+func (c *UpdateObligationCommand) Creditor() *string                       { return c.creditor }
+func (c *UpdateObligationCommand) MonthlyPayment() *float64                { return c.monthlyPayment }
+func (c *UpdateObligationCommand) Currency() *string                       { return c.currency }
+func (c *UpdateObligationCommand) OutstandingBalance() *float64            { return c.outstandingBalance }
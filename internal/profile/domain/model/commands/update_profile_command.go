@@ -13,6 +13,7 @@ type UpdateProfileCommand struct {
 	maritalStatus *string
 	isFirstHome   *bool
 	hasOwnLand    *bool
+	dependents    *int
 }
 
 func NewUpdateProfileCommand(
@@ -23,6 +24,7 @@ func NewUpdateProfileCommand(
 	maritalStatus *string,
 	isFirstHome *bool,
 	hasOwnLand *bool,
+	dependents *int,
 ) (*UpdateProfileCommand, error) {
 	if profileID.IsZero() {
 		return nil, errors.New("profile ID is required")
@@ -41,7 +43,7 @@ func NewUpdateProfileCommand(
 
 	// At least one field must be provided
 	if phoneNumber == nil && monthlyIncome == nil && currency == nil &&
-		maritalStatus == nil && isFirstHome == nil && hasOwnLand == nil {
+		maritalStatus == nil && isFirstHome == nil && hasOwnLand == nil && dependents == nil {
 		return nil, errors.New("at least one field must be provided for update")
 	}
 
@@ -49,6 +51,9 @@ func NewUpdateProfileCommand(
 	if monthlyIncome != nil && *monthlyIncome < 0 {
 		return nil, errors.New("monthly income cannot be negative")
 	}
+	if dependents != nil && *dependents < 0 {
+		return nil, errors.New("dependents cannot be negative")
+	}
 
 	return &UpdateProfileCommand{
 		profileID:     profileID,
@@ -58,6 +63,7 @@ func NewUpdateProfileCommand(
 		maritalStatus: maritalStatus,
 		isFirstHome:   isFirstHome,
 		hasOwnLand:    hasOwnLand,
+		dependents:    dependents,
 	}, nil
 }
 
@@ -68,3 +74,4 @@ func (c *UpdateProfileCommand) Currency() *string                 { return c.cur
 func (c *UpdateProfileCommand) MaritalStatus() *string            { return c.maritalStatus }
 func (c *UpdateProfileCommand) IsFirstHome() *bool                { return c.isFirstHome }
 func (c *UpdateProfileCommand) HasOwnLand() *bool                 { return c.hasOwnLand }
+func (c *UpdateProfileCommand) Dependents() *int                  { return c.dependents }
@@ -0,0 +1,114 @@
+package entities
+
+import (
+	"errors"
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+	"time"
+)
+
+// CoBorrower es un co-prestatario (crédito mancomunado) registrado en el perfil del titular.
+// Sus nombres provienen de RENIEC y su ingreso se suma al del titular para evaluar la capacidad de pago.
+type CoBorrower struct {
+	id             valueobjects.CoBorrowerID
+	profileID      valueobjects.ProfileID
+	dni            valueobjects.DNI
+	firstName      string
+	firstLastName  string
+	secondLastName string
+	monthlyIncome  valueobjects.MonthlyIncome
+	relationship   valueobjects.Relationship
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+func NewCoBorrower(
+	profileID valueobjects.ProfileID,
+	dni valueobjects.DNI,
+	firstName string,
+	firstLastName string,
+	secondLastName string,
+	monthlyIncome valueobjects.MonthlyIncome,
+	relationship valueobjects.Relationship,
+) (*CoBorrower, error) {
+	if profileID.IsZero() {
+		return nil, errors.New("profile ID is required")
+	}
+	if monthlyIncome.Amount() <= 0 {
+		return nil, errors.New("co-borrower monthly income must be greater than zero")
+	}
+
+	return &CoBorrower{
+		profileID:      profileID,
+		dni:            dni,
+		firstName:      firstName,
+		firstLastName:  firstLastName,
+		secondLastName: secondLastName,
+		monthlyIncome:  monthlyIncome,
+		relationship:   relationship,
+		createdAt:      time.Now(),
+		updatedAt:      time.Now(),
+	}, nil
+}
+
+func ReconstructCoBorrower(
+	id valueobjects.CoBorrowerID,
+	profileID valueobjects.ProfileID,
+	dni valueobjects.DNI,
+	firstName string,
+	firstLastName string,
+	secondLastName string,
+	monthlyIncome valueobjects.MonthlyIncome,
+	relationship valueobjects.Relationship,
+	createdAt, updatedAt time.Time,
+) *CoBorrower {
+	return &CoBorrower{
+		id:             id,
+		profileID:      profileID,
+		dni:            dni,
+		firstName:      firstName,
+		firstLastName:  firstLastName,
+		secondLastName: secondLastName,
+		monthlyIncome:  monthlyIncome,
+		relationship:   relationship,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+	}
+}
+
+// Getters
+func (c *CoBorrower) ID() valueobjects.CoBorrowerID             { return c.id }
+func (c *CoBorrower) ProfileID() valueobjects.ProfileID         { return c.profileID }
+func (c *CoBorrower) DNI() valueobjects.DNI                     { return c.dni }
+func (c *CoBorrower) FirstName() string                         { return c.firstName }
+func (c *CoBorrower) FirstLastName() string                     { return c.firstLastName }
+func (c *CoBorrower) SecondLastName() string                    { return c.secondLastName }
+func (c *CoBorrower) MonthlyIncome() valueobjects.MonthlyIncome { return c.monthlyIncome }
+func (c *CoBorrower) Relationship() valueobjects.Relationship   { return c.relationship }
+func (c *CoBorrower) CreatedAt() time.Time                      { return c.createdAt }
+func (c *CoBorrower) UpdatedAt() time.Time                      { return c.updatedAt }
+
+func (c *CoBorrower) FullName() string {
+	return c.firstLastName + " " + c.secondLastName + " " + c.firstName
+}
+
+func (c *CoBorrower) SetID(id valueobjects.CoBorrowerID) {
+	c.id = id
+}
+
+func (c *CoBorrower) SetDNI(dni valueobjects.DNI) {
+	c.dni = dni
+}
+
+func (c *CoBorrower) UpdateMonthlyIncome(monthlyIncome valueobjects.MonthlyIncome) error {
+	if monthlyIncome.Amount() <= 0 {
+		return errors.New("co-borrower monthly income must be greater than zero")
+	}
+	c.monthlyIncome = monthlyIncome
+	c.updatedAt = time.Now()
+	return nil
+}
+
+func (c *CoBorrower) UpdateRelationship(relationship valueobjects.Relationship) {
+	c.relationship = relationship
+	c.updatedAt = time.Now()
+}
@@ -0,0 +1,106 @@
+package entities
+
+import (
+	"errors"
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+	"time"
+)
+
+// FinancialObligation es una deuda o gasto fijo mensual del titular del perfil
+type FinancialObligation struct {
+	id                 valueobjects.ObligationID
+	profileID          valueobjects.ProfileID
+	obligationType     valueobjects.ObligationType
+	creditor           string  // Entidad acreedora o descripción del gasto
+	monthlyPayment     float64 // Pago mensual
+	currency           valueobjects.Currency
+	outstandingBalance float64 // Saldo pendiente (0 si no aplica)
+	createdAt          time.Time
+	updatedAt          time.Time
+}
+
+func NewFinancialObligation(
+	profileID valueobjects.ProfileID,
+	obligationType valueobjects.ObligationType,
+	creditor string,
+	monthlyPayment float64,
+	currency valueobjects.Currency,
+	outstandingBalance float64,
+) (*FinancialObligation, error) {
+	if profileID.IsZero() {
+		return nil, errors.New("profile ID is required")
+	}
+
+	obligation := &FinancialObligation{
+		profileID:      profileID,
+		obligationType: obligationType,
+		creditor:       creditor,
+		createdAt:      time.Now(),
+		updatedAt:      time.Now(),
+	}
+	if err := obligation.UpdatePayment(monthlyPayment, currency, outstandingBalance); err != nil {
+		return nil, err
+	}
+	return obligation, nil
+}
+
+func ReconstructFinancialObligation(
+	id valueobjects.ObligationID,
+	profileID valueobjects.ProfileID,
+	obligationType valueobjects.ObligationType,
+	creditor string,
+	monthlyPayment float64,
+	currency valueobjects.Currency,
+	outstandingBalance float64,
+	createdAt, updatedAt time.Time,
+) *FinancialObligation {
+	return &FinancialObligation{
+		id:                 id,
+		profileID:          profileID,
+		obligationType:     obligationType,
+		creditor:           creditor,
+		monthlyPayment:     monthlyPayment,
+		currency:           currency,
+		outstandingBalance: outstandingBalance,
+		createdAt:          createdAt,
+		updatedAt:          updatedAt,
+	}
+}
+
+// Getters
+func (o *FinancialObligation) ID() valueobjects.ObligationID     { return o.id }
+func (o *FinancialObligation) ProfileID() valueobjects.ProfileID { return o.profileID }
+func (o *FinancialObligation) Type() valueobjects.ObligationType { return o.obligationType }
+func (o *FinancialObligation) Creditor() string                  { return o.creditor }
+func (o *FinancialObligation) MonthlyPayment() float64           { return o.monthlyPayment }
+func (o *FinancialObligation) Currency() valueobjects.Currency   { return o.currency }
+func (o *FinancialObligation) OutstandingBalance() float64       { return o.outstandingBalance }
+func (o *FinancialObligation) CreatedAt() time.Time              { return o.createdAt }
+func (o *FinancialObligation) UpdatedAt() time.Time              { return o.updatedAt }
+
+func (o *FinancialObligation) SetID(id valueobjects.ObligationID) {
+	o.id = id
+}
+
+func (o *FinancialObligation) UpdatePayment(monthlyPayment float64, currency valueobjects.Currency, outstandingBalance float64) error {
+	if monthlyPayment <= 0 {
+		return errors.New("monthly payment must be greater than zero")
+	}
+	if currency != valueobjects.CurrencyPEN && currency != valueobjects.CurrencyUSD {
+		return errors.New("currency must be PEN or USD")
+	}
+	if outstandingBalance < 0 {
+		return errors.New("outstanding balance cannot be negative")
+	}
+	o.monthlyPayment = monthlyPayment
+	o.currency = currency
+	o.outstandingBalance = outstandingBalance
+	o.updatedAt = time.Now()
+	return nil
+}
+
+func (o *FinancialObligation) UpdateDetails(obligationType valueobjects.ObligationType, creditor string) {
+	o.obligationType = obligationType
+	o.creditor = creditor
+	o.updatedAt = time.Now()
+}
@@ -0,0 +1,71 @@
+package entities
+
+import "finanzas-backend/internal/profile/domain/model/valueobjects"
+
+// FinancialSnapshot resume la capacidad de pago del titular en la moneda de su ingreso:
+// ingreso, deudas y gastos mensuales, ingreso neto disponible y ratio de endeudamiento.
+// Las obligaciones en otra moneda se convierten con el tipo de cambio vigente; sin tipo de cambio
+// no se suman y se reportan en ExcludedObligations.
+type FinancialSnapshot struct {
+	currency             valueobjects.Currency
+	monthlyIncome        float64
+	totalDebtPayments    float64
+	totalExpenses        float64
+	dependents           int
+	convertedObligations int
+	excludedObligations  int
+	exchangeRate         *valueobjects.ExchangeRate
+}
+
+// NewFinancialSnapshot arma la situación financiera; exchangeRate puede ser nil si no hay tipo de cambio vigente
+func NewFinancialSnapshot(profile *Profile, obligations []*FinancialObligation, exchangeRate *valueobjects.ExchangeRate) *FinancialSnapshot {
+	snapshot := &FinancialSnapshot{
+		currency:      profile.MonthlyIncome().Currency(),
+		monthlyIncome: profile.MonthlyIncome().Amount(),
+		dependents:    profile.Dependents(),
+	}
+
+	for _, obligation := range obligations {
+		payment := obligation.MonthlyPayment()
+		if obligation.Currency() != snapshot.currency {
+			if exchangeRate == nil {
+				snapshot.excludedObligations++
+				continue
+			}
+			payment = exchangeRate.Convert(payment, obligation.Currency(), snapshot.currency)
+			snapshot.convertedObligations++
+			snapshot.exchangeRate = exchangeRate
+		}
+
+		if obligation.Type().IsDebt() {
+			snapshot.totalDebtPayments += payment
+		} else {
+			snapshot.totalExpenses += payment
+		}
+	}
+	return snapshot
+}
+
+func (s *FinancialSnapshot) Currency() valueobjects.Currency { return s.currency }
+func (s *FinancialSnapshot) MonthlyIncome() float64          { return s.monthlyIncome }
+func (s *FinancialSnapshot) TotalDebtPayments() float64      { return s.totalDebtPayments }
+func (s *FinancialSnapshot) TotalExpenses() float64          { return s.totalExpenses }
+func (s *FinancialSnapshot) Dependents() int                 { return s.dependents }
+func (s *FinancialSnapshot) ConvertedObligations() int       { return s.convertedObligations }
+func (s *FinancialSnapshot) ExcludedObligations() int        { return s.excludedObligations }
+
+// ExchangeRate es el tipo de cambio usado para convertir obligaciones, o nil si no se convirtió ninguna
+func (s *FinancialSnapshot) ExchangeRate() *valueobjects.ExchangeRate { return s.exchangeRate }
+
+// NetDisposableIncome es el ingreso menos deudas y gastos mensuales (puede ser negativo)
+func (s *FinancialSnapshot) NetDisposableIncome() float64 {
+	return s.monthlyIncome - s.totalDebtPayments - s.totalExpenses
+}
+
+// DebtServiceRatio es la proporción del ingreso destinada a pagar deudas; 0 sin ingreso declarado
+func (s *FinancialSnapshot) DebtServiceRatio() float64 {
+	if s.monthlyIncome <= 0 {
+		return 0
+	}
+	return s.totalDebtPayments / s.monthlyIncome
+}
@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"errors"
 	"finanzas-backend/internal/profile/domain/model/valueobjects"
 	"time"
 )
@@ -17,6 +18,7 @@ type Profile struct {
 	maritalStatus  valueobjects.MaritalStatus
 	isFirstHome    bool
 	hasOwnLand     bool
+	dependents     int // Personas que dependen económicamente del titular
 	createdAt      time.Time
 	updatedAt      time.Time
 }
@@ -61,6 +63,7 @@ func ReconstructProfile(
 	maritalStatus valueobjects.MaritalStatus,
 	isFirstHome bool,
 	hasOwnLand bool,
+	dependents int,
 	createdAt, updatedAt time.Time,
 ) *Profile {
 	return &Profile{
@@ -75,6 +78,7 @@ func ReconstructProfile(
 		maritalStatus:  maritalStatus,
 		isFirstHome:    isFirstHome,
 		hasOwnLand:     hasOwnLand,
+		dependents:     dependents,
 		createdAt:      createdAt,
 		updatedAt:      updatedAt,
 	}
@@ -92,6 +96,7 @@ func (p *Profile) MonthlyIncome() valueobjects.MonthlyIncome { return p.monthlyI
 func (p *Profile) MaritalStatus() valueobjects.MaritalStatus { return p.maritalStatus }
 func (p *Profile) IsFirstHome() bool                         { return p.isFirstHome }
 func (p *Profile) HasOwnLand() bool                          { return p.hasOwnLand }
+func (p *Profile) Dependents() int                           { return p.dependents }
 func (p *Profile) CreatedAt() time.Time                      { return p.createdAt }
 func (p *Profile) UpdatedAt() time.Time                      { return p.updatedAt }
 
@@ -131,3 +136,12 @@ func (p *Profile) UpdateHasOwnLand(hasOwnLand bool) {
 	p.hasOwnLand = hasOwnLand
 	p.updatedAt = time.Now()
 }
+
+func (p *Profile) UpdateDependents(dependents int) error {
+	if dependents < 0 {
+		return errors.New("dependents cannot be negative")
+	}
+	p.dependents = dependents
+	p.updatedAt = time.Now()
+	return nil
+}
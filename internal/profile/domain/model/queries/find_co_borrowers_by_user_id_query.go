@@ -0,0 +1,21 @@
+package queries
+
+import (
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+)
+
+type FindCoBorrowersByUserIDQuery struct {
+	userID valueobjects.UserID
+}
+
+func NewFindCoBorrowersByUserIDQuery(userID string) (FindCoBorrowersByUserIDQuery, error) {
+	id, err := valueobjects.NewUserIDFromString(userID)
+	if err != nil {
+		return FindCoBorrowersByUserIDQuery{}, err
+	}
+	return FindCoBorrowersByUserIDQuery{userID: id}, nil
+}
+
+func (q FindCoBorrowersByUserIDQuery) UserID() valueobjects.UserID {
+	return q.userID
+}
@@ -0,0 +1,21 @@
+package queries
+
+import (
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+)
+
+type FindObligationsByUserIDQuery struct {
+	userID valueobjects.UserID
+}
+
+func NewFindObligationsByUserIDQuery(userID string) (FindObligationsByUserIDQuery, error) {
+	id, err := valueobjects.NewUserIDFromString(userID)
+	if err != nil {
+		return FindObligationsByUserIDQuery{}, err
+	}
+	return FindObligationsByUserIDQuery{userID: id}, nil
+}
+
+func (q FindObligationsByUserIDQuery) UserID() valueobjects.UserID {
+	return q.userID
+}
@@ -0,0 +1,21 @@
+package queries
+
+import (
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+)
+
+type FindProfilesByIncomeBandQuery struct {
+	band valueobjects.IncomeBand
+}
+
+func NewFindProfilesByIncomeBandQuery(band string) (FindProfilesByIncomeBandQuery, error) {
+	incomeBand, err := valueobjects.NewIncomeBand(band)
+	if err != nil {
+		return FindProfilesByIncomeBandQuery{}, err
+	}
+	return FindProfilesByIncomeBandQuery{band: incomeBand}, nil
+}
+
+func (q FindProfilesByIncomeBandQuery) Band() valueobjects.IncomeBand {
+	return q.band
+}
@@ -0,0 +1,21 @@
+package queries
+
+import (
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+)
+
+type GetFinancialSnapshotQuery struct {
+	userID valueobjects.UserID
+}
+
+func NewGetFinancialSnapshotQuery(userID string) (GetFinancialSnapshotQuery, error) {
+	id, err := valueobjects.NewUserIDFromString(userID)
+	if err != nil {
+		return GetFinancialSnapshotQuery{}, err
+	}
+	return GetFinancialSnapshotQuery{userID: id}, nil
+}
+
+func (q GetFinancialSnapshotQuery) UserID() valueobjects.UserID {
+	return q.userID
+}
@@ -0,0 +1,38 @@
+package valueobjects
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type CoBorrowerID struct {
+	value uuid.UUID
+}
+
+func NewCoBorrowerID(value uuid.UUID) (CoBorrowerID, error) {
+	if value == uuid.Nil {
+		return CoBorrowerID{}, errors.New("co-borrower ID cannot be nil")
+	}
+	return CoBorrowerID{value: value}, nil
+}
+
+func NewCoBorrowerIDFromString(value string) (CoBorrowerID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return CoBorrowerID{}, errors.New("invalid co-borrower ID format")
+	}
+	return NewCoBorrowerID(id)
+}
+
+func (c CoBorrowerID) Value() uuid.UUID {
+	return c.value
+}
+
+func (c CoBorrowerID) String() string {
+	return c.value.String()
+}
+
+func (c CoBorrowerID) IsZero() bool {
+	return c.value == uuid.Nil
+}
@@ -0,0 +1,36 @@
+package valueobjects
+
+import (
+	"errors"
+	"time"
+)
+
+// ExchangeRate es el tipo de cambio (S/ por US$) con el que se suman montos en otra moneda
+type ExchangeRate struct {
+	buy  float64
+	sell float64
+	date time.Time
+}
+
+func NewExchangeRate(buy, sell float64, date time.Time) (ExchangeRate, error) {
+	if buy <= 0 || sell <= 0 {
+		return ExchangeRate{}, errors.New("exchange rates must be greater than 0")
+	}
+	return ExchangeRate{buy: buy, sell: sell, date: date}, nil
+}
+
+func (r ExchangeRate) Buy() float64    { return r.buy }
+func (r ExchangeRate) Sell() float64   { return r.sell }
+func (r ExchangeRate) Date() time.Time { return r.date }
+
+// Convert expresa amount en la moneda to: USD→PEN multiplica por la compra, PEN→USD divide entre la venta
+func (r ExchangeRate) Convert(amount float64, from, to Currency) float64 {
+	switch {
+	case from == to:
+		return amount
+	case from == CurrencyUSD && to == CurrencyPEN:
+		return amount * r.buy
+	default:
+		return amount / r.sell
+	}
+}
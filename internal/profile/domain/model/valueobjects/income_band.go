@@ -0,0 +1,36 @@
+package valueobjects
+
+import "errors"
+
+// IncomeBand es un rango grueso de ingreso mensual. No identifica a la persona,
+// por lo que puede guardarse sin cifrar y usarse para consultas y segmentación.
+type IncomeBand string
+
+const (
+	IncomeBandNone        IncomeBand = "none" // Ingreso no informado
+	IncomeBandVeryLow     IncomeBand = "very_low"
+	IncomeBandLow         IncomeBand = "low"
+	IncomeBandMiddle      IncomeBand = "middle"
+	IncomeBandUpperMiddle IncomeBand = "upper_middle"
+	IncomeBandHigh        IncomeBand = "high"
+)
+
+// incomeBandLimits son los topes (exclusivos) de cada banda por moneda, de very_low a upper_middle
+var incomeBandLimits = map[Currency][4]float64{
+	CurrencyPEN: {1500, 3000, 6000, 12000},
+	CurrencyUSD: {400, 800, 1600, 3200},
+}
+
+func NewIncomeBand(value string) (IncomeBand, error) {
+	band := IncomeBand(value)
+	switch band {
+	case IncomeBandNone, IncomeBandVeryLow, IncomeBandLow, IncomeBandMiddle, IncomeBandUpperMiddle, IncomeBandHigh:
+		return band, nil
+	default:
+		return "", errors.New("invalid income band")
+	}
+}
+
+func (b IncomeBand) String() string {
+	return string(b)
+}
@@ -39,6 +39,22 @@ func (m MonthlyIncome) Currency() Currency {
 	return m.currency
 }
 
+// Band retorna el rango de ingreso al que pertenece el monto
+func (m MonthlyIncome) Band() IncomeBand {
+	limits, ok := incomeBandLimits[m.currency]
+	if !ok || m.amount == 0 {
+		return IncomeBandNone
+	}
+
+	bands := [4]IncomeBand{IncomeBandVeryLow, IncomeBandLow, IncomeBandMiddle, IncomeBandUpperMiddle}
+	for i, limit := range limits {
+		if m.amount < limit {
+			return bands[i]
+		}
+	}
+	return IncomeBandHigh
+}
+
 func (m MonthlyIncome) String() string {
 	return fmt.Sprintf("%.2f %s", m.amount, m.currency)
 }
@@ -0,0 +1,38 @@
+package valueobjects
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type ObligationID struct {
+	value uuid.UUID
+}
+
+func NewObligationID(value uuid.UUID) (ObligationID, error) {
+	if value == uuid.Nil {
+		return ObligationID{}, errors.New("obligation ID cannot be nil")
+	}
+	return ObligationID{value: value}, nil
+}
+
+func NewObligationIDFromString(value string) (ObligationID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return ObligationID{}, errors.New("invalid obligation ID format")
+	}
+	return NewObligationID(id)
+}
+
+func (o ObligationID) Value() uuid.UUID {
+	return o.value
+}
+
+func (o ObligationID) String() string {
+	return o.value.String()
+}
+
+func (o ObligationID) IsZero() bool {
+	return o.value == uuid.Nil
+}
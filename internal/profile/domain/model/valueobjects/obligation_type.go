@@ -0,0 +1,43 @@
+package valueobjects
+
+import "errors"
+
+// ObligationType clasifica las obligaciones financieras del titular: deudas con el sistema
+// financiero (cuentan para el ratio de endeudamiento) o gastos fijos del hogar
+type ObligationType string
+
+const (
+	ObligationCreditCard   ObligationType = "TARJETA_CREDITO"
+	ObligationCarLoan      ObligationType = "PRESTAMO_VEHICULAR"
+	ObligationPersonalLoan ObligationType = "PRESTAMO_PERSONAL"
+	ObligationMortgage     ObligationType = "PRESTAMO_HIPOTECARIO"
+	ObligationOtherDebt    ObligationType = "OTRA_DEUDA"
+	ObligationRent         ObligationType = "ALQUILER"
+	ObligationEducation    ObligationType = "EDUCACION"
+	ObligationOtherExpense ObligationType = "OTRO_GASTO"
+)
+
+func NewObligationType(value string) (ObligationType, error) {
+	obligationType := ObligationType(value)
+	switch obligationType {
+	case ObligationCreditCard, ObligationCarLoan, ObligationPersonalLoan, ObligationMortgage, ObligationOtherDebt,
+		ObligationRent, ObligationEducation, ObligationOtherExpense:
+		return obligationType, nil
+	default:
+		return "", errors.New("invalid obligation type")
+	}
+}
+
+// IsDebt indica si la obligación es una deuda (y no un gasto del hogar)
+func (t ObligationType) IsDebt() bool {
+	switch t {
+	case ObligationCreditCard, ObligationCarLoan, ObligationPersonalLoan, ObligationMortgage, ObligationOtherDebt:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t ObligationType) String() string {
+	return string(t)
+}
@@ -0,0 +1,29 @@
+package valueobjects
+
+import "errors"
+
+// Relationship es el vínculo del co-prestatario con el titular del perfil
+type Relationship string
+
+const (
+	RelationshipSpouse     Relationship = "CONYUGE"
+	RelationshipCohabitant Relationship = "CONVIVIENTE"
+	RelationshipParent     Relationship = "PADRE_MADRE"
+	RelationshipChild      Relationship = "HIJO"
+	RelationshipSibling    Relationship = "HERMANO"
+	RelationshipOther      Relationship = "OTRO"
+)
+
+func NewRelationship(value string) (Relationship, error) {
+	relationship := Relationship(value)
+	switch relationship {
+	case RelationshipSpouse, RelationshipCohabitant, RelationshipParent, RelationshipChild, RelationshipSibling, RelationshipOther:
+		return relationship, nil
+	default:
+		return "", errors.New("invalid relationship")
+	}
+}
+
+func (r Relationship) String() string {
+	return string(r)
+}
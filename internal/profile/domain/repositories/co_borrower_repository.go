@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+	"finanzas-backend/internal/profile/domain/model/entities"
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+)
+
+type CoBorrowerRepository interface {
+	Save(ctx context.Context, coBorrower *entities.CoBorrower) error
+	Update(ctx context.Context, coBorrower *entities.CoBorrower) error
+	Delete(ctx context.Context, id valueobjects.CoBorrowerID) error
+	FindByID(ctx context.Context, id valueobjects.CoBorrowerID) (*entities.CoBorrower, error)
+	FindByProfileID(ctx context.Context, profileID valueobjects.ProfileID) ([]*entities.CoBorrower, error)
+	ExistsByProfileIDAndDNI(ctx context.Context, profileID valueobjects.ProfileID, dni string) (bool, error)
+}
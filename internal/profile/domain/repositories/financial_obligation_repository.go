@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+	"finanzas-backend/internal/profile/domain/model/entities"
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+)
+
+type FinancialObligationRepository interface {
+	Save(ctx context.Context, obligation *entities.FinancialObligation) error
+	Update(ctx context.Context, obligation *entities.FinancialObligation) error
+	Delete(ctx context.Context, id valueobjects.ObligationID) error
+	FindByID(ctx context.Context, id valueobjects.ObligationID) (*entities.FinancialObligation, error)
+	FindByProfileID(ctx context.Context, profileID valueobjects.ProfileID) ([]*entities.FinancialObligation, error)
+}
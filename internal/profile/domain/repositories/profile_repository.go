@@ -12,6 +12,8 @@ type ProfileRepository interface {
 	FindByID(ctx context.Context, id valueobjects.ProfileID) (*entities.Profile, error)
 	FindByUserID(ctx context.Context, userID valueobjects.UserID) (*entities.Profile, error)
 	FindByDNI(ctx context.Context, dni string) (*entities.Profile, error)
+	FindByIncomeBand(ctx context.Context, band valueobjects.IncomeBand) ([]*entities.Profile, error)
 	ExistsByUserID(ctx context.Context, userID valueobjects.UserID) (bool, error)
 	ExistsByDNI(ctx context.Context, dni string) (bool, error)
+	DeleteByUserID(ctx context.Context, userID valueobjects.UserID) error
 }
@@ -0,0 +1,13 @@
+package services
+
+import (
+	"context"
+	"finanzas-backend/internal/profile/domain/model/commands"
+	"finanzas-backend/internal/profile/domain/model/entities"
+)
+
+type CoBorrowerCommandService interface {
+	HandleAdd(ctx context.Context, cmd *commands.AddCoBorrowerCommand) (*entities.CoBorrower, error)
+	HandleUpdate(ctx context.Context, cmd *commands.UpdateCoBorrowerCommand) (*entities.CoBorrower, error)
+	HandleRemove(ctx context.Context, cmd *commands.RemoveCoBorrowerCommand) error
+}
@@ -0,0 +1,11 @@
+package services
+
+import (
+	"context"
+	"finanzas-backend/internal/profile/domain/model/entities"
+	"finanzas-backend/internal/profile/domain/model/queries"
+)
+
+type CoBorrowerQueryService interface {
+	HandleFindByUserID(ctx context.Context, query queries.FindCoBorrowersByUserIDQuery) ([]*entities.CoBorrower, error)
+}
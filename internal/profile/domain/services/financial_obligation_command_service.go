@@ -0,0 +1,13 @@
+package services
+
+import (
+	"context"
+	"finanzas-backend/internal/profile/domain/model/commands"
+	"finanzas-backend/internal/profile/domain/model/entities"
+)
+
+type FinancialObligationCommandService interface {
+	HandleAdd(ctx context.Context, cmd *commands.AddObligationCommand) (*entities.FinancialObligation, error)
+	HandleUpdate(ctx context.Context, cmd *commands.UpdateObligationCommand) (*entities.FinancialObligation, error)
+	HandleRemove(ctx context.Context, cmd *commands.RemoveObligationCommand) error
+}
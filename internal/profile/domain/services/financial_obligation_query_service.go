@@ -0,0 +1,12 @@
+package services
+
+import (
+	"context"
+	"finanzas-backend/internal/profile/domain/model/entities"
+	"finanzas-backend/internal/profile/domain/model/queries"
+)
+
+type FinancialObligationQueryService interface {
+	HandleFindByUserID(ctx context.Context, query queries.FindObligationsByUserIDQuery) ([]*entities.FinancialObligation, error)
+	HandleGetFinancialSnapshot(ctx context.Context, query queries.GetFinancialSnapshotQuery) (*entities.FinancialSnapshot, error)
+}
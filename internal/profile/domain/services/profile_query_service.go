@@ -10,4 +10,5 @@ type ProfileQueryService interface {
 	HandleFindByID(ctx context.Context, query queries.FindProfileByIDQuery) (*entities.Profile, error)
 	HandleFindByUserID(ctx context.Context, query queries.FindProfileByUserIDQuery) (*entities.Profile, error)
 	HandleFindByDNI(ctx context.Context, query queries.FindProfileByDNIQuery) (*entities.Profile, error)
+	HandleFindByIncomeBand(ctx context.Context, query queries.FindProfilesByIncomeBandQuery) ([]*entities.Profile, error)
 }
@@ -0,0 +1,69 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"finanzas-backend/internal/profile/infrastructure/persistence/repositories"
+	"finanzas-backend/internal/shared/infrastructure/security"
+
+	"gorm.io/gorm"
+)
+
+// ProfileReencryptionJob migra periódicamente los datos cifrados de los perfiles y co-prestatarios a la llave vigente
+// y a los campos cifrados configurados, de modo que tras una rotación las llaves anteriores puedan retirarse
+type ProfileReencryptionJob struct {
+	db                *gorm.DB
+	encryptionService *security.EncryptionService
+	encryptedFields   []string
+	interval          time.Duration
+}
+
+func NewProfileReencryptionJob(
+	db *gorm.DB,
+	encryptionService *security.EncryptionService,
+	encryptedFields []string,
+	interval time.Duration,
+) *ProfileReencryptionJob {
+	return &ProfileReencryptionJob{
+		db:                db,
+		encryptionService: encryptionService,
+		encryptedFields:   encryptedFields,
+		interval:          interval,
+	}
+}
+
+// Start ejecuta la re-encriptación al iniciar y luego cada intervalo, hasta que ctx se cancele
+func (j *ProfileReencryptionJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.run(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *ProfileReencryptionJob) run(ctx context.Context) {
+	updated, err := repositories.ReencryptProfiles(ctx, j.db, j.encryptionService, j.encryptedFields)
+	if err != nil {
+		log.Printf("Profile re-encryption failed: %v", err)
+	}
+	if updated > 0 {
+		log.Printf("Profile re-encryption: %d profile(s) updated to key %s", updated, j.encryptionService.CurrentKeyID())
+	}
+
+	updated, err = repositories.ReencryptCoBorrowers(ctx, j.db, j.encryptionService)
+	if err != nil {
+		log.Printf("Co-borrower re-encryption failed: %v", err)
+	}
+	if updated > 0 {
+		log.Printf("Co-borrower re-encryption: %d co-borrower(s) updated to key %s", updated, j.encryptionService.CurrentKeyID())
+	}
+}
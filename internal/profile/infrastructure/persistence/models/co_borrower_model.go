@@ -0,0 +1,94 @@
+package models
+
+import (
+	"finanzas-backend/internal/profile/domain/model/entities"
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CoBorrowerModel guarda DNI, nombres e ingreso del co-prestatario siempre cifrados
+// (el repositorio cifra y descifra); el DNI se busca por su blind index
+type CoBorrowerModel struct {
+	ID                     uuid.UUID    `gorm:"type:uuid;primaryKey;column:id"`
+	ProfileID              uuid.UUID    `gorm:"type:uuid;not null;index;uniqueIndex:idx_co_borrowers_profile_dni;column:profile_id"`
+	Profile                ProfileModel `gorm:"foreignKey:ProfileID;constraint:OnDelete:CASCADE"`
+	DNIEncrypted           string       `gorm:"type:varchar(255);not null;column:dni_encrypted"`
+	DNIHash                string       `gorm:"type:varchar(64);not null;uniqueIndex:idx_co_borrowers_profile_dni;column:dni_hash"`
+	FirstName              string       `gorm:"type:text;not null;column:first_name"`
+	FirstLastName          string       `gorm:"type:text;not null;column:first_last_name"`
+	SecondLastName         string       `gorm:"type:text;not null;column:second_last_name"`
+	MonthlyIncomeEncrypted string       `gorm:"type:text;not null;column:monthly_income_encrypted"`
+	Currency               string       `gorm:"type:varchar(3);not null;column:currency"`
+	Relationship           string       `gorm:"type:varchar(20);not null;column:relationship"`
+	CreatedAt              time.Time    `gorm:"autoCreateTime;column:created_at"`
+	UpdatedAt              time.Time    `gorm:"autoUpdateTime;column:updated_at"`
+}
+
+func (CoBorrowerModel) TableName() string {
+	return "co_borrowers"
+}
+
+// ToEntity espera el modelo ya descifrado por el repositorio
+func (m *CoBorrowerModel) ToEntity() (*entities.CoBorrower, error) {
+	id, err := valueobjects.NewCoBorrowerID(m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	profileID, err := valueobjects.NewProfileID(m.ProfileID)
+	if err != nil {
+		return nil, err
+	}
+
+	dni, err := valueobjects.NewDNI(m.DNIEncrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := strconv.ParseFloat(m.MonthlyIncomeEncrypted, 64)
+	if err != nil {
+		return nil, err
+	}
+	monthlyIncome, err := valueobjects.NewMonthlyIncome(amount, valueobjects.Currency(m.Currency))
+	if err != nil {
+		return nil, err
+	}
+
+	relationship, err := valueobjects.NewRelationship(m.Relationship)
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.ReconstructCoBorrower(
+		id,
+		profileID,
+		dni,
+		m.FirstName,
+		m.FirstLastName,
+		m.SecondLastName,
+		monthlyIncome,
+		relationship,
+		m.CreatedAt,
+		m.UpdatedAt,
+	), nil
+}
+
+// CoBorrowerFromEntity arma el modelo en claro; el repositorio cifra la PII antes de guardarlo
+func CoBorrowerFromEntity(coBorrower *entities.CoBorrower) *CoBorrowerModel {
+	return &CoBorrowerModel{
+		ID:                     coBorrower.ID().Value(),
+		ProfileID:              coBorrower.ProfileID().Value(),
+		DNIEncrypted:           coBorrower.DNI().Value(),
+		FirstName:              coBorrower.FirstName(),
+		FirstLastName:          coBorrower.FirstLastName(),
+		SecondLastName:         coBorrower.SecondLastName(),
+		MonthlyIncomeEncrypted: strconv.FormatFloat(coBorrower.MonthlyIncome().Amount(), 'f', 2, 64),
+		Currency:               string(coBorrower.MonthlyIncome().Currency()),
+		Relationship:           coBorrower.Relationship().String(),
+		CreatedAt:              coBorrower.CreatedAt(),
+		UpdatedAt:              coBorrower.UpdatedAt(),
+	}
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"finanzas-backend/internal/profile/domain/model/entities"
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type FinancialObligationModel struct {
+	ID                 uuid.UUID    `gorm:"type:uuid;primaryKey;column:id"`
+	ProfileID          uuid.UUID    `gorm:"type:uuid;not null;index;column:profile_id"`
+	Profile            ProfileModel `gorm:"foreignKey:ProfileID;constraint:OnDelete:CASCADE"`
+	Type               string       `gorm:"type:varchar(30);not null;column:type"`
+	Creditor           string       `gorm:"type:varchar(100);not null;default:'';column:creditor"`
+	MonthlyPayment     float64      `gorm:"type:decimal(12,2);not null;column:monthly_payment"`
+	Currency           string       `gorm:"type:varchar(3);not null;column:currency"`
+	OutstandingBalance float64      `gorm:"type:decimal(14,2);not null;default:0;column:outstanding_balance"`
+	CreatedAt          time.Time    `gorm:"autoCreateTime;column:created_at"`
+	UpdatedAt          time.Time    `gorm:"autoUpdateTime;column:updated_at"`
+}
+
+func (FinancialObligationModel) TableName() string {
+	return "financial_obligations"
+}
+
+func (m *FinancialObligationModel) ToEntity() (*entities.FinancialObligation, error) {
+	id, err := valueobjects.NewObligationID(m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	profileID, err := valueobjects.NewProfileID(m.ProfileID)
+	if err != nil {
+		return nil, err
+	}
+
+	obligationType, err := valueobjects.NewObligationType(m.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.ReconstructFinancialObligation(
+		id,
+		profileID,
+		obligationType,
+		m.Creditor,
+		m.MonthlyPayment,
+		valueobjects.Currency(m.Currency),
+		m.OutstandingBalance,
+		m.CreatedAt,
+		m.UpdatedAt,
+	), nil
+}
+
+func FinancialObligationFromEntity(obligation *entities.FinancialObligation) *FinancialObligationModel {
+	return &FinancialObligationModel{
+		ID:                 obligation.ID().Value(),
+		ProfileID:          obligation.ProfileID().Value(),
+		Type:               obligation.Type().String(),
+		Creditor:           obligation.Creditor(),
+		MonthlyPayment:     obligation.MonthlyPayment(),
+		Currency:           string(obligation.Currency()),
+		OutstandingBalance: obligation.OutstandingBalance(),
+		CreatedAt:          obligation.CreatedAt(),
+		UpdatedAt:          obligation.UpdatedAt(),
+	}
+}
@@ -8,21 +8,27 @@ import (
 	"github.com/google/uuid"
 )
 
+// ProfileModel guarda el DNI siempre cifrado; nombres, teléfono e ingreso se cifran
+// según PROFILE_ENCRYPTED_FIELDS (el repositorio cifra y descifra de forma transparente)
 type ProfileModel struct {
-	ID             uuid.UUID `gorm:"type:uuid;primaryKey;column:id"`
-	UserID         uuid.UUID `gorm:"type:uuid;not null;uniqueIndex;column:user_id"`
-	DNIEncrypted   string    `gorm:"type:varchar(255);not null;uniqueIndex;column:dni_encrypted"`
-	FirstName      string    `gorm:"type:varchar(100);not null;column:first_name"`
-	FirstLastName  string    `gorm:"type:varchar(100);not null;column:first_last_name"`
-	SecondLastName string    `gorm:"type:varchar(100);not null;column:second_last_name"`
-	PhoneNumber    string    `gorm:"type:varchar(9);not null;column:phone_number"`
-	MonthlyIncome  float64   `gorm:"type:decimal(12,2);not null;column:monthly_income"`
-	Currency       string    `gorm:"type:varchar(3);not null;column:currency"`
-	MaritalStatus  string    `gorm:"type:varchar(20);not null;column:marital_status"`
-	IsFirstHome    bool      `gorm:"not null;column:is_first_home"`
-	HasOwnLand     bool      `gorm:"not null;column:has_own_land"`
-	CreatedAt      time.Time `gorm:"autoCreateTime;column:created_at"`
-	UpdatedAt      time.Time `gorm:"autoUpdateTime;column:updated_at"`
+	ID                     uuid.UUID `gorm:"type:uuid;primaryKey;column:id"`
+	UserID                 uuid.UUID `gorm:"type:uuid;not null;uniqueIndex;column:user_id"`
+	DNIEncrypted           string    `gorm:"type:varchar(255);not null;column:dni_encrypted"`
+	DNIHash                *string   `gorm:"type:varchar(64);uniqueIndex;column:dni_hash"` // Blind index (HMAC) para búsqueda y unicidad
+	FirstName              string    `gorm:"type:text;not null;column:first_name"`
+	FirstLastName          string    `gorm:"type:text;not null;column:first_last_name"`
+	SecondLastName         string    `gorm:"type:text;not null;column:second_last_name"`
+	PhoneNumber            string    `gorm:"type:text;not null;column:phone_number"`
+	MonthlyIncome          float64   `gorm:"type:decimal(12,2);not null;column:monthly_income"` // 0 cuando el ingreso se guarda cifrado
+	MonthlyIncomeEncrypted string    `gorm:"type:text;not null;default:'';column:monthly_income_encrypted"`
+	IncomeBand             string    `gorm:"type:varchar(20);not null;default:'';index;column:income_band"` // Rango grueso, sin cifrar, para consultas
+	Currency               string    `gorm:"type:varchar(3);not null;column:currency"`
+	MaritalStatus          string    `gorm:"type:varchar(20);not null;column:marital_status"`
+	IsFirstHome            bool      `gorm:"not null;column:is_first_home"`
+	HasOwnLand             bool      `gorm:"not null;column:has_own_land"`
+	Dependents             int       `gorm:"not null;default:0;column:dependents"`
+	CreatedAt              time.Time `gorm:"autoCreateTime;column:created_at"`
+	UpdatedAt              time.Time `gorm:"autoUpdateTime;column:updated_at"`
 }
 
 func (ProfileModel) TableName() string {
@@ -88,6 +94,7 @@ func (m *ProfileModel) ToEntity() (*entities.Profile, error) {
 		maritalStatus,
 		m.IsFirstHome,
 		m.HasOwnLand,
+		m.Dependents,
 		m.CreatedAt,
 		m.UpdatedAt,
 	), nil
@@ -103,10 +110,12 @@ func FromEntity(profile *entities.Profile) *ProfileModel {
 		SecondLastName: profile.SecondLastName(),
 		PhoneNumber:    profile.PhoneNumber().Value(),
 		MonthlyIncome:  profile.MonthlyIncome().Amount(),
+		IncomeBand:     profile.MonthlyIncome().Band().String(),
 		Currency:       string(profile.MonthlyIncome().Currency()),
 		MaritalStatus:  profile.MaritalStatus().String(),
 		IsFirstHome:    profile.IsFirstHome(),
 		HasOwnLand:     profile.HasOwnLand(),
+		Dependents:     profile.Dependents(),
 		CreatedAt:      profile.CreatedAt(),
 		UpdatedAt:      profile.UpdatedAt(),
 	}
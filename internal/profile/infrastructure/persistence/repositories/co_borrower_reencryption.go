@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"finanzas-backend/internal/profile/infrastructure/persistence/models"
+	"finanzas-backend/internal/shared/infrastructure/security"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ReencryptCoBorrowers vuelve a cifrar con la llave vigente la PII de los co-prestatarios cifrada con
+// llaves anteriores. Igual que ReencryptProfiles, recorre por id y actualiza condicionado a updated_at.
+func ReencryptCoBorrowers(ctx context.Context, db *gorm.DB, encryptionService *security.EncryptionService) (int, error) {
+	current := sql.Named("current", escapeLike("ev1:"+encryptionService.CurrentKeyID()+":")+"%")
+	pending := "dni_encrypted NOT LIKE @current OR first_name NOT LIKE @current OR " +
+		"first_last_name NOT LIKE @current OR second_last_name NOT LIKE @current OR " +
+		"monthly_income_encrypted NOT LIKE @current"
+
+	updated := 0
+	lastID := uuid.Nil
+	for {
+		if err := ctx.Err(); err != nil {
+			return updated, err
+		}
+
+		var rows []models.CoBorrowerModel
+		if err := db.WithContext(ctx).
+			Where("id > @last AND ("+pending+")", sql.Named("last", lastID), current).
+			Order("id").
+			Limit(reencryptionBatchSize).
+			Find(&rows).Error; err != nil {
+			return updated, err
+		}
+		if len(rows) == 0 {
+			return updated, nil
+		}
+
+		for i := range rows {
+			row := &rows[i]
+			lastID = row.ID
+
+			if err := reencryptFields(encryptionService,
+				&row.DNIEncrypted, &row.FirstName, &row.FirstLastName, &row.SecondLastName, &row.MonthlyIncomeEncrypted,
+			); err != nil {
+				log.Printf("Co-borrower re-encryption: cannot re-encrypt co-borrower %s: %v", row.ID, err)
+				continue
+			}
+
+			result := db.WithContext(ctx).Model(&models.CoBorrowerModel{}).
+				Where("id = ? AND updated_at = ?", row.ID, row.UpdatedAt).
+				UpdateColumns(map[string]interface{}{
+					"dni_encrypted":            row.DNIEncrypted,
+					"first_name":               row.FirstName,
+					"first_last_name":          row.FirstLastName,
+					"second_last_name":         row.SecondLastName,
+					"monthly_income_encrypted": row.MonthlyIncomeEncrypted,
+				})
+			if result.Error != nil {
+				return updated, result.Error
+			}
+			updated += int(result.RowsAffected)
+		}
+	}
+}
+
+func reencryptFields(encryptionService *security.EncryptionService, fields ...*string) error {
+	for _, field := range fields {
+		if !encryptionService.NeedsReencryption(*field) {
+			continue
+		}
+		reencrypted, err := encryptionService.Reencrypt(*field)
+		if err != nil {
+			return err
+		}
+		*field = reencrypted
+	}
+	return nil
+}
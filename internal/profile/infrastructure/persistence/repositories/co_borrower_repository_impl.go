@@ -0,0 +1,145 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"finanzas-backend/internal/profile/domain/model/entities"
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+	domain_repos "finanzas-backend/internal/profile/domain/repositories"
+	"finanzas-backend/internal/profile/infrastructure/persistence/models"
+	"finanzas-backend/internal/shared/infrastructure/persistence"
+	"finanzas-backend/internal/shared/infrastructure/security"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// coBorrowerDNIBlindIndexPurpose separa el blind index del DNI de co-prestatarios del de titulares
+const coBorrowerDNIBlindIndexPurpose = "profile.co_borrower.dni"
+
+type coBorrowerRepositoryImpl struct {
+	db                *gorm.DB
+	encryptionService *security.EncryptionService
+	blindIndexService *security.BlindIndexService
+}
+
+// NewCoBorrowerRepository crea el repositorio de co-prestatarios; toda su PII se guarda cifrada
+func NewCoBorrowerRepository(
+	db *gorm.DB,
+	encryptionService *security.EncryptionService,
+	blindIndexService *security.BlindIndexService,
+) domain_repos.CoBorrowerRepository {
+	return &coBorrowerRepositoryImpl{
+		db:                db,
+		encryptionService: encryptionService,
+		blindIndexService: blindIndexService,
+	}
+}
+
+func (r *coBorrowerRepositoryImpl) Save(ctx context.Context, coBorrower *entities.CoBorrower) error {
+	id, err := valueobjects.NewCoBorrowerID(uuid.New())
+	if err != nil {
+		return err
+	}
+	coBorrower.SetID(id)
+
+	model, err := r.toModel(coBorrower)
+	if err != nil {
+		return err
+	}
+	return persistence.Conn(ctx, r.db).Omit("Profile").Create(model).Error
+}
+
+func (r *coBorrowerRepositoryImpl) Update(ctx context.Context, coBorrower *entities.CoBorrower) error {
+	model, err := r.toModel(coBorrower)
+	if err != nil {
+		return err
+	}
+	return persistence.Conn(ctx, r.db).Omit("Profile").Save(model).Error
+}
+
+func (r *coBorrowerRepositoryImpl) Delete(ctx context.Context, id valueobjects.CoBorrowerID) error {
+	return persistence.Conn(ctx, r.db).Where("id = ?", id.Value()).Delete(&models.CoBorrowerModel{}).Error
+}
+
+func (r *coBorrowerRepositoryImpl) FindByID(ctx context.Context, id valueobjects.CoBorrowerID) (*entities.CoBorrower, error) {
+	var model models.CoBorrowerModel
+	err := persistence.Conn(ctx, r.db).Where("id = ?", id.Value()).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return r.toEntity(&model)
+}
+
+func (r *coBorrowerRepositoryImpl) FindByProfileID(ctx context.Context, profileID valueobjects.ProfileID) ([]*entities.CoBorrower, error) {
+	var rows []models.CoBorrowerModel
+	if err := persistence.Conn(ctx, r.db).Where("profile_id = ?", profileID.Value()).Order("created_at").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	coBorrowers := make([]*entities.CoBorrower, 0, len(rows))
+	for i := range rows {
+		coBorrower, err := r.toEntity(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		coBorrowers = append(coBorrowers, coBorrower)
+	}
+	return coBorrowers, nil
+}
+
+func (r *coBorrowerRepositoryImpl) ExistsByProfileIDAndDNI(ctx context.Context, profileID valueobjects.ProfileID, dni string) (bool, error) {
+	var count int64
+	err := persistence.Conn(ctx, r.db).Model(&models.CoBorrowerModel{}).
+		Where("profile_id = ? AND dni_hash = ?", profileID.Value(), r.dniHash(dni)).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *coBorrowerRepositoryImpl) dniHash(dni string) string {
+	return r.blindIndexService.Compute(coBorrowerDNIBlindIndexPurpose, dni)
+}
+
+// toModel mapea la entidad y cifra su PII
+func (r *coBorrowerRepositoryImpl) toModel(coBorrower *entities.CoBorrower) (*models.CoBorrowerModel, error) {
+	model := models.CoBorrowerFromEntity(coBorrower)
+	model.DNIHash = r.dniHash(coBorrower.DNI().Value())
+
+	for _, field := range []*string{
+		&model.DNIEncrypted,
+		&model.FirstName,
+		&model.FirstLastName,
+		&model.SecondLastName,
+		&model.MonthlyIncomeEncrypted,
+	} {
+		encrypted, err := r.encryptionService.Encrypt(*field)
+		if err != nil {
+			return nil, err
+		}
+		*field = encrypted
+	}
+	return model, nil
+}
+
+// toEntity descifra la PII del modelo y lo mapea a la entidad
+func (r *coBorrowerRepositoryImpl) toEntity(model *models.CoBorrowerModel) (*entities.CoBorrower, error) {
+	for _, field := range []*string{
+		&model.DNIEncrypted,
+		&model.FirstName,
+		&model.FirstLastName,
+		&model.SecondLastName,
+		&model.MonthlyIncomeEncrypted,
+	} {
+		decrypted, err := r.encryptionService.Decrypt(*field)
+		if err != nil {
+			return nil, err
+		}
+		*field = decrypted
+	}
+	return model.ToEntity()
+}
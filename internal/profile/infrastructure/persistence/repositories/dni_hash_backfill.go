@@ -0,0 +1,71 @@
+package repositories
+
+import (
+	"context"
+	"log"
+
+	"finanzas-backend/internal/profile/infrastructure/persistence/models"
+	"finanzas-backend/internal/shared/infrastructure/security"
+
+	"gorm.io/gorm"
+)
+
+const dniBackfillBatchSize = 200
+
+// legacyDNIEncryptedIndex es el índice único sobre el DNI cifrado, inútil con nonce aleatorio
+const legacyDNIEncryptedIndex = "idx_profiles_dni_encrypted"
+
+// BackfillDNIHashes calcula el blind index de los perfiles creados antes de que existiera dni_hash.
+// Es idempotente: solo procesa filas con dni_hash nulo. Si dos perfiles tienen el mismo DNI
+// (posible antes del blind index) el segundo queda sin hash y se reporta para revisión manual.
+func BackfillDNIHashes(
+	ctx context.Context,
+	db *gorm.DB,
+	encryptionService *security.EncryptionService,
+	blindIndexService *security.BlindIndexService,
+) (int, error) {
+	if db.Migrator().HasIndex(&models.ProfileModel{}, legacyDNIEncryptedIndex) {
+		if err := db.Migrator().DropIndex(&models.ProfileModel{}, legacyDNIEncryptedIndex); err != nil {
+			return 0, err
+		}
+	}
+
+	updated := 0
+	skipped := map[string]bool{}
+	for {
+		var rows []models.ProfileModel
+		query := db.WithContext(ctx).Where("dni_hash IS NULL").Order("created_at").Limit(dniBackfillBatchSize)
+		if len(skipped) > 0 {
+			ids := make([]string, 0, len(skipped))
+			for id := range skipped {
+				ids = append(ids, id)
+			}
+			query = query.Where("id NOT IN ?", ids)
+		}
+		if err := query.Find(&rows).Error; err != nil {
+			return updated, err
+		}
+		if len(rows) == 0 {
+			return updated, nil
+		}
+
+		for _, row := range rows {
+			dni, err := encryptionService.Decrypt(row.DNIEncrypted)
+			if err != nil {
+				log.Printf("DNI backfill: cannot decrypt profile %s: %v", row.ID, err)
+				skipped[row.ID.String()] = true
+				continue
+			}
+
+			hash := blindIndexService.Compute(dniBlindIndexPurpose, dni)
+			if err := db.WithContext(ctx).Model(&models.ProfileModel{}).
+				Where("id = ?", row.ID).
+				Update("dni_hash", hash).Error; err != nil {
+				log.Printf("DNI backfill: profile %s not indexed (duplicate DNI?): %v", row.ID, err)
+				skipped[row.ID.String()] = true
+				continue
+			}
+			updated++
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"finanzas-backend/internal/profile/domain/model/entities"
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+	domain_repos "finanzas-backend/internal/profile/domain/repositories"
+	"finanzas-backend/internal/profile/infrastructure/persistence/models"
+	"finanzas-backend/internal/shared/infrastructure/persistence"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type financialObligationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewFinancialObligationRepository(db *gorm.DB) domain_repos.FinancialObligationRepository {
+	return &financialObligationRepositoryImpl{db: db}
+}
+
+func (r *financialObligationRepositoryImpl) Save(ctx context.Context, obligation *entities.FinancialObligation) error {
+	id, err := valueobjects.NewObligationID(uuid.New())
+	if err != nil {
+		return err
+	}
+	obligation.SetID(id)
+
+	return persistence.Conn(ctx, r.db).Omit("Profile").Create(models.FinancialObligationFromEntity(obligation)).Error
+}
+
+func (r *financialObligationRepositoryImpl) Update(ctx context.Context, obligation *entities.FinancialObligation) error {
+	return persistence.Conn(ctx, r.db).Omit("Profile").Save(models.FinancialObligationFromEntity(obligation)).Error
+}
+
+func (r *financialObligationRepositoryImpl) Delete(ctx context.Context, id valueobjects.ObligationID) error {
+	return persistence.Conn(ctx, r.db).Where("id = ?", id.Value()).Delete(&models.FinancialObligationModel{}).Error
+}
+
+func (r *financialObligationRepositoryImpl) FindByID(ctx context.Context, id valueobjects.ObligationID) (*entities.FinancialObligation, error) {
+	var model models.FinancialObligationModel
+	err := persistence.Conn(ctx, r.db).Where("id = ?", id.Value()).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return model.ToEntity()
+}
+
+func (r *financialObligationRepositoryImpl) FindByProfileID(ctx context.Context, profileID valueobjects.ProfileID) ([]*entities.FinancialObligation, error) {
+	var rows []models.FinancialObligationModel
+	if err := persistence.Conn(ctx, r.db).Where("profile_id = ?", profileID.Value()).Order("created_at").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	obligations := make([]*entities.FinancialObligation, 0, len(rows))
+	for i := range rows {
+		obligation, err := rows[i].ToEntity()
+		if err != nil {
+			return nil, err
+		}
+		obligations = append(obligations, obligation)
+	}
+	return obligations, nil
+}
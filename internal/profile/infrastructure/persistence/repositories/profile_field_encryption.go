@@ -0,0 +1,147 @@
+package repositories
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"finanzas-backend/internal/profile/infrastructure/persistence/models"
+	"finanzas-backend/internal/shared/infrastructure/security"
+)
+
+// Campos de PII del perfil que pueden cifrarse en reposo (el DNI se cifra siempre)
+const (
+	FieldFirstName      = "first_name"
+	FieldFirstLastName  = "first_last_name"
+	FieldSecondLastName = "second_last_name"
+	FieldPhoneNumber    = "phone_number"
+	FieldMonthlyIncome  = "monthly_income"
+)
+
+// DefaultEncryptedFields cifra toda la PII del perfil
+var DefaultEncryptedFields = []string{
+	FieldFirstName,
+	FieldFirstLastName,
+	FieldSecondLastName,
+	FieldPhoneNumber,
+	FieldMonthlyIncome,
+}
+
+// textFields expone las columnas de texto cifrables; el nombre del campo es también la columna
+var textFields = map[string]func(model *models.ProfileModel) *string{
+	FieldFirstName:      func(model *models.ProfileModel) *string { return &model.FirstName },
+	FieldFirstLastName:  func(model *models.ProfileModel) *string { return &model.FirstLastName },
+	FieldSecondLastName: func(model *models.ProfileModel) *string { return &model.SecondLastName },
+	FieldPhoneNumber:    func(model *models.ProfileModel) *string { return &model.PhoneNumber },
+}
+
+// ParseEncryptedFields valida una lista separada por comas de campos a cifrar
+func ParseEncryptedFields(value string) ([]string, error) {
+	fields := []string{}
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if _, ok := textFields[field]; !ok && field != FieldMonthlyIncome {
+			return nil, fmt.Errorf("unknown encryptable profile field %q", field)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// fieldEncryption cifra los campos configurados al escribir y descifra cualquier campo cifrado al leer,
+// de modo que cambiar la configuración no impide leer filas escritas con la anterior
+type fieldEncryption struct {
+	encryptionService *security.EncryptionService
+	fields            map[string]bool
+}
+
+func newFieldEncryption(encryptionService *security.EncryptionService, fields []string) fieldEncryption {
+	set := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		set[field] = true
+	}
+	return fieldEncryption{encryptionService: encryptionService, fields: set}
+}
+
+// encrypt cifra en el modelo los campos configurados que están en texto plano
+func (f fieldEncryption) encrypt(model *models.ProfileModel) error {
+	for field, value := range textFields {
+		target := value(model)
+		if !f.fields[field] || *target == "" || security.IsEncrypted(*target) {
+			continue
+		}
+		encrypted, err := f.encryptionService.Encrypt(*target)
+		if err != nil {
+			return err
+		}
+		*target = encrypted
+	}
+
+	if f.fields[FieldMonthlyIncome] && model.MonthlyIncome != 0 {
+		encrypted, err := f.encryptionService.Encrypt(strconv.FormatFloat(model.MonthlyIncome, 'f', 2, 64))
+		if err != nil {
+			return err
+		}
+		model.MonthlyIncomeEncrypted = encrypted
+		model.MonthlyIncome = 0
+	}
+	return nil
+}
+
+// decrypt deja en texto plano todos los campos cifrados del modelo
+func (f fieldEncryption) decrypt(model *models.ProfileModel) error {
+	for _, value := range textFields {
+		target := value(model)
+		if !security.IsEncrypted(*target) {
+			continue
+		}
+		decrypted, err := f.encryptionService.Decrypt(*target)
+		if err != nil {
+			return err
+		}
+		*target = decrypted
+	}
+
+	if model.MonthlyIncomeEncrypted != "" {
+		decrypted, err := f.encryptionService.Decrypt(model.MonthlyIncomeEncrypted)
+		if err != nil {
+			return err
+		}
+		amount, err := strconv.ParseFloat(decrypted, 64)
+		if err != nil {
+			return fmt.Errorf("invalid encrypted monthly income: %w", err)
+		}
+		model.MonthlyIncome = amount
+		model.MonthlyIncomeEncrypted = ""
+	}
+	return nil
+}
+
+// pendingCondition retorna la condición SQL de las filas cuyos campos no están como indica la
+// configuración: texto plano que debe cifrarse, cifrado que ya no debe estarlo o llave anterior.
+// Usa los parámetros nombrados @current (prefijo de la llave vigente) y @encrypted (cualquier cifrado).
+func (f fieldEncryption) pendingCondition() string {
+	conditions := []string{}
+	for _, field := range DefaultEncryptedFields {
+		column := field
+		if field == FieldMonthlyIncome {
+			if f.fields[field] {
+				conditions = append(conditions,
+					"monthly_income <> 0",
+					"(monthly_income_encrypted <> '' AND monthly_income_encrypted NOT LIKE @current)")
+			} else {
+				conditions = append(conditions, "monthly_income_encrypted <> ''")
+			}
+			continue
+		}
+		if f.fields[field] {
+			conditions = append(conditions, fmt.Sprintf("(%s <> '' AND %s NOT LIKE @current)", column, column))
+		} else {
+			conditions = append(conditions, fmt.Sprintf("%s LIKE @encrypted", column))
+		}
+	}
+	return strings.Join(conditions, " OR ")
+}
@@ -0,0 +1,108 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"strings"
+
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+	"finanzas-backend/internal/profile/infrastructure/persistence/models"
+	"finanzas-backend/internal/shared/infrastructure/security"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const reencryptionBatchSize = 200
+
+// ReencryptProfiles deja los perfiles como indica la configuración vigente: vuelve a cifrar con la
+// llave actual lo cifrado con llaves anteriores (o con el formato sin versión), cifra los campos de
+// PII configurados que estén en texto plano, descifra los que ya no deben cifrarse y completa
+// income_band. Recorre la tabla por id; la actualización es condicional a updated_at, así que no pisa
+// un perfil modificado mientras tanto. Retorna cuántos perfiles actualizó.
+func ReencryptProfiles(
+	ctx context.Context,
+	db *gorm.DB,
+	encryptionService *security.EncryptionService,
+	encryptedFields []string,
+) (int, error) {
+	fields := newFieldEncryption(encryptionService, encryptedFields)
+	pending := "dni_encrypted NOT LIKE @current OR income_band = '' OR " + fields.pendingCondition()
+	current := sql.Named("current", escapeLike("ev1:"+encryptionService.CurrentKeyID()+":")+"%")
+	encrypted := sql.Named("encrypted", "ev1:%")
+
+	updated := 0
+	lastID := uuid.Nil
+	for {
+		if err := ctx.Err(); err != nil {
+			return updated, err
+		}
+
+		var rows []models.ProfileModel
+		if err := db.WithContext(ctx).
+			Where("id > @last AND ("+pending+")", sql.Named("last", lastID), current, encrypted).
+			Order("id").
+			Limit(reencryptionBatchSize).
+			Find(&rows).Error; err != nil {
+			return updated, err
+		}
+		if len(rows) == 0 {
+			return updated, nil
+		}
+
+		for i := range rows {
+			row := &rows[i]
+			lastID = row.ID
+			updatedAt := row.UpdatedAt
+
+			if err := migrateProfileRow(row, encryptionService, fields); err != nil {
+				log.Printf("Profile re-encryption: cannot re-encrypt profile %s: %v", row.ID, err)
+				continue
+			}
+
+			result := db.WithContext(ctx).Model(&models.ProfileModel{}).
+				Where("id = ? AND updated_at = ?", row.ID, updatedAt).
+				UpdateColumns(map[string]interface{}{
+					"dni_encrypted":            row.DNIEncrypted,
+					"first_name":               row.FirstName,
+					"first_last_name":          row.FirstLastName,
+					"second_last_name":         row.SecondLastName,
+					"phone_number":             row.PhoneNumber,
+					"monthly_income":           row.MonthlyIncome,
+					"monthly_income_encrypted": row.MonthlyIncomeEncrypted,
+					"income_band":              row.IncomeBand,
+				})
+			if result.Error != nil {
+				return updated, result.Error
+			}
+			updated += int(result.RowsAffected)
+		}
+	}
+}
+
+// migrateProfileRow reescribe en memoria los campos cifrados de la fila según la configuración vigente
+func migrateProfileRow(row *models.ProfileModel, encryptionService *security.EncryptionService, fields fieldEncryption) error {
+	if encryptionService.NeedsReencryption(row.DNIEncrypted) {
+		reencrypted, err := encryptionService.Reencrypt(row.DNIEncrypted)
+		if err != nil {
+			return err
+		}
+		row.DNIEncrypted = reencrypted
+	}
+
+	if err := fields.decrypt(row); err != nil {
+		return err
+	}
+
+	row.IncomeBand = valueobjects.IncomeBandNone.String()
+	if income, err := valueobjects.NewMonthlyIncome(row.MonthlyIncome, valueobjects.Currency(row.Currency)); err == nil {
+		row.IncomeBand = income.Band().String()
+	}
+
+	return fields.encrypt(row)
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
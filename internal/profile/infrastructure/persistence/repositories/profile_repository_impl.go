@@ -8,21 +8,36 @@ import (
 	"finanzas-backend/internal/profile/domain/model/valueobjects"
 	domain_repos "finanzas-backend/internal/profile/domain/repositories"
 	"finanzas-backend/internal/profile/infrastructure/persistence/models"
+	"finanzas-backend/internal/shared/infrastructure/persistence"
 	"finanzas-backend/internal/shared/infrastructure/security"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
+// dniBlindIndexPurpose separa el blind index del DNI de otros campos indexados
+const dniBlindIndexPurpose = "profile.dni"
+
 type profileRepositoryImpl struct {
 	db                *gorm.DB
 	encryptionService *security.EncryptionService
+	blindIndexService *security.BlindIndexService
+	fieldEncryption   fieldEncryption
 }
 
-func NewProfileRepository(db *gorm.DB, encryptionService *security.EncryptionService) domain_repos.ProfileRepository {
+// NewProfileRepository crea el repositorio de perfiles. encryptedFields indica qué campos de PII,
+// además del DNI, se guardan cifrados (ver ParseEncryptedFields).
+func NewProfileRepository(
+	db *gorm.DB,
+	encryptionService *security.EncryptionService,
+	blindIndexService *security.BlindIndexService,
+	encryptedFields []string,
+) domain_repos.ProfileRepository {
 	return &profileRepositoryImpl{
 		db:                db,
 		encryptionService: encryptionService,
+		blindIndexService: blindIndexService,
+		fieldEncryption:   newFieldEncryption(encryptionService, encryptedFields),
 	}
 }
 
@@ -43,8 +58,14 @@ func (r *profileRepositoryImpl) Save(ctx context.Context, profile *entities.Prof
 		return err
 	}
 	model.DNIEncrypted = encryptedDNI
+	model.DNIHash = r.dniHash(profile.DNI().Value())
+
+	// Encrypt the configured PII fields
+	if err := r.fieldEncryption.encrypt(model); err != nil {
+		return err
+	}
 
-	return r.db.WithContext(ctx).Create(model).Error
+	return persistence.Conn(ctx, r.db).Create(model).Error
 }
 
 func (r *profileRepositoryImpl) Update(ctx context.Context, profile *entities.Profile) error {
@@ -57,13 +78,19 @@ func (r *profileRepositoryImpl) Update(ctx context.Context, profile *entities.Pr
 		return err
 	}
 	model.DNIEncrypted = encryptedDNI
+	model.DNIHash = r.dniHash(profile.DNI().Value())
 
-	return r.db.WithContext(ctx).Save(model).Error
+	// Encrypt the configured PII fields
+	if err := r.fieldEncryption.encrypt(model); err != nil {
+		return err
+	}
+
+	return persistence.Conn(ctx, r.db).Save(model).Error
 }
 
 func (r *profileRepositoryImpl) FindByID(ctx context.Context, id valueobjects.ProfileID) (*entities.Profile, error) {
 	var model models.ProfileModel
-	err := r.db.WithContext(ctx).Where("id = ?", id.Value()).First(&model).Error
+	err := persistence.Conn(ctx, r.db).Where("id = ?", id.Value()).First(&model).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -71,22 +98,12 @@ func (r *profileRepositoryImpl) FindByID(ctx context.Context, id valueobjects.Pr
 		return nil, err
 	}
 
-	profile, err := model.ToEntity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Decrypt DNI
-	if err := r.decryptProfileDNI(profile); err != nil {
-		return nil, err
-	}
-
-	return profile, nil
+	return r.toEntity(&model)
 }
 
 func (r *profileRepositoryImpl) FindByUserID(ctx context.Context, userID valueobjects.UserID) (*entities.Profile, error) {
 	var model models.ProfileModel
-	err := r.db.WithContext(ctx).Where("user_id = ?", userID.Value()).First(&model).Error
+	err := persistence.Conn(ctx, r.db).Where("user_id = ?", userID.Value()).First(&model).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -94,28 +111,13 @@ func (r *profileRepositoryImpl) FindByUserID(ctx context.Context, userID valueob
 		return nil, err
 	}
 
-	profile, err := model.ToEntity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Decrypt DNI
-	if err := r.decryptProfileDNI(profile); err != nil {
-		return nil, err
-	}
-
-	return profile, nil
+	return r.toEntity(&model)
 }
 
 func (r *profileRepositoryImpl) FindByDNI(ctx context.Context, dni string) (*entities.Profile, error) {
-	// Encrypt DNI for search
-	encryptedDNI, err := r.encryptionService.Encrypt(dni)
-	if err != nil {
-		return nil, err
-	}
-
+	// Search by blind index: the ciphertext uses a random nonce and never matches
 	var model models.ProfileModel
-	err = r.db.WithContext(ctx).Where("dni_encrypted = ?", encryptedDNI).First(&model).Error
+	err := persistence.Conn(ctx, r.db).Where("dni_hash = ?", *r.dniHash(dni)).First(&model).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -123,35 +125,68 @@ func (r *profileRepositoryImpl) FindByDNI(ctx context.Context, dni string) (*ent
 		return nil, err
 	}
 
-	profile, err := model.ToEntity()
-	if err != nil {
-		return nil, err
-	}
+	return r.toEntity(&model)
+}
 
-	// Decrypt DNI
-	if err := r.decryptProfileDNI(profile); err != nil {
+// FindByIncomeBand busca por la columna income_band, que no está cifrada
+func (r *profileRepositoryImpl) FindByIncomeBand(ctx context.Context, band valueobjects.IncomeBand) ([]*entities.Profile, error) {
+	var rows []models.ProfileModel
+	if err := persistence.Conn(ctx, r.db).Where("income_band = ?", band.String()).Order("created_at").Find(&rows).Error; err != nil {
 		return nil, err
 	}
 
-	return profile, nil
+	profiles := make([]*entities.Profile, 0, len(rows))
+	for i := range rows {
+		profile, err := r.toEntity(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles, nil
 }
 
 func (r *profileRepositoryImpl) ExistsByUserID(ctx context.Context, userID valueobjects.UserID) (bool, error) {
 	var count int64
-	err := r.db.WithContext(ctx).Model(&models.ProfileModel{}).Where("user_id = ?", userID.Value()).Count(&count).Error
+	err := persistence.Conn(ctx, r.db).Model(&models.ProfileModel{}).Where("user_id = ?", userID.Value()).Count(&count).Error
 	return count > 0, err
 }
 
 func (r *profileRepositoryImpl) ExistsByDNI(ctx context.Context, dni string) (bool, error) {
-	// Encrypt DNI for search
-	encryptedDNI, err := r.encryptionService.Encrypt(dni)
+	// Search by blind index: the ciphertext uses a random nonce and never matches
+	var count int64
+	err := persistence.Conn(ctx, r.db).Model(&models.ProfileModel{}).Where("dni_hash = ?", *r.dniHash(dni)).Count(&count).Error
+	return count > 0, err
+}
+
+// dniHash computes the DNI blind index used for lookups and the unique constraint
+func (r *profileRepositoryImpl) dniHash(dni string) *string {
+	hash := r.blindIndexService.Compute(dniBlindIndexPurpose, dni)
+	return &hash
+}
+
+// DeleteByUserID elimina el perfil del usuario; no falla si no existe
+func (r *profileRepositoryImpl) DeleteByUserID(ctx context.Context, userID valueobjects.UserID) error {
+	return persistence.Conn(ctx, r.db).Where("user_id = ?", userID.Value()).Delete(&models.ProfileModel{}).Error
+}
+
+// toEntity decrypts the PII fields of the model and maps it to the entity with its DNI decrypted
+func (r *profileRepositoryImpl) toEntity(model *models.ProfileModel) (*entities.Profile, error) {
+	if err := r.fieldEncryption.decrypt(model); err != nil {
+		return nil, err
+	}
+
+	profile, err := model.ToEntity()
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	var count int64
-	err = r.db.WithContext(ctx).Model(&models.ProfileModel{}).Where("dni_encrypted = ?", encryptedDNI).Count(&count).Error
-	return count > 0, err
+	// Decrypt DNI
+	if err := r.decryptProfileDNI(profile); err != nil {
+		return nil, err
+	}
+
+	return profile, nil
 }
 
 // decryptProfileDNI decrypts the DNI field of a profile
@@ -1,6 +1,9 @@
 package acl
 
-import "context"
+import (
+	"context"
+	"encoding/json"
+)
 
 // ProfileContextFacade define el contrato ACL para que otros bounded contexts consulten Profile
 // Este facade expone solo las operaciones necesarias para consultas de perfil
@@ -13,4 +16,39 @@ type ProfileContextFacade interface {
 
 	// CreateProfile crea un perfil automáticamente con datos de RENIEC
 	CreateProfile(ctx context.Context, userID, dni, firstName, firstLastName, secondLastName string) error
+
+	// GetMonthlyIncome obtiene el ingreso mensual declarado por el titular; ok es false si no tiene perfil
+	GetMonthlyIncome(ctx context.Context, userID string) (amount float64, currency string, ok bool, err error)
+
+	// FindCoBorrower obtiene un co-prestatario del perfil del usuario, o nil si no existe o es de otro perfil
+	FindCoBorrower(ctx context.Context, userID, coBorrowerID string) (*CoBorrowerData, error)
+
+	// GetFinancialSnapshot obtiene ingreso, deudas y gastos del titular, o nil si no tiene perfil
+	GetFinancialSnapshot(ctx context.Context, userID string) (*FinancialSnapshotData, error)
+
+	// ExportUserData retorna el perfil del usuario (descifrado) con sus co-prestatarios y obligaciones como JSON, o null si no tiene perfil
+	ExportUserData(ctx context.Context, userID string) (json.RawMessage, error)
+
+	// DeleteUserData elimina definitivamente el perfil del usuario, sus co-prestatarios y obligaciones
+	DeleteUserData(ctx context.Context, userID string) error
+}
+
+// CoBorrowerData son los datos de un co-prestatario que Profile expone a otros contextos
+type CoBorrowerData struct {
+	ID            string
+	FullName      string
+	MonthlyIncome float64
+	Currency      string
+	Relationship  string
+}
+
+// FinancialSnapshotData es la situación financiera del titular en la moneda de su ingreso
+type FinancialSnapshotData struct {
+	Currency            string
+	MonthlyIncome       float64
+	TotalDebtPayments   float64 // Cuotas mensuales de deudas vigentes
+	TotalExpenses       float64 // Gastos fijos del hogar
+	NetDisposableIncome float64
+	DebtServiceRatio    float64
+	Dependents          int
 }
@@ -0,0 +1,198 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"finanzas-backend/internal/profile/domain/model/commands"
+	"finanzas-backend/internal/profile/domain/model/queries"
+	"finanzas-backend/internal/profile/domain/model/valueobjects"
+	"finanzas-backend/internal/profile/domain/services"
+	"finanzas-backend/internal/profile/interfaces/rest/resources"
+	"finanzas-backend/internal/shared/infrastructure/identity"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CoBorrowerController struct {
+	commandService services.CoBorrowerCommandService
+	queryService   services.CoBorrowerQueryService
+}
+
+func NewCoBorrowerController(
+	commandService services.CoBorrowerCommandService,
+	queryService services.CoBorrowerQueryService,
+) *CoBorrowerController {
+	return &CoBorrowerController{
+		commandService: commandService,
+		queryService:   queryService,
+	}
+}
+
+// GetCoBorrowers godoc
+// @Summary List co-borrowers
+// @Description List the co-borrowers registered in the authenticated user's profile
+// @Tags Profile
+// @Produce json
+// @Success 200 {array} resources.CoBorrowerResource
+// @Failure 401 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/profile/co-borrowers [get]
+func (c *CoBorrowerController) GetCoBorrowers(ctx *gin.Context) {
+	userID, ok := authenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	query, _ := queries.NewFindCoBorrowersByUserIDQuery(userID.String())
+	coBorrowers, err := c.queryService.HandleFindByUserID(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resources.TransformToCoBorrowerResources(coBorrowers))
+}
+
+// AddCoBorrower godoc
+// @Summary Add co-borrower
+// @Description Register a co-borrower (crédito mancomunado); the DNI is validated with RENIEC
+// @Tags Profile
+// @Accept json
+// @Produce json
+// @Param request body resources.AddCoBorrowerResource true "Add co-borrower request"
+// @Success 201 {object} resources.CoBorrowerResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/profile/co-borrowers [post]
+func (c *CoBorrowerController) AddCoBorrower(ctx *gin.Context) {
+	userID, ok := authenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req resources.AddCoBorrowerResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd, err := commands.NewAddCoBorrowerCommand(userID, req.DNI, req.MonthlyIncome, req.Currency, req.Relationship)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	coBorrower, err := c.commandService.HandleAdd(ctx.Request.Context(), cmd)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, resources.TransformToCoBorrowerResource(coBorrower))
+}
+
+// UpdateCoBorrower godoc
+// @Summary Update co-borrower
+// @Description Update a co-borrower's income or relationship
+// @Tags Profile
+// @Accept json
+// @Produce json
+// @Param id path string true "Co-borrower ID"
+// @Param request body resources.UpdateCoBorrowerResource true "Update co-borrower request"
+// @Success 200 {object} resources.CoBorrowerResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/profile/co-borrowers/{id} [put]
+func (c *CoBorrowerController) UpdateCoBorrower(ctx *gin.Context) {
+	userID, ok := authenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req resources.UpdateCoBorrowerResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd, err := commands.NewUpdateCoBorrowerCommand(userID, ctx.Param("id"), req.MonthlyIncome, req.Currency, req.Relationship)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	coBorrower, err := c.commandService.HandleUpdate(ctx.Request.Context(), cmd)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resources.TransformToCoBorrowerResource(coBorrower))
+}
+
+// RemoveCoBorrower godoc
+// @Summary Remove co-borrower
+// @Description Remove a co-borrower from the authenticated user's profile
+// @Tags Profile
+// @Param id path string true "Co-borrower ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/profile/co-borrowers/{id} [delete]
+func (c *CoBorrowerController) RemoveCoBorrower(ctx *gin.Context) {
+	userID, ok := authenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	cmd, err := commands.NewRemoveCoBorrowerCommand(userID, ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.commandService.HandleRemove(ctx.Request.Context(), cmd); err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// authenticatedUserID obtiene el usuario autenticado; si falta o es inválido ya respondió el error
+func authenticatedUserID(ctx *gin.Context) (valueobjects.UserID, bool) {
+	userIDValue, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return valueobjects.UserID{}, false
+	}
+
+	userID, err := valueobjects.NewUserIDFromString(userIDValue.(string))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return valueobjects.UserID{}, false
+	}
+	return userID, true
+}
+
+func (c *CoBorrowerController) respondError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, identity.ErrProviderUnavailable):
+		ctx.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+	case err.Error() == "profile not found" || err.Error() == "co-borrower not found":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case err.Error() == "co-borrower already registered":
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,211 @@
+package controllers
+
+import (
+	"net/http"
+
+	"finanzas-backend/internal/profile/domain/model/commands"
+	"finanzas-backend/internal/profile/domain/model/queries"
+	"finanzas-backend/internal/profile/domain/services"
+	"finanzas-backend/internal/profile/interfaces/rest/resources"
+
+	"github.com/gin-gonic/gin"
+)
+
+type FinancialObligationController struct {
+	commandService services.FinancialObligationCommandService
+	queryService   services.FinancialObligationQueryService
+}
+
+func NewFinancialObligationController(
+	commandService services.FinancialObligationCommandService,
+	queryService services.FinancialObligationQueryService,
+) *FinancialObligationController {
+	return &FinancialObligationController{
+		commandService: commandService,
+		queryService:   queryService,
+	}
+}
+
+// GetObligations godoc
+// @Summary List financial obligations
+// @Description List the debts and fixed expenses registered in the authenticated user's profile
+// @Tags Profile
+// @Produce json
+// @Success 200 {array} resources.FinancialObligationResource
+// @Failure 401 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/profile/obligations [get]
+func (c *FinancialObligationController) GetObligations(ctx *gin.Context) {
+	userID, ok := authenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	query, _ := queries.NewFindObligationsByUserIDQuery(userID.String())
+	obligations, err := c.queryService.HandleFindByUserID(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resources.TransformToFinancialObligationResources(obligations))
+}
+
+// AddObligation godoc
+// @Summary Add financial obligation
+// @Description Register a debt (credit card, loan) or fixed household expense
+// @Tags Profile
+// @Accept json
+// @Produce json
+// @Param request body resources.AddFinancialObligationResource true "Add obligation request"
+// @Success 201 {object} resources.FinancialObligationResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/profile/obligations [post]
+func (c *FinancialObligationController) AddObligation(ctx *gin.Context) {
+	userID, ok := authenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req resources.AddFinancialObligationResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd, err := commands.NewAddObligationCommand(userID, req.Type, req.Creditor, req.MonthlyPayment, req.Currency, req.OutstandingBalance)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	obligation, err := c.commandService.HandleAdd(ctx.Request.Context(), cmd)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, resources.TransformToFinancialObligationResource(obligation))
+}
+
+// UpdateObligation godoc
+// @Summary Update financial obligation
+// @Description Update a debt or fixed expense of the authenticated user's profile
+// @Tags Profile
+// @Accept json
+// @Produce json
+// @Param id path string true "Obligation ID"
+// @Param request body resources.UpdateFinancialObligationResource true "Update obligation request"
+// @Success 200 {object} resources.FinancialObligationResource
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/profile/obligations/{id} [put]
+func (c *FinancialObligationController) UpdateObligation(ctx *gin.Context) {
+	userID, ok := authenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req resources.UpdateFinancialObligationResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd, err := commands.NewUpdateObligationCommand(
+		userID,
+		ctx.Param("id"),
+		req.Type,
+		req.Creditor,
+		req.MonthlyPayment,
+		req.Currency,
+		req.OutstandingBalance,
+	)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	obligation, err := c.commandService.HandleUpdate(ctx.Request.Context(), cmd)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resources.TransformToFinancialObligationResource(obligation))
+}
+
+// RemoveObligation godoc
+// @Summary Remove financial obligation
+// @Description Remove a debt or fixed expense from the authenticated user's profile
+// @Tags Profile
+// @Param id path string true "Obligation ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/profile/obligations/{id} [delete]
+func (c *FinancialObligationController) RemoveObligation(ctx *gin.Context) {
+	userID, ok := authenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	cmd, err := commands.NewRemoveObligationCommand(userID, ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.commandService.HandleRemove(ctx.Request.Context(), cmd); err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// GetFinancialSnapshot godoc
+// @Summary Get financial snapshot
+// @Description Net disposable income and debt-service ratio of the authenticated user, in the currency of their income
+// @Tags Profile
+// @Produce json
+// @Success 200 {object} resources.FinancialSnapshotResource
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/profile/financial-snapshot [get]
+func (c *FinancialObligationController) GetFinancialSnapshot(ctx *gin.Context) {
+	userID, ok := authenticatedUserID(ctx)
+	if !ok {
+		return
+	}
+
+	query, _ := queries.NewGetFinancialSnapshotQuery(userID.String())
+	snapshot, err := c.queryService.HandleGetFinancialSnapshot(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if snapshot == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "profile not found"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resources.TransformToFinancialSnapshotResource(snapshot))
+}
+
+func (c *FinancialObligationController) respondError(ctx *gin.Context, err error) {
+	switch err.Error() {
+	case "profile not found", "obligation not found":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
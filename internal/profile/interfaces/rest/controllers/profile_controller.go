@@ -125,6 +125,7 @@ func (c *ProfileController) UpdateProfile(ctx *gin.Context) {
 		req.MaritalStatus,
 		req.IsFirstHome,
 		req.HasOwnLand,
+		req.Dependents,
 	)
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -150,20 +151,5 @@ func (c *ProfileController) UpdateProfile(ctx *gin.Context) {
 }
 
 func (c *ProfileController) transformProfileToResource(profile *entities.Profile) resources.ProfileResource {
-	return resources.ProfileResource{
-		ID:             profile.ID().String(),
-		UserID:         profile.UserID().String(),
-		DNI:            profile.DNI().Value(),
-		FirstName:      profile.FirstName(),
-		FirstLastName:  profile.FirstLastName(),
-		SecondLastName: profile.SecondLastName(),
-		FullName:       profile.FullName(),
-		PhoneNumber:    profile.PhoneNumber().Value(),
-		MonthlyIncome:  profile.MonthlyIncome().Amount(),
-		Currency:       string(profile.MonthlyIncome().Currency()),
-		MaritalStatus:  profile.MaritalStatus().String(),
-		IsFirstHome:    profile.IsFirstHome(),
-		HasOwnLand:     profile.HasOwnLand(),
-		CreatedAt:      profile.CreatedAt(),
-	}
+	return resources.TransformToProfileResource(profile)
 }
@@ -0,0 +1,65 @@
+package resources
+
+import (
+	"time"
+
+	"finanzas-backend/internal/profile/domain/model/entities"
+)
+
+type CoBorrowerResource struct {
+	ID             string    `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	DNI            string    `json:"dni" example:"41234567"`
+	FirstName      string    `json:"first_name" example:"CARLOS ALBERTO"`
+	FirstLastName  string    `json:"first_last_name" example:"RAMIREZ"`
+	SecondLastName string    `json:"second_last_name" example:"SOTO"`
+	FullName       string    `json:"full_name" example:"RAMIREZ SOTO CARLOS ALBERTO"`
+	MonthlyIncome  float64   `json:"monthly_income" example:"3500.00"`
+	Currency       string    `json:"currency" example:"PEN"`
+	Relationship   string    `json:"relationship" example:"CONYUGE"`
+	CreatedAt      time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`
+}
+
+type AddCoBorrowerResource struct {
+	DNI           string  `json:"dni" example:"41234567" validate:"required,len=8"`
+	MonthlyIncome float64 `json:"monthly_income" example:"3500.00" validate:"required,gt=0"`
+	Currency      string  `json:"currency" example:"PEN" validate:"required,oneof=PEN USD"`
+	Relationship  string  `json:"relationship" example:"CONYUGE" validate:"required,oneof=CONYUGE CONVIVIENTE PADRE_MADRE HIJO HERMANO OTRO"`
+}
+
+type UpdateCoBorrowerResource struct {
+	MonthlyIncome *float64 `json:"monthly_income,omitempty" example:"4000.00" validate:"omitempty,gt=0"`
+	Currency      *string  `json:"currency,omitempty" example:"USD" validate:"omitempty,oneof=PEN USD"`
+	Relationship  *string  `json:"relationship,omitempty" example:"CONVIVIENTE" validate:"omitempty,oneof=CONYUGE CONVIVIENTE PADRE_MADRE HIJO HERMANO OTRO"`
+}
+
+// ProfileExportResource es el perfil con sus co-prestatarios y obligaciones, usado en la exportación de datos
+type ProfileExportResource struct {
+	ProfileResource
+	CoBorrowers []CoBorrowerResource          `json:"co_borrowers"`
+	Obligations []FinancialObligationResource `json:"obligations"`
+}
+
+// TransformToCoBorrowerResource transforma una entidad CoBorrower a CoBorrowerResource
+func TransformToCoBorrowerResource(coBorrower *entities.CoBorrower) CoBorrowerResource {
+	return CoBorrowerResource{
+		ID:             coBorrower.ID().String(),
+		DNI:            coBorrower.DNI().Value(),
+		FirstName:      coBorrower.FirstName(),
+		FirstLastName:  coBorrower.FirstLastName(),
+		SecondLastName: coBorrower.SecondLastName(),
+		FullName:       coBorrower.FullName(),
+		MonthlyIncome:  coBorrower.MonthlyIncome().Amount(),
+		Currency:       string(coBorrower.MonthlyIncome().Currency()),
+		Relationship:   coBorrower.Relationship().String(),
+		CreatedAt:      coBorrower.CreatedAt(),
+	}
+}
+
+// TransformToCoBorrowerResources transforma una lista de co-prestatarios
+func TransformToCoBorrowerResources(coBorrowers []*entities.CoBorrower) []CoBorrowerResource {
+	result := make([]CoBorrowerResource, 0, len(coBorrowers))
+	for _, coBorrower := range coBorrowers {
+		result = append(result, TransformToCoBorrowerResource(coBorrower))
+	}
+	return result
+}
@@ -0,0 +1,100 @@
+package resources
+
+import (
+	"time"
+
+	"finanzas-backend/internal/profile/domain/model/entities"
+)
+
+type FinancialObligationResource struct {
+	ID                 string    `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Type               string    `json:"type" example:"TARJETA_CREDITO"`
+	IsDebt             bool      `json:"is_debt" example:"true"`
+	Creditor           string    `json:"creditor" example:"BCP"`
+	MonthlyPayment     float64   `json:"monthly_payment" example:"450.00"`
+	Currency           string    `json:"currency" example:"PEN"`
+	OutstandingBalance float64   `json:"outstanding_balance" example:"3200.00"`
+	CreatedAt          time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`
+}
+
+type AddFinancialObligationResource struct {
+	Type               string  `json:"type" example:"TARJETA_CREDITO" validate:"required,oneof=TARJETA_CREDITO PRESTAMO_VEHICULAR PRESTAMO_PERSONAL PRESTAMO_HIPOTECARIO OTRA_DEUDA ALQUILER EDUCACION OTRO_GASTO"`
+	Creditor           string  `json:"creditor" example:"BCP" validate:"omitempty,max=100"`
+	MonthlyPayment     float64 `json:"monthly_payment" example:"450.00" validate:"required,gt=0"`
+	Currency           string  `json:"currency" example:"PEN" validate:"required,oneof=PEN USD"`
+	OutstandingBalance float64 `json:"outstanding_balance" example:"3200.00" validate:"omitempty,min=0"`
+}
+
+type UpdateFinancialObligationResource struct {
+	Type               *string  `json:"type,omitempty" example:"PRESTAMO_PERSONAL" validate:"omitempty,oneof=TARJETA_CREDITO PRESTAMO_VEHICULAR PRESTAMO_PERSONAL PRESTAMO_HIPOTECARIO OTRA_DEUDA ALQUILER EDUCACION OTRO_GASTO"`
+	Creditor           *string  `json:"creditor,omitempty" example:"Interbank" validate:"omitempty,max=100"`
+	MonthlyPayment     *float64 `json:"monthly_payment,omitempty" example:"500.00" validate:"omitempty,gt=0"`
+	Currency           *string  `json:"currency,omitempty" example:"PEN" validate:"omitempty,oneof=PEN USD"`
+	OutstandingBalance *float64 `json:"outstanding_balance,omitempty" example:"2800.00" validate:"omitempty,min=0"`
+}
+
+type FinancialSnapshotResource struct {
+	Currency             string                `json:"currency" example:"PEN"`
+	MonthlyIncome        float64               `json:"monthly_income" example:"5000.00"`
+	TotalDebtPayments    float64               `json:"total_debt_payments" example:"950.00"`
+	TotalExpenses        float64               `json:"total_expenses" example:"1200.00"`
+	NetDisposableIncome  float64               `json:"net_disposable_income" example:"2850.00"`
+	DebtServiceRatio     float64               `json:"debt_service_ratio" example:"0.19"`
+	Dependents           int                   `json:"dependents" example:"2"`
+	ConvertedObligations int                   `json:"converted_obligations" example:"1"` // Obligaciones en otra moneda convertidas
+	ExcludedObligations  int                   `json:"excluded_obligations" example:"0"`  // En otra moneda y sin tipo de cambio vigente
+	ExchangeRate         *ExchangeRateResource `json:"exchange_rate,omitempty"`
+}
+
+// ExchangeRateResource es el tipo de cambio (S/ por US$) usado para convertir obligaciones
+type ExchangeRateResource struct {
+	Date string  `json:"date" example:"2024-01-02"`
+	Buy  float64 `json:"buy" example:"3.70"`
+	Sell float64 `json:"sell" example:"3.72"`
+}
+
+// TransformToFinancialObligationResource transforma una entidad FinancialObligation a su recurso
+func TransformToFinancialObligationResource(obligation *entities.FinancialObligation) FinancialObligationResource {
+	return FinancialObligationResource{
+		ID:                 obligation.ID().String(),
+		Type:               obligation.Type().String(),
+		IsDebt:             obligation.Type().IsDebt(),
+		Creditor:           obligation.Creditor(),
+		MonthlyPayment:     obligation.MonthlyPayment(),
+		Currency:           string(obligation.Currency()),
+		OutstandingBalance: obligation.OutstandingBalance(),
+		CreatedAt:          obligation.CreatedAt(),
+	}
+}
+
+// TransformToFinancialObligationResources transforma una lista de obligaciones
+func TransformToFinancialObligationResources(obligations []*entities.FinancialObligation) []FinancialObligationResource {
+	result := make([]FinancialObligationResource, 0, len(obligations))
+	for _, obligation := range obligations {
+		result = append(result, TransformToFinancialObligationResource(obligation))
+	}
+	return result
+}
+
+// TransformToFinancialSnapshotResource transforma un FinancialSnapshot a su recurso
+func TransformToFinancialSnapshotResource(snapshot *entities.FinancialSnapshot) FinancialSnapshotResource {
+	resource := FinancialSnapshotResource{
+		Currency:             string(snapshot.Currency()),
+		MonthlyIncome:        snapshot.MonthlyIncome(),
+		TotalDebtPayments:    snapshot.TotalDebtPayments(),
+		TotalExpenses:        snapshot.TotalExpenses(),
+		NetDisposableIncome:  snapshot.NetDisposableIncome(),
+		DebtServiceRatio:     snapshot.DebtServiceRatio(),
+		Dependents:           snapshot.Dependents(),
+		ConvertedObligations: snapshot.ConvertedObligations(),
+		ExcludedObligations:  snapshot.ExcludedObligations(),
+	}
+	if rate := snapshot.ExchangeRate(); rate != nil {
+		resource.ExchangeRate = &ExchangeRateResource{
+			Date: rate.Date().Format("2006-01-02"),
+			Buy:  rate.Buy(),
+			Sell: rate.Sell(),
+		}
+	}
+	return resource
+}
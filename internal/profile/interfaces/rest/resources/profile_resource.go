@@ -1,6 +1,10 @@
 package resources
 
-import "time"
+import (
+	"time"
+
+	"finanzas-backend/internal/profile/domain/model/entities"
+)
 
 type ProfileResource struct {
 	ID             string    `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
@@ -16,6 +20,7 @@ type ProfileResource struct {
 	MaritalStatus  string    `json:"marital_status" example:"SOLTERO"`
 	IsFirstHome    bool      `json:"is_first_home" example:"true"`
 	HasOwnLand     bool      `json:"has_own_land" example:"false"`
+	Dependents     int       `json:"dependents" example:"2"`
 	CreatedAt      time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`
 }
 
@@ -36,6 +41,7 @@ type UpdateProfileResource struct {
 	MaritalStatus *string  `json:"marital_status,omitempty" example:"CASADO" validate:"omitempty,oneof=SOLTERO CASADO DIVORCIADO VIUDO"`
 	IsFirstHome   *bool    `json:"is_first_home,omitempty" example:"false"`
 	HasOwnLand    *bool    `json:"has_own_land,omitempty" example:"true"`
+	Dependents    *int     `json:"dependents,omitempty" example:"2" validate:"omitempty,min=0"`
 }
 
 type ReniecDataResource struct {
@@ -49,3 +55,24 @@ type ReniecDataResource struct {
 type ErrorResponse struct {
 	Error string `json:"error" example:"error message"`
 }
+
+// TransformToProfileResource transforma una entidad Profile a ProfileResource
+func TransformToProfileResource(profile *entities.Profile) ProfileResource {
+	return ProfileResource{
+		ID:             profile.ID().String(),
+		UserID:         profile.UserID().String(),
+		DNI:            profile.DNI().Value(),
+		FirstName:      profile.FirstName(),
+		FirstLastName:  profile.FirstLastName(),
+		SecondLastName: profile.SecondLastName(),
+		FullName:       profile.FullName(),
+		PhoneNumber:    profile.PhoneNumber().Value(),
+		MonthlyIncome:  profile.MonthlyIncome().Amount(),
+		Currency:       string(profile.MonthlyIncome().Currency()),
+		MaritalStatus:  profile.MaritalStatus().String(),
+		IsFirstHome:    profile.IsFirstHome(),
+		HasOwnLand:     profile.HasOwnLand(),
+		Dependents:     profile.Dependents(),
+		CreatedAt:      profile.CreatedAt(),
+	}
+}
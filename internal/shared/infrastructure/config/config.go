@@ -14,6 +14,12 @@ type Config struct {
 	JWT        JWTConfig
 	Reniec     ReniecConfig
 	Encryption EncryptionConfig
+	MFA        MFAConfig
+	Account    AccountConfig
+	Profile    ProfileConfig
+	Exchange   ExchangeRateConfig
+	Admin      AdminConfig
+	Mortgage   MortgageConfig
 }
 
 type DatabaseConfig struct {
@@ -35,17 +41,62 @@ type AppConfig struct {
 }
 
 type JWTConfig struct {
-	SecretKey     string
-	Issuer        string
-	ExpirationHrs int
+	Algorithm      string // RS256 o EdDSA
+	Issuer         string
+	ExpirationHrs  int
+	KeyRotationHrs int
 }
 
 type ReniecConfig struct {
-	APIKey string
+	Provider         string // decolecta o stub (datos ficticios, sin red)
+	APIKey           string
+	BaseURL          string
+	TimeoutSecs      int
+	CacheTTLMins     int
+	MaxAttempts      int // Intentos por consulta ante fallas transitorias
+	BreakerThreshold int // Fallas consecutivas que abren el circuit breaker
+	BreakerOpenSecs  int
 }
 
 type EncryptionConfig struct {
-	Key string
+	Provider      string // env, file o localkms
+	Key           string // Llave única previa al versionado; descifra datos sin kid
+	Keys          string // Llaves versionadas para el proveedor env: "kid:llave,kid:llave"
+	CurrentKeyID  string // Llave con la que se cifran los datos nuevos
+	KeysFile      string // Archivo JSON de llaves para el proveedor file
+	KMSDir        string // Directorio del KMS local
+	BlindIndexKey string // Llave HMAC para índices de búsqueda sobre datos cifrados (opcional)
+	ReencryptMins int    // Cada cuántos minutos se re-cifran los perfiles con la llave vigente
+}
+
+type MFAConfig struct {
+	Issuer string
+}
+
+type ProfileConfig struct {
+	EncryptedFields string // Campos de PII del perfil cifrados en reposo, separados por comas
+}
+
+type ExchangeRateConfig struct {
+	ManualFile string // Tabla de tipos de cambio mantenida a mano (fecha,compra,venta)
+	SBSFile    string // Descarga diaria de tipos de cambio de la SBS
+	BCRPFile   string // Serie diaria de tipos de cambio del BCRP
+	ImportMins int    // Cada cuántos minutos se revisan los archivos por cambios
+	MaxAgeDays int    // Antigüedad máxima del tipo de cambio para convertir montos (0 sin límite)
+}
+
+type AdminConfig struct {
+	Emails string // Emails de los administradores del catálogo de productos, separados por comas
+}
+
+type MortgageConfig struct {
+	OffersFile      string // Archivo JSON con las ofertas de entidades para cotizar, mantenido por los administradores
+	IndexFile       string // CSV con los valores diarios del VAC/IPC (fecha,vac[,ipc])
+	IndexImportMins int    // Cada cuántos minutos se revisa el archivo de índices por cambios
+}
+
+type AccountConfig struct {
+	DeletionGraceDays int // Días entre la solicitud de eliminación y el borrado definitivo
 }
 
 func LoadConfig() (*Config, error) {
@@ -71,15 +122,54 @@ func LoadConfig() (*Config, error) {
 			Env: getEnv("APP_ENV", "development"),
 		},
 		JWT: JWTConfig{
-			SecretKey:     getEnv("JWT_SECRET_KEY", "your-super-secret-key-change-in-production"),
-			Issuer:        getEnv("JWT_ISSUER", "finanzas-backend"),
-			ExpirationHrs: getEnvAsInt("JWT_EXPIRATION_HRS", 24),
+			Algorithm:      getEnv("JWT_SIGNING_ALGORITHM", "RS256"),
+			Issuer:         getEnv("JWT_ISSUER", "finanzas-backend"),
+			ExpirationHrs:  getEnvAsInt("JWT_EXPIRATION_HRS", 24),
+			KeyRotationHrs: getEnvAsInt("JWT_KEY_ROTATION_HRS", 720),
 		},
 		Reniec: ReniecConfig{
-			APIKey: getEnv("RENIEC_API_KEY", ""),
+			Provider:         getEnv("IDENTITY_PROVIDER", "decolecta"),
+			APIKey:           getEnv("RENIEC_API_KEY", ""),
+			BaseURL:          getEnv("RENIEC_BASE_URL", "https://api.decolecta.com/v1/reniec"),
+			TimeoutSecs:      getEnvAsInt("RENIEC_TIMEOUT_SECS", 10),
+			CacheTTLMins:     getEnvAsInt("RENIEC_CACHE_TTL_MINS", 1440),
+			MaxAttempts:      getEnvAsInt("RENIEC_MAX_ATTEMPTS", 3),
+			BreakerThreshold: getEnvAsInt("RENIEC_BREAKER_THRESHOLD", 5),
+			BreakerOpenSecs:  getEnvAsInt("RENIEC_BREAKER_OPEN_SECS", 30),
 		},
 		Encryption: EncryptionConfig{
-			Key: getEnv("ENCRYPTION_KEY", "12345678901234567890123456789012"), // 32 bytes default for dev
+			Provider:      getEnv("ENCRYPTION_KEY_PROVIDER", "env"),
+			Key:           getEnv("ENCRYPTION_KEY", ""),
+			Keys:          getEnv("ENCRYPTION_KEYS", ""),
+			CurrentKeyID:  getEnv("ENCRYPTION_KEY_ID", ""),
+			KeysFile:      getEnv("ENCRYPTION_KEYS_FILE", ""),
+			KMSDir:        getEnv("ENCRYPTION_KMS_DIR", ".kms"),
+			BlindIndexKey: getEnv("BLIND_INDEX_KEY", ""), // Derivada de ENCRYPTION_KEY si está vacía
+			ReencryptMins: getEnvAsInt("ENCRYPTION_REENCRYPT_INTERVAL_MINS", 60),
+		},
+		MFA: MFAConfig{
+			Issuer: getEnv("MFA_ISSUER", "Finanzas MiVivienda"),
+		},
+		Account: AccountConfig{
+			DeletionGraceDays: getEnvAsInt("ACCOUNT_DELETION_GRACE_DAYS", 30),
+		},
+		Profile: ProfileConfig{
+			EncryptedFields: getEnv("PROFILE_ENCRYPTED_FIELDS", "first_name,first_last_name,second_last_name,phone_number,monthly_income"),
+		},
+		Exchange: ExchangeRateConfig{
+			ManualFile: getEnv("EXCHANGE_RATES_MANUAL_FILE", ""),
+			SBSFile:    getEnv("EXCHANGE_RATES_SBS_FILE", ""),
+			BCRPFile:   getEnv("EXCHANGE_RATES_BCRP_FILE", ""),
+			ImportMins: getEnvAsInt("EXCHANGE_RATES_IMPORT_INTERVAL_MINS", 60),
+			MaxAgeDays: getEnvAsInt("EXCHANGE_RATE_MAX_AGE_DAYS", 7),
+		},
+		Admin: AdminConfig{
+			Emails: getEnv("ADMIN_EMAILS", ""),
+		},
+		Mortgage: MortgageConfig{
+			OffersFile:      getEnv("LENDER_OFFERS_FILE", ""),
+			IndexFile:       getEnv("VAC_INDEX_FILE", ""),
+			IndexImportMins: getEnvAsInt("VAC_INDEX_IMPORT_INTERVAL_MINS", 60),
 		},
 	}
 
@@ -0,0 +1,72 @@
+package identity
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type cacheEntry struct {
+	person    PersonData
+	expiresAt time.Time
+}
+
+// cachedProvider guarda en memoria las consultas exitosas durante ttl
+type cachedProvider struct {
+	next       Provider
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+// NewCachedProvider envuelve next con una caché TTL en proceso. Solo guarda respuestas exitosas;
+// al alcanzar maxEntries descarta las vencidas y, si no alcanza, vacía la caché.
+func NewCachedProvider(next Provider, ttl time.Duration, maxEntries int) Provider {
+	return &cachedProvider{
+		next:       next,
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		entries:    make(map[string]cacheEntry),
+	}
+}
+
+func (p *cachedProvider) LookupDNI(ctx context.Context, dni string) (*PersonData, error) {
+	now := p.now()
+
+	p.mu.Lock()
+	entry, ok := p.entries[dni]
+	p.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		person := entry.person
+		return &person, nil
+	}
+
+	person, err := p.next.LookupDNI(ctx, dni)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.entries) >= p.maxEntries {
+		p.evict(now)
+	}
+	p.entries[dni] = cacheEntry{person: *person, expiresAt: now.Add(p.ttl)}
+	return person, nil
+}
+
+// evict descarta las entradas vencidas; si la caché sigue llena la vacía. Debe llamarse con mu tomado.
+func (p *cachedProvider) evict(now time.Time) {
+	for dni, entry := range p.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(p.entries, dni)
+		}
+	}
+	if len(p.entries) >= p.maxEntries {
+		p.entries = make(map[string]cacheEntry)
+	}
+}
@@ -0,0 +1,85 @@
+package identity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultDecolectaBaseURL es la API de RENIEC de Decolecta
+const DefaultDecolectaBaseURL = "https://api.decolecta.com/v1/reniec"
+
+type decolectaProvider struct {
+	apiKey     string
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewDecolectaProvider crea el cliente de RENIEC vía Decolecta
+func NewDecolectaProvider(baseURL, apiKey string, timeout time.Duration) Provider {
+	if baseURL == "" {
+		baseURL = DefaultDecolectaBaseURL
+	}
+	return &decolectaProvider{
+		apiKey:  apiKey,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+func (p *decolectaProvider) LookupDNI(ctx context.Context, dni string) (*PersonData, error) {
+	endpoint := fmt.Sprintf("%s/dni?numero=%s", p.baseURL, url.QueryEscape(dni))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Set headers
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", p.apiKey))
+
+	// Execute request
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, &transientError{fmt.Errorf("failed to execute request: %w", err)}
+	}
+	defer resp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &transientError{fmt.Errorf("failed to read response body: %w", err)}
+	}
+
+	// Check status code
+	switch {
+	case resp.StatusCode == http.StatusOK:
+	case resp.StatusCode == http.StatusNotFound, resp.StatusCode == http.StatusUnprocessableEntity:
+		return nil, ErrPersonNotFound
+	case resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode >= http.StatusInternalServerError:
+		return nil, &transientError{fmt.Errorf("RENIEC API returned status %d", resp.StatusCode)}
+	default:
+		return nil, fmt.Errorf("RENIEC API returned status %d", resp.StatusCode)
+	}
+
+	// Parse response
+	var personData PersonData
+	if err := json.Unmarshal(body, &personData); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	// Validate response
+	if personData.DocumentNumber == "" {
+		return nil, ErrPersonNotFound
+	}
+
+	return &personData, nil
+}
@@ -0,0 +1,45 @@
+package identity
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrPersonNotFound indica que el documento no existe en el registro de identidad
+var ErrPersonNotFound = errors.New("DNI not found in RENIEC")
+
+// ErrProviderUnavailable indica que el proveedor de identidad no responde; la operación puede reintentarse más tarde
+var ErrProviderUnavailable = errors.New("identity provider unavailable, try again later")
+
+// PersonData son los datos de una persona según el registro de identidad
+type PersonData struct {
+	FirstName      string `json:"first_name"`
+	FirstLastName  string `json:"first_last_name"`
+	SecondLastName string `json:"second_last_name"`
+	FullName       string `json:"full_name"`
+	DocumentNumber string `json:"document_number"`
+}
+
+// Provider consulta los datos de una persona por su DNI (RENIEC u otro registro).
+// Compartido por los contextos que necesitan validar identidad.
+type Provider interface {
+	LookupDNI(ctx context.Context, dni string) (*PersonData, error)
+}
+
+// transientError marca fallas que pueden resolverse reintentando (red, timeouts, 5xx, 429)
+type transientError struct {
+	err error
+}
+
+func (e *transientError) Error() string {
+	return e.err.Error()
+}
+
+func (e *transientError) Unwrap() error {
+	return e.err
+}
+
+func isTransient(err error) bool {
+	var transient *transientError
+	return errors.As(err, &transient)
+}
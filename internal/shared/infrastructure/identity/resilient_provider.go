@@ -0,0 +1,124 @@
+package identity
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// ResilienceOptions configura reintentos y circuit breaker
+type ResilienceOptions struct {
+	MaxAttempts      int           // Intentos por consulta, incluido el primero
+	InitialBackoff   time.Duration // Espera antes del primer reintento; se duplica en cada uno
+	FailureThreshold int           // Fallas consecutivas que abren el circuito
+	OpenDuration     time.Duration // Tiempo que el circuito permanece abierto antes de probar de nuevo
+}
+
+// resilientProvider reintenta fallas transitorias y, tras varias fallas seguidas, abre el circuito:
+// mientras está abierto responde ErrProviderUnavailable sin llamar al proveedor, para no bloquear
+// cada registro con timeouts. Pasado OpenDuration deja pasar una consulta de prueba (half-open).
+type resilientProvider struct {
+	next    Provider
+	options ResilienceOptions
+	now     func() time.Time
+
+	mu                  sync.Mutex
+	consecutiveFailures int
+	openUntil           time.Time
+	probing             bool
+}
+
+// NewResilientProvider envuelve next con reintentos y circuit breaker
+func NewResilientProvider(next Provider, options ResilienceOptions) Provider {
+	if options.MaxAttempts < 1 {
+		options.MaxAttempts = 1
+	}
+	if options.FailureThreshold < 1 {
+		options.FailureThreshold = 1
+	}
+	return &resilientProvider{
+		next:    next,
+		options: options,
+		now:     time.Now,
+	}
+}
+
+func (p *resilientProvider) LookupDNI(ctx context.Context, dni string) (*PersonData, error) {
+	if !p.allow() {
+		return nil, ErrProviderUnavailable
+	}
+
+	backoff := p.options.InitialBackoff
+	var err error
+	for attempt := 1; attempt <= p.options.MaxAttempts; attempt++ {
+		var person *PersonData
+		person, err = p.next.LookupDNI(ctx, dni)
+		if err == nil || !isTransient(err) {
+			// Una respuesta definitiva (incluido "no encontrado") indica que el proveedor funciona
+			p.recordSuccess()
+			return person, err
+		}
+
+		if attempt == p.options.MaxAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			// La cancelación es del cliente, no una falla del proveedor
+			p.releaseProbe()
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+
+	log.Printf("Identity provider lookup failed after %d attempt(s): %v", p.options.MaxAttempts, err)
+	p.recordFailure()
+	return nil, ErrProviderUnavailable
+}
+
+// allow indica si la consulta puede llegar al proveedor según el estado del circuito
+func (p *resilientProvider) allow() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.openUntil.IsZero() {
+		return true
+	}
+	if p.now().Before(p.openUntil) || p.probing {
+		return false
+	}
+	p.probing = true
+	return true
+}
+
+func (p *resilientProvider) recordSuccess() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.openUntil.IsZero() {
+		log.Printf("Identity provider circuit closed")
+	}
+	p.consecutiveFailures = 0
+	p.openUntil = time.Time{}
+	p.probing = false
+}
+
+func (p *resilientProvider) releaseProbe() {
+	p.mu.Lock()
+	p.probing = false
+	p.mu.Unlock()
+}
+
+func (p *resilientProvider) recordFailure() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.consecutiveFailures++
+	if p.probing || p.consecutiveFailures >= p.options.FailureThreshold {
+		p.openUntil = p.now().Add(p.options.OpenDuration)
+		log.Printf("Identity provider circuit opened for %s", p.options.OpenDuration)
+	}
+	p.probing = false
+}
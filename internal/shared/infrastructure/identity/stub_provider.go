@@ -0,0 +1,44 @@
+package identity
+
+import (
+	"context"
+	"strings"
+)
+
+// StubNotFoundDNI es el DNI que el stub reporta como inexistente, para probar ese flujo
+const StubNotFoundDNI = "00000000"
+
+type stubProvider struct {
+	people map[string]PersonData
+}
+
+// NewStubProvider crea un proveedor en memoria para desarrollo y pruebas, sin llamadas de red.
+// Responde con people si el DNI está registrado y, si no, con datos ficticios derivados del DNI.
+func NewStubProvider(people map[string]PersonData) Provider {
+	if people == nil {
+		people = map[string]PersonData{}
+	}
+	return &stubProvider{people: people}
+}
+
+func (p *stubProvider) LookupDNI(ctx context.Context, dni string) (*PersonData, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if dni == StubNotFoundDNI {
+		return nil, ErrPersonNotFound
+	}
+
+	if person, ok := p.people[dni]; ok {
+		return &person, nil
+	}
+
+	person := PersonData{
+		FirstName:      "USUARIO",
+		FirstLastName:  "PRUEBA",
+		SecondLastName: dni,
+		DocumentNumber: dni,
+	}
+	person.FullName = strings.Join([]string{person.FirstName, person.FirstLastName, person.SecondLastName}, " ")
+	return &person, nil
+}
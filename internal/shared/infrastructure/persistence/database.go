@@ -11,6 +11,7 @@ import (
 	"gorm.io/gorm/logger"
 
 	// Import models for auto-migration
+	exchangeRateModels "finanzas-backend/internal/exchangerate/infrastructure/persistence/models"
 	iamModels "finanzas-backend/internal/iam/infrastructure/persistence/models"
 	mortgageModels "finanzas-backend/internal/mortgage/infrastructure/persistence/models"
 	profileModels "finanzas-backend/internal/profile/infrastructure/persistence/models"
@@ -46,8 +47,20 @@ func NewDatabase(cfg *config.Config) (*gorm.DB, error) {
 func autoMigrate(db *gorm.DB) error {
 	return db.AutoMigrate(
 		&iamModels.UserModel{},
+		&iamModels.RecoveryCodeModel{},
+		&iamModels.SigningKeyModel{},
+		&iamModels.APIKeyModel{},
 		&mortgageModels.MortgageModel{},
 		&mortgageModels.PaymentScheduleItemModel{},
+		&mortgageModels.MortgageGraceWindowModel{},
+		&mortgageModels.MortgagePaymentModel{},
+		&mortgageModels.MortgageVersionModel{},
+		&mortgageModels.LenderProductModel{},
+		&mortgageModels.LenderProductRateTierModel{},
+		&mortgageModels.PriceIndexModel{},
 		&profileModels.ProfileModel{},
+		&profileModels.CoBorrowerModel{},
+		&profileModels.FinancialObligationModel{},
+		&exchangeRateModels.ExchangeRateModel{},
 	)
 }
@@ -0,0 +1,215 @@
+// Package persistencetest ofrece una base de datos en memoria para probar transacciones sin Postgres.
+// Solo entiende lo necesario para contar filas: INSERT INTO "tabla" y SELECT count(*) FROM "tabla";
+// el resto de sentencias se acepta sin efecto y las consultas no retornan filas.
+package persistencetest
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sync"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const driverName = "persistencetest"
+
+var (
+	registerOnce sync.Once
+	storesMu     sync.Mutex
+	stores       = map[string]*Store{}
+
+	insertPattern = regexp.MustCompile(`(?i)^\s*INSERT INTO "?(\w+)"?`)
+	countPattern  = regexp.MustCompile(`(?i)^\s*SELECT count\(\*\) FROM "?(\w+)"?`)
+)
+
+// Store guarda las filas confirmadas por tabla y cuenta commits y rollbacks
+type Store struct {
+	mu        sync.Mutex
+	rows      map[string]int
+	commits   int
+	rollbacks int
+}
+
+// Rows retorna las filas confirmadas de la tabla
+func (s *Store) Rows(table string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rows[table]
+}
+
+func (s *Store) Commits() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.commits
+}
+
+func (s *Store) Rollbacks() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rollbacks
+}
+
+// Open abre un *gorm.DB con el dialecto de Postgres sobre una base en memoria nueva
+func Open(t testing.TB) (*gorm.DB, *Store) {
+	t.Helper()
+	registerOnce.Do(func() { sql.Register(driverName, fakeDriver{}) })
+
+	store := &Store{rows: map[string]int{}}
+	dsn := t.Name()
+	storesMu.Lock()
+	stores[dsn] = store
+	storesMu.Unlock()
+	t.Cleanup(func() {
+		storesMu.Lock()
+		delete(stores, dsn)
+		storesMu.Unlock()
+	})
+
+	db, err := gorm.Open(postgres.New(postgres.Config{DriverName: driverName, DSN: dsn}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("open fake database: %v", err)
+	}
+	return db, store
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(dsn string) (driver.Conn, error) {
+	storesMu.Lock()
+	store, ok := stores[dsn]
+	storesMu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown fake database %q", dsn)
+	}
+	return &fakeConn{store: store}, nil
+}
+
+type fakeConn struct {
+	store *Store
+	tx    *fakeTx
+}
+
+type fakeTx struct {
+	conn    *fakeConn
+	pending map[string]int
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{conn: c, query: query}, nil
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *fakeConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if c.tx != nil {
+		return nil, errors.New("transaction already in progress")
+	}
+	c.tx = &fakeTx{conn: c, pending: map[string]int{}}
+	return c.tx, nil
+}
+
+func (c *fakeConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.insert(query)
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if m := countPattern.FindStringSubmatch(query); m != nil {
+		return &fakeRows{columns: []string{"count"}, values: [][]driver.Value{{int64(c.count(m[1]))}}}, nil
+	}
+	// INSERT ... RETURNING: se registra la fila y no se retorna nada
+	c.insert(query)
+	return &fakeRows{}, nil
+}
+
+func (c *fakeConn) insert(query string) {
+	m := insertPattern.FindStringSubmatch(query)
+	if m == nil {
+		return
+	}
+	if c.tx != nil {
+		c.tx.pending[m[1]]++
+		return
+	}
+	c.store.mu.Lock()
+	c.store.rows[m[1]]++
+	c.store.mu.Unlock()
+}
+
+func (c *fakeConn) count(table string) int {
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+	n := c.store.rows[table]
+	if c.tx != nil {
+		n += c.tx.pending[table]
+	}
+	return n
+}
+
+func (tx *fakeTx) Commit() error {
+	store := tx.conn.store
+	store.mu.Lock()
+	for table, n := range tx.pending {
+		store.rows[table] += n
+	}
+	store.commits++
+	store.mu.Unlock()
+	tx.conn.tx = nil
+	return nil
+}
+
+func (tx *fakeTx) Rollback() error {
+	store := tx.conn.store
+	store.mu.Lock()
+	store.rollbacks++
+	store.mu.Unlock()
+	tx.conn.tx = nil
+	return nil
+}
+
+type fakeStmt struct {
+	conn  *fakeConn
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.conn.ExecContext(context.Background(), s.query, nil)
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.conn.QueryContext(context.Background(), s.query, nil)
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
@@ -0,0 +1,40 @@
+package persistence
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type txContextKey struct{}
+
+// TransactionManager ejecuta operaciones de varios repositorios (incluso de distintos
+// bounded contexts) dentro de una misma transacción, propagada a través del context.
+type TransactionManager struct {
+	db *gorm.DB
+}
+
+func NewTransactionManager(db *gorm.DB) *TransactionManager {
+	return &TransactionManager{db: db}
+}
+
+// WithinTransaction ejecuta fn en una transacción: commit si retorna nil, rollback si retorna error.
+// Si ctx ya lleva una transacción, fn se une a ella.
+func (m *TransactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txContextKey{}, tx))
+	})
+}
+
+// Conn retorna la transacción en curso si ctx la lleva, o db en caso contrario.
+// Los repositorios deben usarlo en lugar de db.WithContext(ctx).
+func Conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
@@ -0,0 +1,104 @@
+package persistence_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"finanzas-backend/internal/shared/infrastructure/persistence"
+	"finanzas-backend/internal/shared/infrastructure/persistence/persistencetest"
+)
+
+type itemModel struct {
+	ID   uint64 `gorm:"primaryKey"`
+	Name string
+}
+
+func (itemModel) TableName() string { return "items" }
+
+func TestWithinTransactionCommitsOnSuccess(t *testing.T) {
+	db, store := persistencetest.Open(t)
+	manager := persistence.NewTransactionManager(db)
+
+	err := manager.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		return persistence.Conn(ctx, db).Create(&itemModel{Name: "a"}).Error
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := store.Rows("items"); got != 1 {
+		t.Fatalf("expected 1 committed row, got %d", got)
+	}
+	if store.Commits() != 1 || store.Rollbacks() != 0 {
+		t.Fatalf("expected 1 commit and no rollback, got %d and %d", store.Commits(), store.Rollbacks())
+	}
+}
+
+func TestWithinTransactionRollsBackOnError(t *testing.T) {
+	db, store := persistencetest.Open(t)
+	manager := persistence.NewTransactionManager(db)
+	failure := errors.New("second write failed")
+
+	err := manager.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		if err := persistence.Conn(ctx, db).Create(&itemModel{Name: "a"}).Error; err != nil {
+			return err
+		}
+		return failure
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("expected %v, got %v", failure, err)
+	}
+	if got := store.Rows("items"); got != 0 {
+		t.Fatalf("expected the insert to be rolled back, got %d rows", got)
+	}
+	if store.Rollbacks() != 1 || store.Commits() != 0 {
+		t.Fatalf("expected 1 rollback and no commit, got %d and %d", store.Rollbacks(), store.Commits())
+	}
+}
+
+func TestWithinTransactionRollsBackOnPanic(t *testing.T) {
+	db, store := persistencetest.Open(t)
+	manager := persistence.NewTransactionManager(db)
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("expected the panic to propagate")
+			}
+		}()
+		_ = manager.WithinTransaction(context.Background(), func(ctx context.Context) error {
+			if err := persistence.Conn(ctx, db).Create(&itemModel{Name: "a"}).Error; err != nil {
+				return err
+			}
+			panic("boom")
+		})
+	}()
+
+	if got := store.Rows("items"); got != 0 {
+		t.Fatalf("expected the insert to be rolled back, got %d rows", got)
+	}
+	if store.Rollbacks() != 1 || store.Commits() != 0 {
+		t.Fatalf("expected 1 rollback and no commit, got %d and %d", store.Rollbacks(), store.Commits())
+	}
+}
+
+func TestWithinTransactionJoinsOuterTransaction(t *testing.T) {
+	db, store := persistencetest.Open(t)
+	manager := persistence.NewTransactionManager(db)
+	failure := errors.New("outer failed")
+
+	err := manager.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		if err := manager.WithinTransaction(ctx, func(inner context.Context) error {
+			return persistence.Conn(inner, db).Create(&itemModel{Name: "inner"}).Error
+		}); err != nil {
+			return err
+		}
+		return failure
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("expected %v, got %v", failure, err)
+	}
+	if got := store.Rows("items"); got != 0 {
+		t.Fatalf("expected the inner insert to be rolled back with the outer transaction, got %d rows", got)
+	}
+}
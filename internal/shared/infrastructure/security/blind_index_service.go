@@ -0,0 +1,42 @@
+package security
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// BlindIndexService computes deterministic keyed hashes (HMAC-SHA256) of sensitive values.
+// They allow equality lookups and unique constraints on encrypted columns without
+// revealing the value; the randomized ciphertext is still stored for decryption.
+type BlindIndexService struct {
+	key []byte
+}
+
+// NewBlindIndexService creates a blind index service with a key of at least 32 bytes
+func NewBlindIndexService(key string) (*BlindIndexService, error) {
+	if len(key) < 32 {
+		return nil, errors.New("blind index key must be at least 32 bytes")
+	}
+	return &BlindIndexService{key: []byte(key)}, nil
+}
+
+// DeriveBlindIndexKey derives a blind index key from the encryption key, used when no
+// dedicated BLIND_INDEX_KEY is configured so both keys are never the same bytes
+func DeriveBlindIndexKey(encryptionKey string) string {
+	mac := hmac.New(sha256.New, []byte(encryptionKey))
+	mac.Write([]byte("finanzas-backend/blind-index/v1"))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Compute returns the hex blind index of value. The purpose separates indexes of different
+// fields so equal values in different columns do not produce the same hash.
+func (s *BlindIndexService) Compute(purpose, value string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(purpose))
+	mac.Write([]byte{0})
+	mac.Write([]byte(strings.TrimSpace(value)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -1,85 +1,149 @@
 package security
 
 import (
-	"crypto/aes"
-	"crypto/cipher"
 	"crypto/rand"
 	"encoding/base64"
 	"errors"
 	"io"
+	"strings"
 )
 
-// EncryptionService provides AES encryption/decryption for sensitive data
+// envelopePrefix identifica el formato versionado: ev1:<kid>:<llave de datos envuelta>:<payload>
+const envelopePrefix = "ev1:"
+
+// EncryptionService provides envelope encryption for sensitive data.
+// Each value is encrypted with a fresh data key, which is wrapped with the provider's
+// current master key; the key id travels in the ciphertext so any known key can decrypt.
 type EncryptionService struct {
-	key []byte
+	provider  KeyProvider
+	legacyKey []byte
 }
 
-// NewEncryptionService creates a new encryption service with a 32-byte key
-func NewEncryptionService(key string) (*EncryptionService, error) {
-	// Ensure key is 32 bytes for AES-256
-	keyBytes := []byte(key)
-	if len(keyBytes) != 32 {
-		return nil, errors.New("encryption key must be exactly 32 bytes")
+// NewEncryptionService creates an encryption service over a key provider. legacyKey (optional)
+// decrypts values written before key versioning, which carry no key id.
+func NewEncryptionService(provider KeyProvider, legacyKey string) (*EncryptionService, error) {
+	if provider == nil {
+		return nil, errors.New("encryption key provider is required")
+	}
+
+	service := &EncryptionService{provider: provider}
+	if legacyKey != "" {
+		key, err := parseKeyMaterial(legacyKey)
+		if err != nil {
+			return nil, err
+		}
+		service.legacyKey = key
 	}
-	return &EncryptionService{key: keyBytes}, nil
+	return service, nil
+}
+
+// CurrentKeyID returns the key id used for new ciphertexts
+func (s *EncryptionService) CurrentKeyID() string {
+	return s.provider.CurrentKeyID()
 }
 
-// Encrypt encrypts plaintext using AES-GCM
+// Encrypt encrypts plaintext under the current key
 func (s *EncryptionService) Encrypt(plaintext string) (string, error) {
 	if plaintext == "" {
 		return "", nil
 	}
 
-	block, err := aes.NewCipher(s.key)
-	if err != nil {
+	kid := s.provider.CurrentKeyID()
+
+	dataKey := make([]byte, masterKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
 		return "", err
 	}
 
-	gcm, err := cipher.NewGCM(block)
+	wrapped, err := s.provider.WrapKey(kid, dataKey)
 	if err != nil {
 		return "", err
 	}
 
-	nonce := make([]byte, gcm.NonceSize())
-	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+	payload, err := sealAESGCM(dataKey, []byte(plaintext), []byte(envelopePrefix+kid))
+	if err != nil {
 		return "", err
 	}
 
-	ciphertext := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
-	return base64.StdEncoding.EncodeToString(ciphertext), nil
+	return envelopePrefix + kid + ":" +
+		base64.StdEncoding.EncodeToString(wrapped) + ":" +
+		base64.StdEncoding.EncodeToString(payload), nil
 }
 
-// Decrypt decrypts ciphertext using AES-GCM
+// Decrypt decrypts a ciphertext produced with any known key, including legacy values
 func (s *EncryptionService) Decrypt(ciphertext string) (string, error) {
 	if ciphertext == "" {
 		return "", nil
 	}
 
-	data, err := base64.StdEncoding.DecodeString(ciphertext)
+	if !strings.HasPrefix(ciphertext, envelopePrefix) {
+		return s.decryptLegacy(ciphertext)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(ciphertext, envelopePrefix), ":")
+	if len(parts) != 3 {
+		return "", errors.New("malformed ciphertext")
+	}
+	kid := parts[0]
+
+	wrapped, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", err
+	}
+	payload, err := base64.StdEncoding.DecodeString(parts[2])
 	if err != nil {
 		return "", err
 	}
 
-	block, err := aes.NewCipher(s.key)
+	dataKey, err := s.provider.UnwrapKey(kid, wrapped)
 	if err != nil {
 		return "", err
 	}
 
-	gcm, err := cipher.NewGCM(block)
+	plaintext, err := openAESGCM(dataKey, payload, []byte(envelopePrefix+kid))
 	if err != nil {
 		return "", err
 	}
+	return string(plaintext), nil
+}
+
+// IsEncrypted reports whether value is a versioned ciphertext, so fields that may be stored
+// either encrypted or in plaintext can be told apart
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, envelopePrefix)
+}
+
+// NeedsReencryption indicates whether ciphertext was not written under the current key
+func (s *EncryptionService) NeedsReencryption(ciphertext string) bool {
+	if ciphertext == "" {
+		return false
+	}
+	return !strings.HasPrefix(ciphertext, envelopePrefix+s.provider.CurrentKeyID()+":")
+}
+
+// Reencrypt decrypts ciphertext and encrypts it again under the current key
+func (s *EncryptionService) Reencrypt(ciphertext string) (string, error) {
+	plaintext, err := s.Decrypt(ciphertext)
+	if err != nil {
+		return "", err
+	}
+	return s.Encrypt(plaintext)
+}
 
-	nonceSize := gcm.NonceSize()
-	if len(data) < nonceSize {
-		return "", errors.New("ciphertext too short")
+// decryptLegacy handles the original format: base64(nonce || ciphertext) with a single raw key
+func (s *EncryptionService) decryptLegacy(ciphertext string) (string, error) {
+	if s.legacyKey == nil {
+		return "", errors.New("legacy ciphertext found but no legacy encryption key is configured")
 	}
 
-	nonce, cipherData := data[:nonceSize], data[nonceSize:]
-	plaintext, err := gcm.Open(nil, nonce, cipherData, nil)
+	data, err := base64.StdEncoding.DecodeString(ciphertext)
 	if err != nil {
 		return "", err
 	}
 
+	plaintext, err := openAESGCM(s.legacyKey, data, nil)
+	if err != nil {
+		return "", err
+	}
 	return string(plaintext), nil
 }
@@ -0,0 +1,371 @@
+package security
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// masterKeySize es el tamaño de las llaves maestras y de datos (AES-256)
+const masterKeySize = 32
+
+var keyIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,32}$`)
+
+// ErrUnknownKeyID indica que el ciphertext fue cifrado con una llave que el proveedor no conoce
+var ErrUnknownKeyID = errors.New("unknown encryption key id")
+
+// KeyProvider custodia las llaves maestras versionadas. Como un KMS, nunca entrega la llave
+// maestra: solo envuelve y desenvuelve las llaves de datos con la llave indicada.
+type KeyProvider interface {
+	// CurrentKeyID es la llave con la que se envuelven los datos nuevos
+	CurrentKeyID() string
+	WrapKey(keyID string, dataKey []byte) ([]byte, error)
+	UnwrapKey(keyID string, wrapped []byte) ([]byte, error)
+}
+
+// staticKeyProvider mantiene en memoria un conjunto fijo de llaves maestras (env o archivo)
+type staticKeyProvider struct {
+	currentKeyID string
+	keys         map[string][]byte
+}
+
+func newStaticKeyProvider(currentKeyID string, keys map[string][]byte) (KeyProvider, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no encryption keys configured")
+	}
+	if _, ok := keys[currentKeyID]; !ok {
+		return nil, fmt.Errorf("current encryption key id %q is not among the configured keys", currentKeyID)
+	}
+	return &staticKeyProvider{currentKeyID: currentKeyID, keys: keys}, nil
+}
+
+// NewEnvKeyProvider construye el proveedor desde variables de entorno.
+// spec tiene la forma "kid:llave,kid:llave"; si está vacío se usa legacyKey como única llave "k0".
+func NewEnvKeyProvider(spec, currentKeyID, legacyKey string) (KeyProvider, error) {
+	keys := make(map[string][]byte)
+
+	if strings.TrimSpace(spec) == "" {
+		if legacyKey == "" {
+			return nil, errors.New("no encryption keys configured, set ENCRYPTION_KEYS or ENCRYPTION_KEY")
+		}
+		material, err := parseKeyMaterial(legacyKey)
+		if err != nil {
+			return nil, err
+		}
+		keys["k0"] = material
+		if currentKeyID == "" {
+			currentKeyID = "k0"
+		}
+		return newStaticKeyProvider(currentKeyID, keys)
+	}
+
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		kid, value, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, errors.New("ENCRYPTION_KEYS entries must have the form kid:key")
+		}
+		if err := addKey(keys, kid, value); err != nil {
+			return nil, err
+		}
+	}
+
+	return newStaticKeyProvider(currentKeyID, keys)
+}
+
+// keyringFile es el formato del archivo de llaves
+type keyringFile struct {
+	CurrentKeyID string            `json:"current_key_id"`
+	Keys         map[string]string `json:"keys"`
+}
+
+// NewFileKeyProvider carga las llaves desde un archivo JSON
+// {"current_key_id": "k2", "keys": {"k1": "...", "k2": "..."}}
+func NewFileKeyProvider(path string) (KeyProvider, error) {
+	if path == "" {
+		return nil, errors.New("encryption keys file path is required")
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file keyringFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("invalid encryption keys file: %w", err)
+	}
+
+	keys := make(map[string][]byte, len(file.Keys))
+	for kid, value := range file.Keys {
+		if err := addKey(keys, kid, value); err != nil {
+			return nil, err
+		}
+	}
+
+	return newStaticKeyProvider(file.CurrentKeyID, keys)
+}
+
+func (p *staticKeyProvider) CurrentKeyID() string {
+	return p.currentKeyID
+}
+
+func (p *staticKeyProvider) WrapKey(keyID string, dataKey []byte) ([]byte, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, ErrUnknownKeyID
+	}
+	return sealAESGCM(key, dataKey, []byte(keyID))
+}
+
+func (p *staticKeyProvider) UnwrapKey(keyID string, wrapped []byte) ([]byte, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, ErrUnknownKeyID
+	}
+	return openAESGCM(key, wrapped, []byte(keyID))
+}
+
+// localKMSKeyProvider simula un KMS en un directorio local: cada llave maestra vive en
+// <kid>.key y el archivo "current" indica cuál envuelve los datos nuevos.
+// Si el directorio está vacío genera la primera llave. Para rotar basta con agregar
+// un nuevo <kid>.key y apuntar "current" a él: cada instancia relee el directorio al
+// encontrar una llave desconocida y, como máximo, cada localKMSReloadInterval.
+type localKMSKeyProvider struct {
+	dir string
+
+	mu           sync.RWMutex
+	keys         map[string][]byte
+	currentKeyID string
+	loadedAt     time.Time
+}
+
+const localKMSCurrentFile = "current"
+
+// localKMSReloadInterval es cada cuánto se relee "current" para tomar una rotación
+const localKMSReloadInterval = time.Minute
+
+// NewLocalKMSKeyProvider crea el proveedor KMS local sobre dir
+func NewLocalKMSKeyProvider(dir string) (KeyProvider, error) {
+	if dir == "" {
+		return nil, errors.New("local KMS directory is required")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	p := &localKMSKeyProvider{dir: dir, keys: make(map[string][]byte)}
+	err := p.reload()
+	if errors.Is(err, os.ErrNotExist) && len(p.keys) == 0 {
+		if err := p.createInitialKey(); err != nil {
+			return nil, err
+		}
+		return p, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *localKMSKeyProvider) CurrentKeyID() string {
+	p.mu.RLock()
+	current, loadedAt := p.currentKeyID, p.loadedAt
+	p.mu.RUnlock()
+	if time.Since(loadedAt) < localKMSReloadInterval {
+		return current
+	}
+
+	// Si el directorio no se puede leer se sigue con la llave conocida
+	if err := p.reload(); err != nil {
+		log.Printf("local KMS reload failed: %v", err)
+		p.mu.Lock()
+		p.loadedAt = time.Now()
+		p.mu.Unlock()
+		return current
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.currentKeyID
+}
+
+func (p *localKMSKeyProvider) WrapKey(keyID string, dataKey []byte) ([]byte, error) {
+	key, err := p.lookup(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return sealAESGCM(key, dataKey, []byte(keyID))
+}
+
+func (p *localKMSKeyProvider) UnwrapKey(keyID string, wrapped []byte) ([]byte, error) {
+	key, err := p.lookup(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return openAESGCM(key, wrapped, []byte(keyID))
+}
+
+func (p *localKMSKeyProvider) lookup(keyID string) ([]byte, error) {
+	p.mu.RLock()
+	key, ok := p.keys[keyID]
+	p.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+
+	// Otra instancia pudo haber agregado la llave
+	if err := p.reload(); err != nil {
+		return nil, err
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if key, ok := p.keys[keyID]; ok {
+		return key, nil
+	}
+	return nil, ErrUnknownKeyID
+}
+
+// reload relee las llaves y el archivo "current"; solo los reemplaza si ambos son consistentes
+func (p *localKMSKeyProvider) reload() error {
+	entries, err := os.ReadDir(p.dir)
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string][]byte)
+	for _, entry := range entries {
+		kid, ok := strings.CutSuffix(entry.Name(), ".key")
+		if entry.IsDir() || !ok {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(p.dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		if err := addKey(keys, kid, strings.TrimSpace(string(content))); err != nil {
+			return err
+		}
+	}
+
+	content, err := os.ReadFile(filepath.Join(p.dir, localKMSCurrentFile))
+	if err != nil {
+		// Se conservan las llaves leídas: el constructor decide si generar la primera
+		p.mu.Lock()
+		p.keys = keys
+		p.mu.Unlock()
+		return err
+	}
+	current := strings.TrimSpace(string(content))
+	if _, ok := keys[current]; !ok {
+		return fmt.Errorf("current encryption key id %q not found in local KMS", current)
+	}
+
+	p.mu.Lock()
+	p.keys = keys
+	p.currentKeyID = current
+	p.loadedAt = time.Now()
+	p.mu.Unlock()
+	return nil
+}
+
+func (p *localKMSKeyProvider) createInitialKey() error {
+	key := make([]byte, masterKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return err
+	}
+
+	kid := "k1"
+	encoded := base64.StdEncoding.EncodeToString(key)
+	if err := os.WriteFile(filepath.Join(p.dir, kid+".key"), []byte(encoded+"\n"), 0o600); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(p.dir, localKMSCurrentFile), []byte(kid+"\n"), 0o600); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	p.keys[kid] = key
+	p.currentKeyID = kid
+	p.loadedAt = time.Now()
+	p.mu.Unlock()
+	return nil
+}
+
+func addKey(keys map[string][]byte, kid, value string) error {
+	kid = strings.TrimSpace(kid)
+	if !keyIDPattern.MatchString(kid) {
+		return fmt.Errorf("invalid encryption key id %q, use letters, digits, - or _", kid)
+	}
+	if _, exists := keys[kid]; exists {
+		return fmt.Errorf("duplicate encryption key id %q", kid)
+	}
+	material, err := parseKeyMaterial(value)
+	if err != nil {
+		return fmt.Errorf("encryption key %q: %w", kid, err)
+	}
+	keys[kid] = material
+	return nil
+}
+
+// parseKeyMaterial acepta la llave en base64 (32 bytes decodificados) o como texto de 32 bytes
+func parseKeyMaterial(value string) ([]byte, error) {
+	value = strings.TrimSpace(value)
+	if decoded, err := base64.StdEncoding.DecodeString(value); err == nil && len(decoded) == masterKeySize {
+		return decoded, nil
+	}
+	if len(value) == masterKeySize {
+		return []byte(value), nil
+	}
+	return nil, errors.New("encryption key must be 32 bytes or base64 of 32 bytes")
+}
+
+func sealAESGCM(key, plaintext, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func openAESGCM(key, data, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := gcm.NonceSize()
+	if len(data) < nonceSize {
+		return nil, errors.New("ciphertext too short")
+	}
+	return gcm.Open(nil, data[:nonceSize], data[nonceSize:], additionalData)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}